@@ -37,6 +37,7 @@ const (
 	DefaultMaxPendingPeers            = 20
 	DefaultListenAddr                 = ":0"
 	DefaultMaxMessageDeliveryAttempts = 3
+	DefaultMaxIncomingMessagesPerMin  = 60
 	DefaultVerifyTransactionChainID   = 1
 	DefaultCurrentNetwork             = "mainnet_rpc"
 )
@@ -349,6 +350,8 @@ func DefaultNodeConfig(installationID string, request *requests.CreateAccount, o
 		VerifyTransactionChainID:   DefaultVerifyTransactionChainID,
 		DataSyncEnabled:            true,
 		PFSEnabled:                 true,
+
+		MaxIncomingMessagesPerSenderPerMinute: DefaultMaxIncomingMessagesPerMin,
 	}
 
 	if request.VerifyTransactionURL != nil {
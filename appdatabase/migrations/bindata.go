@@ -120,6 +120,15 @@
 // 1721215212_create_keycard_and_accounts.up.sql (725B)
 // 1721832718_rename_shard_test.up.sql (3.186kB)
 // 1722415278_remove_incorrectly_added_keycards.up.sql (67B)
+// 1722500000_add_custom_status_expiry.up.sql (284B)
+// 1722600000_add_mailserver_health.up.sql (573B)
+// 1722700000_add_wakuv2_local_discovery.up.sql (83B)
+// 1722800000_add_incoming_messages_rate_limit.up.sql (170B)
+// 1722900000_add_contact_requests_policy.up.sql (157B)
+// 1723000000_add_url_unfurling_privacy.up.sql (153B)
+// 1723100000_add_archive_transports_config.up.sql (452B)
+// 1723200000_add_archive_transports_data_dir.up.sql (87B)
+// 1723300000_add_communities_signed_archive_indexes.up.sql (119B)
 // doc.go (94B)
 
 package migrations
@@ -2588,6 +2597,186 @@ func _1722415278_remove_incorrectly_added_keycardsUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1722500000_add_custom_status_expiryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xce\x41\x0a\x82\x40\x14\x87\xf1\x7d\xa7\xf8\xe3\x09\xda\xb7\x1a\x73\x0a\xe1\x35\x82\x3c\xa1\xdd\x20\xe3\x50\x96\xa9\xf4\x9e\x50\xb7\x6f\xd3\xa6\x18\xa2\x03\x7c\x3f\x3e\x43\x6c\x6b\xb0\xc9\xc9\x42\xb4\xd5\x45\xfc\x32\x77\xad\x46\x81\x29\x0a\x6c\x2b\x6a\x0e\x0e\xf1\x36\x5d\x7a\xb0\x3d\x32\x5c\xc5\x70\x0d\x11\x0a\xbb\x33\x0d\x31\xb2\x6c\xb3\xfa\x53\x79\xcc\xfd\x3d\x8a\x6f\x15\xa5\x4b\x48\xeb\x2f\x28\xaa\xf6\xe3\xe9\x83\x78\xe3\x12\xce\xb1\x5b\x86\x88\x9c\xaa\x3c\x5d\x79\x79\x8e\xc1\x87\x61\x0a\xd7\x5f\x40\xe9\xd8\xee\x6d\x9d\x9c\x79\x05\x00\x00\xff\xff\x92\xfb\xa5\x87\x1c\x01\x00\x00")
+
+func _1722500000_add_custom_status_expiryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722500000_add_custom_status_expiryUpSql,
+		"1722500000_add_custom_status_expiry.up.sql",
+	)
+}
+
+func _1722500000_add_custom_status_expiryUpSql() (*asset, error) {
+	bytes, err := _1722500000_add_custom_status_expiryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722500000_add_custom_status_expiry.up.sql", size: 284, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbd, 0xbe, 0x98, 0xb7, 0x6a, 0xcc, 0xf2, 0x28, 0x60, 0xeb, 0xac, 0x19, 0xc5, 0x43, 0x13, 0x62, 0x92, 0xd1, 0x33, 0x45, 0x48, 0xee, 0xb9, 0x38, 0xfd, 0x5f, 0x36, 0x82, 0x7, 0x3e, 0x2e, 0xb8}}
+	return a, nil
+}
+
+var __1722600000_add_mailserver_healthUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\x31\x4f\xc3\x30\x10\x85\x77\xff\x8a\x1b\x5b\x29\x03\x3b\x93\x53\xae\x10\x61\x52\xe4\xba\x88\x4e\x96\x1b\xae\xad\xa5\x84\x04\x9f\xdd\xdf\x8f\x42\x18\x52\x41\x95\xac\x7e\xdf\xd3\x7b\x7e\xb7\xd2\x28\x0d\x82\x91\xb9\x42\x28\xd6\x50\x6e\x0c\xe0\x7b\xb1\x35\x5b\x68\x9c\xaf\x99\xc2\x85\x82\x3d\x93\xab\xe3\x19\x16\x02\xc6\xaf\xfe\x03\xde\xa4\x5e\x3d\x49\xfd\x63\x2b\x77\x4a\xc1\xab\x2e\x5e\xa4\xde\xc3\x33\xee\x33\x01\xf0\x95\x28\x78\x62\x28\x4a\x83\x8f\x38\xe2\x1e\x70\x2d\x77\xca\xc0\x5d\x4f\x1d\x9d\xaf\x53\x98\xc4\x4e\xae\x9b\x42\x6a\xc7\xd1\x72\xaa\x2a\xe2\x59\xe8\x6f\xf2\x04\x1a\x88\xbb\xf6\x93\xc9\x46\xdf\x10\x43\xae\x36\xb9\x58\xde\x0b\x31\x6f\xbc\xd8\x76\xbe\xb2\x55\x7b\xa1\xe0\x4e\x34\x63\xc4\x3e\xb2\x4b\x07\x4e\x87\xc1\xfb\x2f\x70\x5b\x39\x86\xb6\xb1\xf1\xef\xf7\x07\xd7\x2d\x65\x74\x38\x58\x5c\xf5\xcb\xae\xca\x64\x43\xf2\xb2\x1f\xe0\x3b\x00\x00\xff\xff\x37\x5a\x9e\x50\x3d\x02\x00\x00")
+
+func _1722600000_add_mailserver_healthUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722600000_add_mailserver_healthUpSql,
+		"1722600000_add_mailserver_health.up.sql",
+	)
+}
+
+func _1722600000_add_mailserver_healthUpSql() (*asset, error) {
+	bytes, err := _1722600000_add_mailserver_healthUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722600000_add_mailserver_health.up.sql", size: 573, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x26, 0x19, 0x80, 0xed, 0x8, 0x7b, 0x2b, 0xc, 0xcd, 0x69, 0xc6, 0xb2, 0x5e, 0xbb, 0xdc, 0x6b, 0x77, 0x41, 0xfa, 0x6d, 0xa8, 0x93, 0xaa, 0xfb, 0xc2, 0x16, 0x1e, 0xd1, 0x5, 0x21, 0x7a, 0x44}}
+	return a, nil
+}
+
+var __1722700000_add_wakuv2_local_discoveryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4f\xcc\x2e\x2d\x33\x8a\x4f\xce\xcf\x4b\xcb\x4c\x57\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x48\xcd\x4b\x4c\xca\x49\x8d\xcf\xc9\x4f\x4e\xcc\x89\x4f\xc9\x2c\x4e\xce\x2f\x4b\x2d\xaa\x54\x70\xf2\xf7\xf7\x71\x75\xf4\x53\x70\x71\x75\x73\x0c\xf5\x09\x51\x48\x4b\xcc\x29\x4e\xb5\xe6\x02\x04\x00\x00\xff\xff\x5e\x0f\x12\xac\x53\x00\x00\x00")
+
+func _1722700000_add_wakuv2_local_discoveryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722700000_add_wakuv2_local_discoveryUpSql,
+		"1722700000_add_wakuv2_local_discovery.up.sql",
+	)
+}
+
+func _1722700000_add_wakuv2_local_discoveryUpSql() (*asset, error) {
+	bytes, err := _1722700000_add_wakuv2_local_discoveryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722700000_add_wakuv2_local_discovery.up.sql", size: 83, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x27, 0x59, 0xb2, 0xf5, 0x98, 0xc3, 0x32, 0x8d, 0x21, 0xe4, 0x29, 0x25, 0xde, 0xff, 0x90, 0x8, 0xfb, 0xd2, 0x34, 0xaf, 0xc6, 0x7c, 0x3f, 0x2b, 0x88, 0xd8, 0x80, 0xaa, 0x85, 0x1a, 0x55, 0x77}}
+	return a, nil
+}
+
+var __1722800000_add_incoming_messages_rate_limitUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\xb1\xca\x02\x31\x0c\x00\xe0\xfd\x7f\x8a\x3c\xc2\xbf\x3b\x55\x5b\x41\xa8\x27\x48\x6f\x0e\xa5\xc6\x5e\x86\xa4\x67\xd3\x83\x7b\x7c\xc1\xd5\xc5\xed\x9b\x3e\x17\x53\xb8\x43\x72\xc7\x18\xc0\x96\x85\xf6\x81\xa5\xe9\x93\x2b\x38\xef\xe1\x74\x8b\xf3\x75\x02\xc9\x3b\xb2\x96\x26\xac\x15\x85\xcc\x72\x25\xc3\x95\x3a\x1a\xe9\x83\xfa\x87\xc2\xba\x0d\x82\xcb\x94\xc0\x87\xb3\x9b\x63\x82\xff\xc3\xdf\x4f\xbf\xad\x59\xf0\xb5\xe5\x9e\x75\xb0\x12\x5a\x69\xfd\x6b\x7a\x07\x00\x00\xff\xff\xe8\x91\x52\x53\xaa\x00\x00\x00")
+
+func _1722800000_add_incoming_messages_rate_limitUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722800000_add_incoming_messages_rate_limitUpSql,
+		"1722800000_add_incoming_messages_rate_limit.up.sql",
+	)
+}
+
+func _1722800000_add_incoming_messages_rate_limitUpSql() (*asset, error) {
+	bytes, err := _1722800000_add_incoming_messages_rate_limitUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722800000_add_incoming_messages_rate_limit.up.sql", size: 170, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4, 0x29, 0x9e, 0xbc, 0x8c, 0x84, 0x3c, 0x3f, 0xe7, 0x31, 0x7, 0x65, 0x2b, 0x60, 0xcd, 0xd6, 0x6b, 0x6d, 0x39, 0xd8, 0x4a, 0x50, 0xec, 0x18, 0xf0, 0xde, 0x15, 0x2, 0xce, 0x7f, 0x71, 0x5f}}
+	return a, nil
+}
+
+var __1722900000_add_contact_requests_policyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\x31\x0a\x02\x31\x10\x05\xd0\x7e\x4f\xf1\x8f\x60\xbf\x55\x62\xa2\x08\x63\x02\xcb\xa4\x0e\x32\x04\x59\x5c\x12\x75\xc6\x62\x6f\xef\x05\x2c\x3c\xc0\x7b\x8e\x38\x2e\x60\xe7\x29\x42\x9b\xd9\xda\xef\x0a\x17\x02\x8e\x99\xca\x35\x41\x46\xb7\x9b\x58\x7d\xb7\xd7\xa7\xa9\x69\x7d\x8e\x6d\x95\x1d\x9e\xb2\x9f\x27\xf7\x43\x57\xdd\xbb\x54\xd9\x86\x3c\xfe\x89\x2e\x89\xe3\x39\x2e\x48\x99\x91\x0a\x11\x42\x3c\xb9\x42\x8c\xc3\x3c\x7d\x03\x00\x00\xff\xff\x62\x4d\x2b\x97\x9d\x00\x00\x00")
+
+func _1722900000_add_contact_requests_policyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722900000_add_contact_requests_policyUpSql,
+		"1722900000_add_contact_requests_policy.up.sql",
+	)
+}
+
+func _1722900000_add_contact_requests_policyUpSql() (*asset, error) {
+	bytes, err := _1722900000_add_contact_requests_policyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722900000_add_contact_requests_policy.up.sql", size: 157, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1c, 0xd0, 0x43, 0x4a, 0xd1, 0x51, 0x84, 0x6c, 0x27, 0x5d, 0xca, 0x2a, 0xa3, 0xdf, 0x98, 0xcd, 0xf, 0xb5, 0xc6, 0x9a, 0x1c, 0x68, 0xfc, 0xb9, 0xaa, 0xd6, 0x3d, 0xf, 0x24, 0x51, 0x18, 0xa1}}
+	return a, nil
+}
+
+var __1723000000_add_url_unfurling_privacyUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\xcc\x41\x0a\x02\x21\x14\x06\xe0\xfd\x9c\xe2\x3f\x42\xfb\x59\x69\xbe\x22\x78\x29\x0c\xcf\xb5\x84\xd4\x20\xc9\x2b\x74\x0c\xe6\xf6\x5d\x20\xe8\x00\xdf\x67\x58\x68\x81\x18\xcb\x84\x7e\xdf\xb6\xa2\x6b\x87\x71\x0e\xc7\xc0\xf1\xea\x31\x5a\x4d\x43\x1f\xa3\xd5\xa2\x6b\x7a\xb7\xf2\xb9\xe5\x1d\x96\x83\x9d\xa7\x5f\x36\xf5\x5d\x73\xca\xf5\x95\x9f\xff\x9b\x8b\x17\x3a\xd3\x02\x1f\x04\x3e\x32\xc3\xd1\xc9\x44\x16\x1c\xe6\xe9\x1b\x00\x00\xff\xff\x2c\x72\x30\x25\x99\x00\x00\x00")
+
+func _1723000000_add_url_unfurling_privacyUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723000000_add_url_unfurling_privacyUpSql,
+		"1723000000_add_url_unfurling_privacy.up.sql",
+	)
+}
+
+func _1723000000_add_url_unfurling_privacyUpSql() (*asset, error) {
+	bytes, err := _1723000000_add_url_unfurling_privacyUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723000000_add_url_unfurling_privacy.up.sql", size: 153, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x23, 0x88, 0xc9, 0xc5, 0x11, 0x37, 0x90, 0x8c, 0xe0, 0x66, 0xa8, 0x30, 0x63, 0x4d, 0x43, 0x69, 0x91, 0x86, 0x22, 0x27, 0xa5, 0x77, 0xca, 0xdf, 0x45, 0xb1, 0x28, 0xac, 0x34, 0xec, 0x25, 0x2a}}
+	return a, nil
+}
+
+var __1723100000_add_archive_transports_configUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8d\xce\xb1\x6e\xc2\x30\x14\x85\xe1\x3d\x4f\x71\xb7\x14\xa9\x6f\xd0\xc9\x05\x23\xac\xa6\x04\x19\xa7\x94\xc9\xba\xc4\x06\x5b\xb2\x62\xcb\xbe\x01\xe5\xed\xa9\x18\x90\x3a\x91\xfd\xfb\x8f\xce\x52\x72\xa6\x38\x28\xf6\xd9\x70\x10\x6b\xd8\xb6\x0a\xf8\xaf\xd8\xab\x3d\x60\xee\x9d\xbf\x5a\x4d\x19\x87\x92\x62\xa6\xa2\xfb\x38\x9c\xfd\x05\xde\x2a\x00\xe3\xb3\xed\x29\xe6\x49\x27\x24\x07\x3f\x4c\x2e\x37\x4c\x3e\xfa\x6d\xd7\x34\xb0\xe2\x6b\xd6\x35\x0a\xea\xfa\xfd\x9f\x3e\x61\xb1\x7a\xcc\xe1\x55\xe1\x88\x92\x1e\x53\x88\x68\x66\xf3\x34\x9e\x82\xef\x67\x73\x0c\x21\xde\xac\xd1\x2e\x16\x2a\xaf\x0a\x9f\xce\x45\x63\xf2\x73\xd6\x1f\xf6\x82\x64\x6f\x38\xcd\xf1\x65\x1a\xc8\x59\xfa\xbb\xee\xcd\xd3\x3e\x89\x37\x35\xec\xa4\xf8\x66\xf2\x08\x5f\xfc\x58\x2d\xe0\x20\xd4\xa6\xed\x14\xc8\xf6\x20\x56\x1f\xd5\x1d\xf0\xb5\xb7\xdc\xc4\x01\x00\x00")
+
+func _1723100000_add_archive_transports_configUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723100000_add_archive_transports_configUpSql,
+		"1723100000_add_archive_transports_config.up.sql",
+	)
+}
+
+func _1723100000_add_archive_transports_configUpSql() (*asset, error) {
+	bytes, err := _1723100000_add_archive_transports_configUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723100000_add_archive_transports_config.up.sql", size: 452, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa2, 0x9e, 0x60, 0x25, 0x79, 0x7, 0xb1, 0xac, 0x77, 0x3a, 0x3b, 0x63, 0x7f, 0xb2, 0x9f, 0x7b, 0x18, 0x10, 0xee, 0x1, 0x4e, 0x1e, 0x65, 0x72, 0xa8, 0x15, 0x7, 0x3c, 0xa2, 0x5d, 0x5, 0x12}}
+	return a, nil
+}
+
+var __1723200000_add_archive_transports_data_dirUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x05\xc1\x3b\x0e\x80\x20\x10\x05\xc0\xde\x53\xbc\x8e\x43\x58\xad\x80\xb1\x58\x21\x21\x60\x4b\x88\x5f\x1a\x34\x48\x3c\xbf\x33\xc4\x5e\x3b\x78\x1a\x58\x23\xd5\xf5\xca\xdf\x1e\x5b\x4d\xe5\x7d\xee\xda\xde\xb8\xde\xe5\xc8\x27\x48\x29\x48\xcb\x61\x36\xd8\x52\x4b\x71\xcb\x15\x0b\x39\x39\x91\x83\xb1\x1e\x26\x30\x43\xe9\x91\x02\x7b\x08\xd1\x77\x3f\xc2\x2a\xe0\x10\x57\x00\x00\x00")
+
+func _1723200000_add_archive_transports_data_dirUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723200000_add_archive_transports_data_dirUpSql,
+		"1723200000_add_archive_transports_data_dir.up.sql",
+	)
+}
+
+func _1723200000_add_archive_transports_data_dirUpSql() (*asset, error) {
+	bytes, err := _1723200000_add_archive_transports_data_dirUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723200000_add_archive_transports_data_dir.up.sql", size: 87, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x74, 0x95, 0x81, 0xf6, 0x21, 0x30, 0x83, 0x14, 0xa0, 0x29, 0x3b, 0xb9, 0x39, 0x73, 0xb2, 0xbe, 0x89, 0xaf, 0xf3, 0x4c, 0xac, 0x12, 0x2d, 0x9e, 0xd2, 0x38, 0xf, 0xf9, 0xb0, 0x11, 0xf9, 0x14}}
+	return a, nil
+}
+
+var __1723300000_add_communities_signed_archive_indexesUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3d\xca\xb1\x0e\xc2\x20\x10\x06\xe0\x9d\xa7\xf8\x47\x7d\x06\x27\x24\xd7\x84\x88\xd0\xd0\x1b\xda\x89\x98\x72\xd1\x1b\x8a\x89\xa8\xd1\xb7\x77\xf3\x9b\x3f\x97\xc9\x32\x81\xed\x31\x10\xfc\x80\x98\x18\x34\xfb\x89\x27\xac\xf7\x6d\x7b\x35\x7d\xaa\xf4\xd2\xf5\xda\xa4\x96\xcb\x63\xbd\xe9\x5b\x8a\xb6\x2a\x1f\xe9\xd8\x19\xfc\xdb\xb7\x68\x05\xd3\xcc\x18\xb3\x3f\xdb\xbc\xe0\x44\x0b\x52\x84\x4b\x71\x08\xde\x31\x32\x8d\xc1\x3a\x32\xfb\x83\xf9\x01\xed\x69\x87\xa5\x77\x00\x00\x00")
+
+func _1723300000_add_communities_signed_archive_indexesUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723300000_add_communities_signed_archive_indexesUpSql,
+		"1723300000_add_communities_signed_archive_indexes.up.sql",
+	)
+}
+
+func _1723300000_add_communities_signed_archive_indexesUpSql() (*asset, error) {
+	bytes, err := _1723300000_add_communities_signed_archive_indexesUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723300000_add_communities_signed_archive_indexes.up.sql", size: 119, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0x2f, 0x55, 0xa1, 0xca, 0x48, 0xb, 0x0, 0xd4, 0xa0, 0x8d, 0xd6, 0xbd, 0x18, 0x32, 0xa1, 0xa3, 0xe8, 0x4f, 0x79, 0x45, 0xc3, 0x67, 0xd8, 0x80, 0x37, 0x9a, 0x32, 0x85, 0xe4, 0xc1, 0x7f}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x41\x0e\x02\x31\x08\x05\xd0\x7d\x4f\xf1\x2f\x00\xe8\xca\xc4\xc4\xc3\xa0\x43\x08\x19\x5b\xc6\x96\xfb\xc7\x4d\xdf\xfe\x5d\xfa\x39\xd5\x0d\xeb\xf7\x6d\x4d\xc4\xf3\xe9\x36\x6c\x6a\x19\x3c\xe9\x1d\xe3\xd0\x52\x50\xcf\xa3\xa2\xdb\xeb\xfe\xb8\x6d\xa0\xeb\x74\xf4\xf0\xa9\x15\x39\x16\x28\xc1\x2c\x7b\xb0\x27\x58\xda\x3f\x00\x00\xff\xff\x57\xd4\xd5\x90\x5e\x00\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -2819,6 +3008,15 @@ var _bindata = map[string]func() (*asset, error){
 	"1721215212_create_keycard_and_accounts.up.sql":                            _1721215212_create_keycard_and_accountsUpSql,
 	"1721832718_rename_shard_test.up.sql":                                      _1721832718_rename_shard_testUpSql,
 	"1722415278_remove_incorrectly_added_keycards.up.sql":                      _1722415278_remove_incorrectly_added_keycardsUpSql,
+	"1722500000_add_custom_status_expiry.up.sql":                               _1722500000_add_custom_status_expiryUpSql,
+	"1722600000_add_mailserver_health.up.sql":                                  _1722600000_add_mailserver_healthUpSql,
+	"1722700000_add_wakuv2_local_discovery.up.sql":                             _1722700000_add_wakuv2_local_discoveryUpSql,
+	"1722800000_add_incoming_messages_rate_limit.up.sql":                       _1722800000_add_incoming_messages_rate_limitUpSql,
+	"1722900000_add_contact_requests_policy.up.sql":                            _1722900000_add_contact_requests_policyUpSql,
+	"1723000000_add_url_unfurling_privacy.up.sql":                              _1723000000_add_url_unfurling_privacyUpSql,
+	"1723100000_add_archive_transports_config.up.sql":                          _1723100000_add_archive_transports_configUpSql,
+	"1723200000_add_archive_transports_data_dir.up.sql":                        _1723200000_add_archive_transports_data_dirUpSql,
+	"1723300000_add_communities_signed_archive_indexes.up.sql":                 _1723300000_add_communities_signed_archive_indexesUpSql,
 	"doc.go": docGo,
 }
 
@@ -2988,6 +3186,15 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1721215212_create_keycard_and_accounts.up.sql":                            {_1721215212_create_keycard_and_accountsUpSql, map[string]*bintree{}},
 	"1721832718_rename_shard_test.up.sql":                                      {_1721832718_rename_shard_testUpSql, map[string]*bintree{}},
 	"1722415278_remove_incorrectly_added_keycards.up.sql":                      {_1722415278_remove_incorrectly_added_keycardsUpSql, map[string]*bintree{}},
+	"1722500000_add_custom_status_expiry.up.sql":                               {_1722500000_add_custom_status_expiryUpSql, map[string]*bintree{}},
+	"1722600000_add_mailserver_health.up.sql":                                  {_1722600000_add_mailserver_healthUpSql, map[string]*bintree{}},
+	"1722700000_add_wakuv2_local_discovery.up.sql":                             {_1722700000_add_wakuv2_local_discoveryUpSql, map[string]*bintree{}},
+	"1722800000_add_incoming_messages_rate_limit.up.sql":                       {_1722800000_add_incoming_messages_rate_limitUpSql, map[string]*bintree{}},
+	"1722900000_add_contact_requests_policy.up.sql":                            {_1722900000_add_contact_requests_policyUpSql, map[string]*bintree{}},
+	"1723000000_add_url_unfurling_privacy.up.sql":                              {_1723000000_add_url_unfurling_privacyUpSql, map[string]*bintree{}},
+	"1723100000_add_archive_transports_config.up.sql":                          {_1723100000_add_archive_transports_configUpSql, map[string]*bintree{}},
+	"1723200000_add_archive_transports_data_dir.up.sql":                        {_1723200000_add_archive_transports_data_dirUpSql, map[string]*bintree{}},
+	"1723300000_add_communities_signed_archive_indexes.up.sql":                 {_1723300000_add_communities_signed_archive_indexesUpSql, map[string]*bintree{}},
 	"doc.go": {docGo, map[string]*bintree{}},
 }}
 
@@ -0,0 +1,4 @@
+ALTER TABLE status_updates ADD COLUMN emoji TEXT NOT NULL DEFAULT "";
+ALTER TABLE status_updates ADD COLUMN expires_at INT NOT NULL DEFAULT 0;
+ALTER TABLE settings ADD COLUMN status_schedule BLOB;
+ALTER TABLE settings_sync_clock ADD COLUMN status_schedule INTEGER NOT NULL DEFAULT 0;
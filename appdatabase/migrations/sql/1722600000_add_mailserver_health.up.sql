@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS mailserver_health (
+  mailserver_id VARCHAR NOT NULL PRIMARY KEY,
+  queries INTEGER NOT NULL DEFAULT 0,
+  failures INTEGER NOT NULL DEFAULT 0,
+  gaps INTEGER NOT NULL DEFAULT 0,
+  last_success INTEGER NOT NULL DEFAULT 0,
+  last_failure INTEGER NOT NULL DEFAULT 0,
+  response_times BLOB
+);
+
+CREATE TABLE IF NOT EXISTS mailserver_topic_coverage (
+  mailserver_id VARCHAR NOT NULL,
+  pubsub_topic VARCHAR NOT NULL,
+  topic VARCHAR NOT NULL,
+  from_ts INTEGER NOT NULL,
+  to_ts INTEGER NOT NULL,
+  PRIMARY KEY (mailserver_id, pubsub_topic, topic)
+);
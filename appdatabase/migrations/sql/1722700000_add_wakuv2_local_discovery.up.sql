@@ -0,0 +1 @@
+ALTER TABLE wakuv2_config ADD COLUMN enable_local_discovery BOOLEAN DEFAULT false;
@@ -0,0 +1,2 @@
+ALTER TABLE shhext_config ADD COLUMN max_incoming_messages_per_sender_per_minute INT DEFAULT 0;
+ALTER TABLE shhext_config ADD COLUMN spam_quarantine_score INT DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN contact_requests_policy BLOB;
+ALTER TABLE settings_sync_clock ADD COLUMN contact_requests_policy INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+ALTER TABLE settings ADD COLUMN url_unfurling_privacy BLOB;
+ALTER TABLE settings_sync_clock ADD COLUMN url_unfurling_privacy INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS archive_transports_config (
+  directory_path VARCHAR NOT NULL DEFAULT '',
+  directory_base_url VARCHAR NOT NULL DEFAULT '',
+  http_upload_url VARCHAR NOT NULL DEFAULT '',
+  http_public_url VARCHAR NOT NULL DEFAULT '',
+  http_allowed_hosts VARCHAR NOT NULL DEFAULT '',
+  ipfs_api_url VARCHAR NOT NULL DEFAULT '',
+  ipfs_gateway_url VARCHAR NOT NULL DEFAULT '',
+  synthetic_id VARCHAR DEFAULT 'id' PRIMARY KEY
+) WITHOUT ROWID;
@@ -0,0 +1 @@
+ALTER TABLE archive_transports_config ADD COLUMN data_dir VARCHAR NOT NULL DEFAULT '';
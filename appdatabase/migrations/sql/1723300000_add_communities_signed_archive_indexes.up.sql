@@ -0,0 +1,3 @@
+CREATE TABLE IF NOT EXISTS communities_signed_archive_indexes (
+  community_id TEXT PRIMARY KEY ON CONFLICT REPLACE
+);
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+)
+
+// messageDelivery prints the delivery timeline of a message sent by a running `serve` instance
+func messageDelivery(cCtx *cli.Context) error {
+	port := cCtx.Int(PortFlag)
+	messageID := cCtx.String(MessageIDFlag)
+
+	client, err := rpc.DialContext(cCtx.Context, fmt.Sprintf("http://127.0.0.1:%d", port))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var diagnostics json.RawMessage
+	err = client.CallContext(cCtx.Context, &diagnostics, "wakuext_getMessageDeliveryDiagnostics", messageID)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(diagnostics, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
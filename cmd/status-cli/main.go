@@ -25,6 +25,8 @@ const KeyUIDFlag = "key-uid"
 const FleetFlag = "fleet"
 const DebugLevel = "debug"
 const MessageFailureFlag = "fail"
+const MessageIDFlag = "message-id"
+const LANFlag = "lan"
 
 const RetrieveInterval = 300 * time.Millisecond
 const SendInterval = 1 * time.Second
@@ -60,6 +62,11 @@ var CommonFlags = []cli.Flag{
 		Value:    "status.staging",
 		Required: false,
 	},
+	&cli.BoolFlag{
+		Name:  LANFlag,
+		Usage: "Discover peers on the local network with mDNS instead of connecting to the fleet",
+		Value: false,
+	},
 }
 
 var SimulateFlags = append([]cli.Flag{
@@ -172,6 +179,28 @@ func main() {
 					return serve(cCtx)
 				},
 			},
+			{
+				Name:    "message-delivery",
+				Aliases: []string{"md"},
+				Usage:   "Print the delivery timeline of a message sent by a running server",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     MessageIDFlag,
+						Aliases:  []string{"id"},
+						Usage:    "ID of the message",
+						Required: true,
+					},
+					&cli.IntFlag{
+						Name:    PortFlag,
+						Aliases: []string{"p"},
+						Value:   8545,
+						Usage:   "HTTP port of the running server",
+					},
+				},
+				Action: func(cCtx *cli.Context) error {
+					return messageDelivery(cCtx)
+				},
+			},
 		},
 	}
 
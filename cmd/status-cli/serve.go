@@ -25,6 +25,7 @@ func serve(cCtx *cli.Context) error {
 	keyUID := cCtx.String(KeyUIDFlag)
 	isDebugLevel := cCtx.Bool(DebugLevel)
 	fleet := cCtx.String(FleetFlag)
+	lan := cCtx.Bool(LANFlag)
 	cmdName := cCtx.Command.Name
 
 	logger, err := getSLogger(isDebugLevel)
@@ -42,6 +43,7 @@ func serve(cCtx *cli.Context) error {
 		TelemetryURL: telemetryUrl,
 		KeyUID:       keyUID,
 		Fleet:        fleet,
+		LAN:          lan,
 	}, logger)
 	if err != nil {
 		return err
@@ -37,12 +37,14 @@ func simulate(cCtx *cli.Context) error {
 	telemetryUrl := cCtx.String(TelemetryServerURLFlag)
 	failMessages := cCtx.Bool(MessageFailureFlag)
 	fleet := cCtx.String(FleetFlag)
+	lan := cCtx.Bool(LANFlag)
 
 	alice, err := start(StartParams{
 		Name:         "Alice",
 		APIModules:   apiModules,
 		TelemetryURL: telemetryUrl,
 		Fleet:        fleet,
+		LAN:          lan,
 	}, logger.Named("alice"))
 	if err != nil {
 		return err
@@ -54,6 +56,7 @@ func simulate(cCtx *cli.Context) error {
 		APIModules:   apiModules,
 		TelemetryURL: telemetryUrl,
 		Fleet:        fleet,
+		LAN:          lan,
 	}, logger.Named("charlie"))
 	if err != nil {
 		return err
@@ -45,6 +45,7 @@ type StartParams struct {
 	TelemetryURL string
 	KeyUID       string
 	Fleet        string
+	LAN          bool
 }
 
 func start(p StartParams, logger *zap.SugaredLogger) (*StatusCLI, error) {
@@ -155,6 +156,14 @@ func createAccountAndLogin(b *api.GethStatusBackend, rootDataDir, password strin
 		},
 		TelemetryServerURL: p.TelemetryURL,
 	}
+	if p.LAN {
+		// No bootstrap node, the peers are only found on the local network
+		return b.CreateAccountAndLogin(req,
+			params.WithLocalDiscovery(),
+			params.WithDiscV5BootstrapNodes(nil),
+			params.WithWakuNodes(nil),
+		)
+	}
 	return b.CreateAccountAndLogin(req,
 		params.WithFleet(p.Fleet),
 		params.WithDiscV5BootstrapNodes(params.DefaultDiscV5Nodes(p.Fleet)),
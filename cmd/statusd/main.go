@@ -307,11 +307,7 @@ func main() {
 		if config.PushNotificationServerConfig.Enabled {
 			options := []protocol.Option{
 				protocol.WithPushNotifications(),
-				protocol.WithPushNotificationServerConfig(&pushnotificationserver.Config{
-					Enabled:   config.PushNotificationServerConfig.Enabled,
-					Identity:  config.PushNotificationServerConfig.Identity,
-					GorushURL: config.PushNotificationServerConfig.GorushURL,
-				}),
+				protocol.WithPushNotificationServerConfig(pushNotificationServerConfig(&config.PushNotificationServerConfig)),
 				protocol.WithDatabase(appDB),
 				protocol.WithWalletDatabase(walletDB),
 				protocol.WithTorrentConfig(&config.TorrentConfig),
@@ -593,3 +589,34 @@ func startNode(config *params.NodeConfig, backend *api.GethStatusBackend, instal
 
 	return appDB, walletDB, nil
 }
+
+func pushNotificationServerConfig(config *params.PushNotificationServerConfig) *pushnotificationserver.Config {
+	serverConfig := &pushnotificationserver.Config{
+		Enabled:                            config.Enabled,
+		Identity:                           config.Identity,
+		GorushURL:                          config.GorushURL,
+		MaxNotificationsPerSenderPerMinute: config.MaxNotificationsPerSenderPerMinute,
+		MaxNotificationsPerTokenPerMinute:  config.MaxNotificationsPerTokenPerMinute,
+		DuplicateNotificationWindow:        time.Duration(config.DuplicateNotificationWindowSeconds) * time.Second,
+	}
+	if config.APNs != nil {
+		serverConfig.APNs = &pushnotificationserver.APNsConfig{
+			KeyID:      config.APNs.KeyID,
+			TeamID:     config.APNs.TeamID,
+			PrivateKey: config.APNs.PrivateKey,
+			Sandbox:    config.APNs.Sandbox,
+		}
+	}
+	if config.FCM != nil {
+		serverConfig.FCM = &pushnotificationserver.FCMConfig{
+			ServiceAccount: config.FCM.ServiceAccount,
+		}
+	}
+	if config.Webhook != nil {
+		serverConfig.Webhook = &pushnotificationserver.WebhookConfig{
+			URL:                 config.Webhook.URL,
+			AuthorizationHeader: config.Webhook.AuthorizationHeader,
+		}
+	}
+	return serverConfig
+}
@@ -1262,6 +1262,42 @@ func InputConnectionStringForBootstrappingAnotherDevice(cs, configJSON string) s
 	return makeJSONResponse(err)
 }
 
+// GetShortCodeForConnectionString generates a numeric short code for the connection string of a started
+// pairing server, and announces it on the local network. The connection string is sent to the device
+// the short code is entered on, once both users confirmed the short authentication string.
+func GetShortCodeForConnectionString(cs string) string {
+	code, err := pairing.StartUpShortCodeResponder(cs)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return code
+}
+
+// InputShortCodeForPairing finds the device displaying the given short code on the local network
+// and returns its connection string, once both users confirmed the short authentication string.
+// The connection string is then used as a scanned one.
+func InputShortCodeForPairing(code string) string {
+	cs, err := pairing.ConnectWithShortCode(code)
+	if err != nil {
+		return makeJSONResponse(err)
+	}
+	return cs
+}
+
+// ConfirmShortCodePairing confirms or rejects the short authentication string of the short code pairing
+// in progress, sent with the `short-code-sas` local pairing event
+func ConfirmShortCodePairing(confirmed bool) string {
+	err := pairing.ConfirmShortCodePairing(confirmed)
+	return makeJSONResponse(err)
+}
+
+// CancelLocalPairing cancels the local pairing in progress, stopping the transfer.
+// If an account was partially received, its keys and multiaccount are removed
+func CancelLocalPairing() string {
+	err := pairing.CancelPairing()
+	return makeJSONResponse(err)
+}
+
 // GetConnectionStringForExportingKeypairsKeystores starts a pairing.SenderServer
 // then generates a pairing.ConnectionParams. Used when the device is Logged in and therefore has Account keys
 // and the device might not have a camera, to transfer kestore files of provided key uids.
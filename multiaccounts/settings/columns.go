@@ -39,6 +39,17 @@ var (
 		dBColumnName:   "chaos_mode",
 		valueHandler:   BoolHandler,
 	}
+	ContactRequestsPolicy = SettingField{
+		reactFieldName: "contact-requests-policy",
+		dBColumnName:   "contact_requests_policy",
+		valueHandler:   JSONBlobHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     contactRequestsPolicyProtobufFactory,
+			fromStruct:        contactRequestsPolicyProtobufFactoryStruct,
+			valueFromProtobuf: BytesFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_CONTACT_REQUESTS_POLICY,
+		},
+	}
 	Currency = SettingField{
 		reactFieldName: "currency",
 		dBColumnName:   "currency",
@@ -366,6 +377,17 @@ var (
 			protobufType:      protobuf.SyncSetting_STICKERS_PACKS_PENDING,
 		},
 	}
+	StatusSchedule = SettingField{
+		reactFieldName: "status-schedule",
+		dBColumnName:   "status_schedule",
+		valueHandler:   JSONBlobHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     statusScheduleProtobufFactory,
+			fromStruct:        statusScheduleProtobufFactoryStruct,
+			valueFromProtobuf: BytesFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_STATUS_SCHEDULE,
+		},
+	}
 	StickersRecentStickers = SettingField{
 		reactFieldName: "stickers/recent-stickers",
 		dBColumnName:   "stickers_recent_stickers",
@@ -496,6 +518,17 @@ var (
 			protobufType:      protobuf.SyncSetting_URL_UNFURLING_MODE,
 		},
 	}
+	URLUnfurlingPrivacy = SettingField{
+		reactFieldName: "url-unfurling-privacy",
+		dBColumnName:   "url_unfurling_privacy",
+		valueHandler:   JSONBlobHandler,
+		syncProtobufFactory: &SyncProtobufFactory{
+			fromInterface:     urlUnfurlingPrivacyProtobufFactory,
+			fromStruct:        urlUnfurlingPrivacyProtobufFactoryStruct,
+			valueFromProtobuf: BytesFromSyncProtobuf,
+			protobufType:      protobuf.SyncSetting_URL_UNFURLING_PRIVACY,
+		},
+	}
 	OmitTransfersHistoryScan = SettingField{
 		reactFieldName: "omit-transfers-history-scan",
 		dBColumnName:   "omit_transfers_history_scan",
@@ -521,6 +554,7 @@ var (
 		ChaosMode,
 		CollectibleGroupByCollection,
 		CollectibleGroupByCommunity,
+		ContactRequestsPolicy,
 		Currency,
 		CurrentUserStatus,
 		CustomBootNodes,
@@ -574,6 +608,7 @@ var (
 		SendPushNotifications,
 		SendStatusUpdates,
 		ShowCommunityAssetWhenSendingTokens,
+		StatusSchedule,
 		StickersPacksInstalled,
 		StickersPacksPending,
 		StickersRecentStickers,
@@ -582,6 +617,7 @@ var (
 		TestNetworksEnabled,
 		TokenGroupByCommunity,
 		URLUnfurlingMode,
+		URLUnfurlingPrivacy,
 		UseMailservers,
 		WakuBloomFilterMode,
 		WalletRootAddress,
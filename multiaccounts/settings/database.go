@@ -390,7 +390,7 @@ func (db *Database) GetSettings() (Settings, error) {
 		test_networks_enabled, mutual_contact_enabled, profile_migration_needed, is_goerli_enabled, wallet_token_preferences_group_by_community, url_unfurling_mode,
 		omit_transfers_history_scan, mnemonic_was_not_shown, wallet_show_community_asset_when_sending_tokens, wallet_display_assets_below_balance,
 		wallet_display_assets_below_balance_threshold, wallet_collectible_preferences_group_by_collection, wallet_collectible_preferences_group_by_community, 
-		peer_syncing_enabled
+		peer_syncing_enabled, status_schedule, contact_requests_policy, url_unfurling_privacy
 	FROM
 		settings
 	WHERE
@@ -475,6 +475,9 @@ func (db *Database) GetSettings() (Settings, error) {
 		&s.CollectibleGroupByCollection,
 		&s.CollectibleGroupByCommunity,
 		&s.PeerSyncingEnabled,
+		&sqlite.JSONBlob{Data: &s.StatusSchedule},
+		&sqlite.JSONBlob{Data: &s.ContactRequestsPolicy},
+		&sqlite.JSONBlob{Data: &s.URLUnfurlingPrivacy},
 	)
 
 	return s, err
@@ -581,6 +584,30 @@ func (db *Database) GetCurrentStatus(status interface{}) error {
 	return err
 }
 
+func (db *Database) GetStatusSchedule(schedule interface{}) error {
+	err := db.makeSelectRow(StatusSchedule).Scan(&sqlite.JSONBlob{Data: &schedule})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	return err
+}
+
+func (db *Database) GetContactRequestsPolicy(policy interface{}) error {
+	err := db.makeSelectRow(ContactRequestsPolicy).Scan(&sqlite.JSONBlob{Data: &policy})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	return err
+}
+
+func (db *Database) GetURLUnfurlingPrivacy(privacy interface{}) error {
+	err := db.makeSelectRow(URLUnfurlingPrivacy).Scan(&sqlite.JSONBlob{Data: &privacy})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	return err
+}
+
 func (db *Database) ShouldBroadcastUserStatus() (result bool, err error) {
 	err = db.makeSelectRow(SendStatusUpdates).Scan(&result)
 	// If the `send_status_updates` value is nil the sql.ErrNoRows will be returned
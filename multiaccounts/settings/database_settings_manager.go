@@ -26,6 +26,9 @@ type DatabaseSettingsManager interface {
 	GetProfilePicturesShowTo() (result int64, err error)
 	GetLatestDerivedPath() (result uint, err error)
 	GetCurrentStatus(status interface{}) error
+	GetStatusSchedule(schedule interface{}) error
+	GetContactRequestsPolicy(policy interface{}) error
+	GetURLUnfurlingPrivacy(privacy interface{}) error
 	GetMnemonicWasNotShown() (result bool, err error)
 	GetPreferredUsername() (string, error)
 	GetCurrency() (string, error)
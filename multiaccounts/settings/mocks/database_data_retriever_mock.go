@@ -189,6 +189,48 @@ func (mr *MockDatabaseSettingsManagerMockRecorder) GetCurrentStatus(status inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentStatus", reflect.TypeOf((*MockDatabaseSettingsManager)(nil).GetCurrentStatus), status)
 }
 
+// GetStatusSchedule mocks base method.
+func (m *MockDatabaseSettingsManager) GetStatusSchedule(schedule interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusSchedule", schedule)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetStatusSchedule indicates an expected call of GetStatusSchedule.
+func (mr *MockDatabaseSettingsManagerMockRecorder) GetStatusSchedule(schedule interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusSchedule", reflect.TypeOf((*MockDatabaseSettingsManager)(nil).GetStatusSchedule), schedule)
+}
+
+// GetContactRequestsPolicy mocks base method.
+func (m *MockDatabaseSettingsManager) GetContactRequestsPolicy(policy interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactRequestsPolicy", policy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetContactRequestsPolicy indicates an expected call of GetContactRequestsPolicy.
+func (mr *MockDatabaseSettingsManagerMockRecorder) GetContactRequestsPolicy(policy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactRequestsPolicy", reflect.TypeOf((*MockDatabaseSettingsManager)(nil).GetContactRequestsPolicy), policy)
+}
+
+// GetURLUnfurlingPrivacy mocks base method.
+func (m *MockDatabaseSettingsManager) GetURLUnfurlingPrivacy(privacy interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetURLUnfurlingPrivacy", privacy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GetURLUnfurlingPrivacy indicates an expected call of GetURLUnfurlingPrivacy.
+func (mr *MockDatabaseSettingsManagerMockRecorder) GetURLUnfurlingPrivacy(privacy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetURLUnfurlingPrivacy", reflect.TypeOf((*MockDatabaseSettingsManager)(nil).GetURLUnfurlingPrivacy), privacy)
+}
+
 // GetWalletRootAddress mocks base method.
 func (m *MockDatabaseSettingsManager) GetWalletRootAddress() (types.Address, error) {
 	m.ctrl.T.Helper()
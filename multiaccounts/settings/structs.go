@@ -224,6 +224,9 @@ type Settings struct {
 	CollectibleGroupByCommunity         bool                          `json:"collectible-group-by-community?,omitempty"`
 	URLUnfurlingMode                    URLUnfurlingModeType          `json:"url-unfurling-mode,omitempty"`
 	PeerSyncingEnabled                  bool                          `json:"peer-syncing-enabled?,omitempty"`
+	StatusSchedule                      *json.RawMessage              `json:"status-schedule,omitempty"`
+	ContactRequestsPolicy               *json.RawMessage              `json:"contact-requests-policy,omitempty"`
+	URLUnfurlingPrivacy                 *json.RawMessage              `json:"url-unfurling-privacy,omitempty"`
 }
 
 func (s Settings) MarshalJSON() ([]byte, error) {
@@ -315,6 +315,84 @@ func stickersPacksPendingProtobufFactoryStruct(s Settings, clock uint64, chatID
 	return buildRawStickerPacksPendingSyncMessage(spp, clock, chatID)
 }
 
+// ContactRequestsPolicy
+
+func buildRawContactRequestsPolicySyncMessage(v []byte, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_CONTACT_REQUESTS_POLICY,
+		Value: &protobuf.SyncSetting_ValueBytes{ValueBytes: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func contactRequestsPolicyProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := parseJSONBlobData(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawContactRequestsPolicySyncMessage(v, clock, chatID)
+}
+
+func contactRequestsPolicyProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	crp := extractJSONRawMessage(s.ContactRequestsPolicy)
+	return buildRawContactRequestsPolicySyncMessage(crp, clock, chatID)
+}
+
+// URLUnfurlingPrivacy
+
+func buildRawURLUnfurlingPrivacySyncMessage(v []byte, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_URL_UNFURLING_PRIVACY,
+		Value: &protobuf.SyncSetting_ValueBytes{ValueBytes: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func urlUnfurlingPrivacyProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := parseJSONBlobData(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawURLUnfurlingPrivacySyncMessage(v, clock, chatID)
+}
+
+func urlUnfurlingPrivacyProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	up := extractJSONRawMessage(s.URLUnfurlingPrivacy)
+	return buildRawURLUnfurlingPrivacySyncMessage(up, clock, chatID)
+}
+
+// StatusSchedule
+
+func buildRawStatusScheduleSyncMessage(v []byte, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	pb := &protobuf.SyncSetting{
+		Type:  protobuf.SyncSetting_STATUS_SCHEDULE,
+		Value: &protobuf.SyncSetting_ValueBytes{ValueBytes: v},
+		Clock: clock,
+	}
+	rm, err := buildRawSyncSettingMessage(pb, chatID)
+	return rm, pb, err
+}
+
+func statusScheduleProtobufFactory(value interface{}, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	v, err := parseJSONBlobData(value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buildRawStatusScheduleSyncMessage(v, clock, chatID)
+}
+
+func statusScheduleProtobufFactoryStruct(s Settings, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
+	ss := extractJSONRawMessage(s.StatusSchedule)
+	return buildRawStatusScheduleSyncMessage(ss, clock, chatID)
+}
+
 // StickersRecentStickers
 
 func buildRawStickersRecentStickersSyncMessage(v []byte, clock uint64, chatID string) (*common.RawMessage, *protobuf.SyncSetting, error) {
@@ -332,6 +332,7 @@ func (b *StatusNode) wakuV2Service(nodeConfig *params.NodeConfig) (*wakuv2.Waku,
 			DiscV5BootstrapNodes:                   nodeConfig.ClusterConfig.DiscV5BootstrapNodes,
 			Nameserver:                             nodeConfig.WakuV2Config.Nameserver,
 			UDPPort:                                nodeConfig.WakuV2Config.UDPPort,
+			EnableLocalDiscovery:                   nodeConfig.WakuV2Config.EnableLocalDiscovery,
 			AutoUpdate:                             nodeConfig.WakuV2Config.AutoUpdate,
 			DefaultShardPubsubTopic:                shard.DefaultShardPubsubTopic(),
 			TelemetryServerURL:                     nodeConfig.WakuV2Config.TelemetryServerURL,
@@ -3,6 +3,7 @@ package nodecfg
 import (
 	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/p2p/discv5"
@@ -203,6 +204,19 @@ func insertTorrentConfig(tx *sql.Tx, c *params.NodeConfig) error {
 	return err
 }
 
+func insertArchiveTransportsConfig(tx *sql.Tx, c *params.NodeConfig) error {
+	_, err := tx.Exec(`
+  INSERT OR REPLACE INTO archive_transports_config (
+    data_dir, directory_path, directory_base_url, http_upload_url, http_public_url, http_allowed_hosts,
+    ipfs_api_url, ipfs_gateway_url, synthetic_id
+  ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, 'id')`,
+		c.ArchiveTransportsConfig.DataDir, c.ArchiveTransportsConfig.DirectoryPath, c.ArchiveTransportsConfig.DirectoryBaseURL,
+		c.ArchiveTransportsConfig.HTTPUploadURL, c.ArchiveTransportsConfig.HTTPPublicURL, strings.Join(c.ArchiveTransportsConfig.HTTPAllowedHosts, ","),
+		c.ArchiveTransportsConfig.IPFSAPIURL, c.ArchiveTransportsConfig.IPFSGatewayURL,
+	)
+	return err
+}
+
 func insertWakuV2ConfigPreMigration(tx *sql.Tx, c *params.NodeConfig) error {
 	_, err := tx.Exec(`
 	INSERT OR REPLACE INTO wakuv2_config (
@@ -234,6 +248,17 @@ func setWakuV2CustomNodes(tx *sql.Tx, customNodes map[string]string) error {
 	return nil
 }
 
+func insertShhExtConfigPostMigration(tx *sql.Tx, c *params.NodeConfig) error {
+	_, err := tx.Exec(`
+	UPDATE shhext_config
+	SET max_incoming_messages_per_sender_per_minute = ?,
+		spam_quarantine_score = ?
+	WHERE synthetic_id = 'id'`,
+		c.ShhextConfig.MaxIncomingMessagesPerSenderPerMinute, c.ShhextConfig.SpamQuarantineScore,
+	)
+	return err
+}
+
 func insertWakuV2ConfigPostMigration(tx *sql.Tx, c *params.NodeConfig) error {
 	_, err := tx.Exec(`
 	UPDATE wakuv2_config
@@ -241,10 +266,12 @@ func insertWakuV2ConfigPostMigration(tx *sql.Tx, c *params.NodeConfig) error {
 		store_capacity = ?,
 		store_seconds = ?,
 		enable_missing_message_verification = ?,
-		enable_store_confirmation_for_messages_sent = ?
+		enable_store_confirmation_for_messages_sent = ?,
+		enable_local_discovery = ?
 	WHERE synthetic_id = 'id'`,
 		c.WakuV2Config.EnableStore, c.WakuV2Config.StoreCapacity, c.WakuV2Config.StoreSeconds,
 		c.WakuV2Config.EnableMissingMessageVerification, c.WakuV2Config.EnableStoreConfirmationForMessagesSent,
+		c.WakuV2Config.EnableLocalDiscovery,
 	)
 
 	if err != nil {
@@ -372,7 +399,9 @@ func nodeConfigNormalInserts() []insertFn {
 		insertWakuV1Config,
 		insertWakuV2ConfigPreMigration,
 		insertTorrentConfig,
+		insertArchiveTransportsConfig,
 		insertWakuV2ConfigPostMigration,
+		insertShhExtConfigPostMigration,
 	}
 }
 
@@ -637,6 +666,13 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 		return nil, err
 	}
 
+	err = tx.QueryRow(`SELECT max_incoming_messages_per_sender_per_minute, spam_quarantine_score FROM shhext_config WHERE synthetic_id = 'id'`).Scan(
+		&nodecfg.ShhextConfig.MaxIncomingMessagesPerSenderPerMinute, &nodecfg.ShhextConfig.SpamQuarantineScore,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
 	rows, err = tx.Query(`SELECT public_key FROM shhext_default_push_notification_servers WHERE synthetic_id = 'id' ORDER BY public_key ASC`)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
@@ -673,11 +709,28 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 		return nil, err
 	}
 
+	var httpAllowedHosts string
+	err = tx.QueryRow(`
+  SELECT data_dir, directory_path, directory_base_url, http_upload_url, http_public_url, http_allowed_hosts,
+  ipfs_api_url, ipfs_gateway_url
+  FROM archive_transports_config WHERE synthetic_id = 'id'
+  `).Scan(
+		&nodecfg.ArchiveTransportsConfig.DataDir, &nodecfg.ArchiveTransportsConfig.DirectoryPath, &nodecfg.ArchiveTransportsConfig.DirectoryBaseURL,
+		&nodecfg.ArchiveTransportsConfig.HTTPUploadURL, &nodecfg.ArchiveTransportsConfig.HTTPPublicURL, &httpAllowedHosts,
+		&nodecfg.ArchiveTransportsConfig.IPFSAPIURL, &nodecfg.ArchiveTransportsConfig.IPFSGatewayURL,
+	)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	if httpAllowedHosts != "" {
+		nodecfg.ArchiveTransportsConfig.HTTPAllowedHosts = strings.Split(httpAllowedHosts, ",")
+	}
+
 	err = tx.QueryRow(`
 	SELECT enabled, host, port, light_client, full_node, discovery_limit, data_dir,
 	max_message_size, enable_confirmations, peer_exchange, enable_discv5, udp_port, auto_update,
 	enable_store, store_capacity, store_seconds, enable_missing_message_verification,
-	enable_store_confirmation_for_messages_sent
+	enable_store_confirmation_for_messages_sent, enable_local_discovery
 	FROM wakuv2_config WHERE synthetic_id = 'id'
 	`).Scan(
 		&nodecfg.WakuV2Config.Enabled, &nodecfg.WakuV2Config.Host, &nodecfg.WakuV2Config.Port, &nodecfg.WakuV2Config.LightClient, &nodecfg.WakuV2Config.FullNode,
@@ -685,6 +738,7 @@ func loadNodeConfig(tx *sql.Tx) (*params.NodeConfig, error) {
 		&nodecfg.WakuV2Config.PeerExchange, &nodecfg.WakuV2Config.EnableDiscV5, &nodecfg.WakuV2Config.UDPPort, &nodecfg.WakuV2Config.AutoUpdate,
 		&nodecfg.WakuV2Config.EnableStore, &nodecfg.WakuV2Config.StoreCapacity, &nodecfg.WakuV2Config.StoreSeconds,
 		&nodecfg.WakuV2Config.EnableMissingMessageVerification, &nodecfg.WakuV2Config.EnableStoreConfirmationForMessagesSent,
+		&nodecfg.WakuV2Config.EnableLocalDiscovery,
 	)
 	if err != nil && err != sql.ErrNoRows {
 		return nil, err
@@ -798,6 +852,16 @@ func SetStoreConfirmationForMessagesSent(db *sql.DB, enabled bool) error {
 	return err
 }
 
+func SetLocalDiscovery(db *sql.DB, enabled bool) error {
+	_, err := db.Exec(`UPDATE wakuv2_config SET enable_local_discovery = ?`, enabled)
+	return err
+}
+
+func SetIncomingMessagesRateLimit(db *sql.DB, maxPerSenderPerMinute int, quarantineScore int) error {
+	_, err := db.Exec(`UPDATE shhext_config SET max_incoming_messages_per_sender_per_minute = ?, spam_quarantine_score = ?`, maxPerSenderPerMinute, quarantineScore)
+	return err
+}
+
 func SetLogLevel(db *sql.DB, logLevel string) error {
 	_, err := db.Exec(`UPDATE log_config SET log_level = ?`, logLevel)
 	return err
@@ -198,6 +198,10 @@ type WakuV2Config struct {
 	// UDPPort number to start discovery v5
 	UDPPort int
 
+	// EnableLocalDiscovery indicates if the peers on the local network are discovered with mDNS,
+	// which allows exchanging messages on a LAN without any fleet
+	EnableLocalDiscovery bool
+
 	// AutoUpdate instructs the node to update their own ip address and port with the values seen by other nodes
 	AutoUpdate bool
 
@@ -511,6 +515,9 @@ type NodeConfig struct {
 
 	TorrentConfig TorrentConfig
 
+	// ArchiveTransportsConfig configures the transports distributing community history archives next to BitTorrent
+	ArchiveTransportsConfig ArchiveTransportsConfig
+
 	// RegisterTopics a list of specific topics where the peer wants to be
 	// discoverable.
 	RegisterTopics []discv5.Topic `json:"RegisterTopics"`
@@ -653,6 +660,39 @@ type PushNotificationServerConfig struct {
 	Enabled   bool
 	Identity  *ecdsa.PrivateKey
 	GorushURL string
+	// APNs enables the native delivery of APN notifications, instead of going through gorush
+	APNs *PushNotificationAPNsConfig `json:"APNs,omitempty"`
+	// FCM enables the native delivery of Firebase notifications, instead of going through gorush
+	FCM *PushNotificationFCMConfig `json:"FCM,omitempty"`
+	// Webhook delivers the notifications to a custom endpoint, instead of gorush
+	Webhook *PushNotificationWebhookConfig `json:"Webhook,omitempty"`
+	// MaxNotificationsPerSenderPerMinute limits the notifications from a single author in a single chat
+	MaxNotificationsPerSenderPerMinute int `json:"MaxNotificationsPerSenderPerMinute,omitempty"`
+	// MaxNotificationsPerTokenPerMinute limits the notifications sent to a single device
+	MaxNotificationsPerTokenPerMinute int `json:"MaxNotificationsPerTokenPerMinute,omitempty"`
+	// DuplicateNotificationWindowSeconds is the period during which identical notifications are only sent once
+	DuplicateNotificationWindowSeconds int `json:"DuplicateNotificationWindowSeconds,omitempty"`
+}
+
+// PushNotificationAPNsConfig is the token-based authentication config for APNs
+type PushNotificationAPNsConfig struct {
+	KeyID  string
+	TeamID string
+	// PrivateKey is the PEM encoded .p8 key
+	PrivateKey string
+	Sandbox    bool
+}
+
+// PushNotificationFCMConfig is the config for the FCM HTTP v1 API
+type PushNotificationFCMConfig struct {
+	// ServiceAccount is the JSON service account key
+	ServiceAccount string
+}
+
+// PushNotificationWebhookConfig is the config for delivering notifications to a webhook
+type PushNotificationWebhookConfig struct {
+	URL                 string
+	AuthorizationHeader string
 }
 
 // ShhextConfig defines options used by shhext service.
@@ -717,6 +757,21 @@ type ShhextConfig struct {
 
 	// BandwidthStatsEnabled indicates if a signal is going to be emitted to indicate the upload and download rate
 	BandwidthStatsEnabled bool
+
+	// ParallelHistoryStorenodes is the number of storenodes history requests are spread over,
+	// only the active storenode is used when lower than 2
+	ParallelHistoryStorenodes int
+
+	// MaxConcurrentHistoryQueries is the maximum number of history queries in flight when fetching from several storenodes
+	MaxConcurrentHistoryQueries int
+
+	// MaxIncomingMessagesPerSenderPerMinute limits the chat messages, reactions and contact requests handled
+	// for a single sender, the excess is dropped. 0 disables the limit
+	MaxIncomingMessagesPerSenderPerMinute int
+
+	// SpamQuarantineScore is the number of recently dropped messages above which a sender is quarantined,
+	// a default is used when 0
+	SpamQuarantineScore int
 }
 
 // TorrentConfig provides configuration for the BitTorrent client used for message history archives.
@@ -731,6 +786,28 @@ type TorrentConfig struct {
 	TorrentDir string
 }
 
+// ArchiveTransportsConfig provides configuration for the transports distributing message history archives
+// next to BitTorrent. A transport is enabled when its fields are set.
+type ArchiveTransportsConfig struct {
+	// DataDir is the directory archives are downloaded to, and stored in when BitTorrent isn't configured
+	DataDir string
+	// DirectoryPath is the directory archives are copied to, archives are only read from this directory
+	DirectoryPath string
+	// DirectoryBaseURL is the URL DirectoryPath is served at, file URLs are advertised when empty
+	DirectoryBaseURL string
+	// HTTPUploadURL is the URL of the HTTP(S) mirror archives are uploaded to
+	HTTPUploadURL string
+	// HTTPPublicURL is the URL the mirror serves the archives at, HTTPUploadURL is used when empty
+	HTTPPublicURL string
+	// HTTPAllowedHosts are the hosts archives are fetched from over HTTPS, next to the host of the mirror.
+	// Archives advertised on other hosts aren't fetched to avoid leaking the user's IP address
+	HTTPAllowedHosts []string
+	// IPFSAPIURL is the HTTP API of the IPFS node archives are added to
+	IPFSAPIURL string
+	// IPFSGatewayURL is the gateway archives are fetched from, IpfsGatewayURL is used when empty
+	IPFSGatewayURL string
+}
+
 // Validate validates the ShhextConfig struct and returns an error if inconsistent values are found
 func (c *ShhextConfig) Validate(validate *validator.Validate) error {
 	if err := validate.Struct(c); err != nil {
@@ -776,6 +853,14 @@ func WithDiscV5BootstrapNodes(nodes []string) Option {
 	}
 }
 
+// WithLocalDiscovery enables the discovery of the peers on the local network.
+func WithLocalDiscovery() Option {
+	return func(c *NodeConfig) error {
+		c.WakuV2Config.EnableLocalDiscovery = true
+		return nil
+	}
+}
+
 func WithWakuNodes(nodes []string) Option {
 	return func(c *NodeConfig) error {
 		c.ClusterConfig.WakuNodes = nodes
@@ -880,6 +965,10 @@ func (c *NodeConfig) UpdateWithDefaults() error {
 		}
 	}
 
+	if c.ArchiveTransportsConfig.DataDir == "" && c.RootDataDir != "" {
+		c.ArchiveTransportsConfig.DataDir = filepath.Join(c.RootDataDir, ArchiveTransportsRelativePath)
+	}
+
 	return c.setDefaultPushNotificationsServers()
 }
 
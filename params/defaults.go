@@ -8,6 +8,9 @@ const (
 
 	ArchivesRelativePath        = "data/archivedata"
 	TorrentTorrentsRelativePath = "data/torrents"
+	// ArchiveTransportsRelativePath is where the archive transports store the history archives
+	// when BitTorrent isn't configured
+	ArchiveTransportsRelativePath = "data/archivetransports"
 
 	// SendTransactionMethodName https://docs.walletconnect.com/advanced/rpc-reference/ethereum-rpc#eth_sendtransaction
 	SendTransactionMethodName = "eth_sendTransaction"
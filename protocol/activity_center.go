@@ -40,6 +40,7 @@ const (
 	ActivityCenterNotificationTypeFirstCommunityTokenReceived
 	ActivityCenterNotificationTypeCommunityBanned
 	ActivityCenterNotificationTypeCommunityUnbanned
+	ActivityCenterNotificationTypeSafetyNumberChanged
 )
 
 type ActivityCenterMembershipStatus int
@@ -25,6 +25,14 @@ type LinkPreviewThumbnail struct {
 	DataURI string `json:"dataUri,omitempty"`
 }
 
+// LinkPreviewEmbed is the video or audio player of a link preview.
+type LinkPreviewEmbed struct {
+	Type   protobuf.UnfurledLinkEmbed_MediaType `json:"type"`
+	URL    string                               `json:"url"`
+	Width  int                                  `json:"width,omitempty"`
+	Height int                                  `json:"height,omitempty"`
+}
+
 type LinkPreview struct {
 	Type        protobuf.UnfurledLink_LinkType `json:"type"`
 	URL         string                         `json:"url"`
@@ -33,6 +41,13 @@ type LinkPreview struct {
 	Description string                         `json:"description,omitempty"`
 	Favicon     LinkPreviewThumbnail           `json:"favicon,omitempty"`
 	Thumbnail   LinkPreviewThumbnail           `json:"thumbnail,omitempty"`
+	SiteName    string                         `json:"siteName,omitempty"`
+	Author      string                         `json:"author,omitempty"`
+	// PublishedAt is the publication time in milliseconds since epoch.
+	PublishedAt uint64 `json:"publishedAt,omitempty"`
+	// Duration of the video or audio in seconds.
+	Duration uint32            `json:"duration,omitempty"`
+	Embed    *LinkPreviewEmbed `json:"embed,omitempty"`
 }
 
 type StatusContactLinkPreview struct {
@@ -151,8 +166,41 @@ func (preview *LinkPreview) validateForProto() error {
 		if err := preview.Thumbnail.validateForProto(); err != nil {
 			return fmt.Errorf("thumbnail is not valid for proto: %w", err)
 		}
+		if preview.Embed != nil && preview.Embed.URL == "" {
+			return fmt.Errorf("embed url is empty")
+		}
+		return nil
+	}
+}
+
+func (embed *LinkPreviewEmbed) convertToProto() *protobuf.UnfurledLinkEmbed {
+	if embed == nil {
 		return nil
 	}
+	return &protobuf.UnfurledLinkEmbed{
+		Type:   embed.Type,
+		Url:    embed.URL,
+		Width:  uint32(embed.Width),
+		Height: uint32(embed.Height),
+	}
+}
+
+// linkPreviewEmbedFromProto converts the embed of a link preview, the embeds whose URL
+// is not http or https are dropped, as the sender might not have checked it
+func linkPreviewEmbedFromProto(embed *protobuf.UnfurledLinkEmbed) *LinkPreviewEmbed {
+	if embed == nil {
+		return nil
+	}
+	u, err := url.Parse(embed.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil
+	}
+	return &LinkPreviewEmbed{
+		Type:   embed.Type,
+		URL:    embed.Url,
+		Width:  int(embed.Width),
+		Height: int(embed.Height),
+	}
 }
 
 func (preview *StatusLinkPreview) validateForProto() error {
@@ -314,6 +362,11 @@ func (m *Message) ConvertLinkPreviewsToProto() ([]*protobuf.UnfurledLink, error)
 			ThumbnailHeight:  uint32(preview.Thumbnail.Height),
 			ThumbnailPayload: thumbnailPayload,
 			FaviconPayload:   faviconPayload,
+			SiteName:         preview.SiteName,
+			Author:           preview.Author,
+			PublishedAt:      preview.PublishedAt,
+			Duration:         preview.Duration,
+			Embed:            preview.Embed.convertToProto(),
 		}
 		unfurledLinks = append(unfurledLinks, ul)
 	}
@@ -347,6 +400,11 @@ func (m *Message) ConvertFromProtoToLinkPreviews(makeThumbnailMediaServerURL fun
 			Title:       link.Title,
 			Type:        link.Type,
 			URL:         link.Url,
+			SiteName:    link.SiteName,
+			Author:      link.Author,
+			PublishedAt: link.PublishedAt,
+			Duration:    link.Duration,
+			Embed:       linkPreviewEmbedFromProto(link.Embed),
 		}
 		mediaURL := ""
 		if len(link.ThumbnailPayload) > 0 {
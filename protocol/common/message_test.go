@@ -265,6 +265,55 @@ func TestConvertFromProtoToLinkPreviews(t *testing.T) {
 	require.Equal(t, "", p.Thumbnail.URL)
 }
 
+func TestConvertLinkPreviewMediaToProto(t *testing.T) {
+	preview := LinkPreview{
+		Type:        protobuf.UnfurledLink_LINK,
+		Hostname:    "www.youtube.com",
+		Title:       "Status Town Hall",
+		URL:         "https://www.youtube.com/watch?v=mzOyYtfXkb0",
+		SiteName:    "YouTube",
+		Author:      "Status",
+		PublishedAt: 1696291200000,
+		Duration:    3725,
+		Embed: &LinkPreviewEmbed{
+			Type:   protobuf.UnfurledLinkEmbed_VIDEO,
+			URL:    "https://www.youtube.com/embed/mzOyYtfXkb0",
+			Width:  1280,
+			Height: 720,
+		},
+	}
+	msg := Message{
+		ID:           "42",
+		LinkPreviews: []LinkPreview{preview},
+	}
+
+	unfurledLinks, err := msg.ConvertLinkPreviewsToProto()
+	require.NoError(t, err)
+	require.Len(t, unfurledLinks, 1)
+	require.Equal(t, "YouTube", unfurledLinks[0].SiteName)
+	require.Equal(t, uint32(1280), unfurledLinks[0].Embed.Width)
+
+	msg.ChatMessage = &protobuf.ChatMessage{UnfurledLinks: unfurledLinks}
+	previews := msg.ConvertFromProtoToLinkPreviews(nil, nil)
+	require.Equal(t, []LinkPreview{preview}, previews)
+
+	// Test a received embed which isn't http or https is dropped.
+	for _, embedURL := range []string{"javascript:alert(1)", "file:///etc/passwd", "JavaScript://www.youtube.com/%0Aalert(1)"} {
+		unfurledLinks[0].Embed.Url = embedURL
+		previews = msg.ConvertFromProtoToLinkPreviews(nil, nil)
+		require.Len(t, previews, 1)
+		require.Nil(t, previews[0].Embed, embedURL)
+		require.Equal(t, preview.Title, previews[0].Title)
+	}
+
+	// Test an embed requires a URL.
+	invalidPreview := preview
+	invalidPreview.Embed = &LinkPreviewEmbed{Width: 1280, Height: 720}
+	msg.LinkPreviews = []LinkPreview{invalidPreview}
+	_, err = msg.ConvertLinkPreviewsToProto()
+	require.ErrorContains(t, err, "embed url is empty")
+}
+
 func TestConvertStatusLinkPreviewsToProto(t *testing.T) {
 	pk1, err := crypto.GenerateKey()
 	require.NoError(t, err)
@@ -258,6 +258,35 @@ func (db RawMessagesPersistence) RawMessagesIDsByType(t protobuf.ApplicationMeta
 	return ids, nil
 }
 
+// RawMessagesIDsByChatSince returns the IDs of the messages sent to the chat since the given time, in milliseconds
+func (db RawMessagesPersistence) RawMessagesIDsByChatSince(localChatID string, since uint64) ([]string, error) {
+	ids := []string{}
+
+	rows, err := db.db.Query(`
+			SELECT
+			  id
+			FROM
+				raw_messages
+			WHERE
+			local_chat_id = ? AND last_sent >= ?
+			ORDER BY last_sent ASC`,
+		localChatID, since)
+	if err != nil {
+		return ids, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return ids, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 // MarkAsConfirmed marks all the messages with dataSyncID as confirmed and returns
 // the messageIDs that can be considered confirmed.
 // If atLeastOne is set it will return messageid if at least once of the messages
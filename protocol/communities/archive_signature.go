@@ -0,0 +1,101 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"sort"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+// unsignedHistoryArchiveIndexesDeadline ends the transition period during which unsigned indexes
+// published by control nodes that predate archive signing are imported. Unsigned indexes are
+// rejected before the deadline too once the community has published a signed index, and
+// indexes carrying an invalid signature are always rejected
+var unsignedHistoryArchiveIndexesDeadline = time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+// historyArchiveHash returns the hash of the archive data, without padding
+func historyArchiveHash(data []byte) []byte {
+	return crypto.Keccak256(data)
+}
+
+// historyArchiveIndexDigest is the digest of the archive index which is signed by the community.
+// Archive IDs are hashes of the archive metadata, so signing them along with the archive hashes
+// covers the whole index.
+func historyArchiveIndexDigest(communityID types.HexBytes, index *protobuf.WakuMessageArchiveIndex) []byte {
+	archiveIDs := make([]string, 0, len(index.Archives))
+	for archiveID := range index.Archives {
+		archiveIDs = append(archiveIDs, archiveID)
+	}
+	sort.Strings(archiveIDs)
+
+	payload := bytes.NewBuffer(communityID)
+	for _, archiveID := range archiveIDs {
+		payload.WriteString(archiveID)
+		payload.Write(index.ArchiveHashes[archiveID])
+	}
+
+	return crypto.Keccak256(payload.Bytes())
+}
+
+func signHistoryArchiveIndex(communityID types.HexBytes, index *protobuf.WakuMessageArchiveIndex, privateKey *ecdsa.PrivateKey) error {
+	signature, err := crypto.Sign(historyArchiveIndexDigest(communityID, index), privateKey)
+	if err != nil {
+		return err
+	}
+	index.Signature = signature
+	return nil
+}
+
+// verifyHistoryArchiveIndex checks that the index has been signed by the community, that all
+// the archives have a hash and that the archive IDs match their metadata
+func verifyHistoryArchiveIndex(communityID types.HexBytes, index *protobuf.WakuMessageArchiveIndex) error {
+	if len(index.Signature) == 0 {
+		return ErrHistoryArchiveIndexNotSigned
+	}
+
+	communityPubKey, err := crypto.DecompressPubkey(communityID)
+	if err != nil {
+		return err
+	}
+
+	signer, err := crypto.SigToPub(historyArchiveIndexDigest(communityID, index), index.Signature)
+	if err != nil || !common.IsPubKeyEqual(signer, communityPubKey) {
+		return ErrInvalidHistoryArchiveIndexSignature
+	}
+
+	for archiveID, metadata := range index.Archives {
+		if len(index.ArchiveHashes[archiveID]) == 0 {
+			return ErrInvalidHistoryArchiveIndexSignature
+		}
+
+		metadataBytes, err := proto.Marshal(metadata)
+		if err != nil {
+			return err
+		}
+		if crypto.Keccak256Hash(metadataBytes).String() != archiveID {
+			return ErrInvalidHistoryArchiveIndexSignature
+		}
+	}
+
+	return nil
+}
+
+// verifyHistoryArchive checks the archive data, without padding, against the signed index.
+// Archives of unsigned legacy indexes, which are only loaded during the transition period,
+// have no hash to be checked against
+func verifyHistoryArchive(index *protobuf.WakuMessageArchiveIndex, archiveID string, data []byte) error {
+	if len(index.Signature) == 0 && len(index.ArchiveHashes[archiveID]) == 0 {
+		return nil
+	}
+	if !bytes.Equal(index.ArchiveHashes[archiveID], historyArchiveHash(data)) {
+		return ErrHistoryArchiveHashMismatch
+	}
+	return nil
+}
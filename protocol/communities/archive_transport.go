@@ -0,0 +1,585 @@
+package communities
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/params"
+)
+
+const (
+	archiveIndexFileName = "index"
+	archiveDataFileName  = "data"
+
+	archiveTransportHTTPTimeout = 5 * time.Minute
+)
+
+// ArchiveFiles points to the files making up the history archives of a community
+type ArchiveFiles struct {
+	IndexPath string
+	DataPath  string
+}
+
+// ArchiveTransport distributes the history archive files of communities.
+// Publishing returns the location advertised to the community members,
+// fetching downloads the archive files available at such a location.
+type ArchiveTransport interface {
+	// Name identifies the transport in logs
+	Name() string
+	// Supports tells whether the transport is able to fetch the location
+	Supports(location string) bool
+	// Publish makes the archive files of the community available and returns their location
+	Publish(communityID types.HexBytes, files ArchiveFiles) (string, error)
+	// Unpublish stops distributing the archive files of the community
+	Unpublish(communityID types.HexBytes)
+	// Fetch downloads the archive files available at the location, it returns
+	// ErrHistoryArchiveDownloadCancelled when the download is cancelled
+	Fetch(communityID types.HexBytes, location string, files ArchiveFiles, cancel chan struct{}) error
+}
+
+// defaultArchiveFetchTransports are the transports able to fetch archives
+// without any configuration, they are used when none of the configured transports
+// supports an advertised location.
+// Locations are advertised by remote control nodes, so transports reading the local
+// file system or reaching arbitrary servers must be configured explicitly
+func defaultArchiveFetchTransports() []ArchiveTransport {
+	return []ArchiveTransport{
+		&IPFSArchiveTransport{},
+	}
+}
+
+// ArchiveTransportsFromConfig builds the archive transports enabled in the config
+func ArchiveTransportsFromConfig(config *params.ArchiveTransportsConfig) []ArchiveTransport {
+	if config == nil {
+		return nil
+	}
+
+	var transports []ArchiveTransport
+	if config.DirectoryPath != "" {
+		transports = append(transports, &LocalDirectoryArchiveTransport{
+			Dir:     config.DirectoryPath,
+			BaseURL: config.DirectoryBaseURL,
+		})
+	}
+	if config.HTTPUploadURL != "" || len(config.HTTPAllowedHosts) > 0 {
+		transports = append(transports, &HTTPArchiveTransport{
+			UploadURL:    config.HTTPUploadURL,
+			PublicURL:    config.HTTPPublicURL,
+			AllowedHosts: config.HTTPAllowedHosts,
+		})
+	}
+	if config.IPFSAPIURL != "" || config.IPFSGatewayURL != "" {
+		transports = append(transports, &IPFSArchiveTransport{
+			APIURL:     config.IPFSAPIURL,
+			GatewayURL: config.IPFSGatewayURL,
+		})
+	}
+	return transports
+}
+
+// HistoryArchiveLocations merges the legacy magnet link with the advertised archive locations,
+// preserving their order and dropping duplicates
+func HistoryArchiveLocations(magnetlink string, locations []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, location := range append([]string{magnetlink}, locations...) {
+		if location == "" || seen[location] {
+			continue
+		}
+		seen[location] = true
+		result = append(result, location)
+	}
+	return result
+}
+
+func locationScheme(location string) string {
+	u, err := url.Parse(location)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Scheme)
+}
+
+// cancelContext returns a context cancelled when the cancel channel is closed
+func cancelContext(cancel chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancelCtx := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-cancel:
+			cancelCtx()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancelCtx
+}
+
+// writeArchiveFile atomically replaces the file at path with the content of r
+func writeArchiveFile(filePath string, r io.Reader) error {
+	err := os.MkdirAll(filepath.Dir(filePath), 0700)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(filePath), filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	_, err = io.Copy(file, r)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), filePath)
+}
+
+// moveArchiveFile moves the file to dst, copying it when dst is on another file system
+func moveArchiveFile(src, dst string) error {
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	return copyArchiveFile(src, dst)
+}
+
+func copyArchiveFile(src, dst string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return writeArchiveFile(dst, file)
+}
+
+// LocalDirectoryArchiveTransport publishes archives by copying them to a directory,
+// for example a directory served by a web server or shared across devices.
+// It only fetches archives located under its own directory
+type LocalDirectoryArchiveTransport struct {
+	// Dir is the directory the archives are copied to, under the community ID
+	Dir string
+	// BaseURL is the URL Dir is served at. When set, the advertised locations
+	// are HTTP(S) URLs instead of file URLs
+	BaseURL string
+}
+
+func (t *LocalDirectoryArchiveTransport) Name() string {
+	return "directory"
+}
+
+func (t *LocalDirectoryArchiveTransport) Supports(location string) bool {
+	_, ok := t.locationDir(location)
+	return ok
+}
+
+// locationDir returns the directory a file location points to, if it's under Dir
+func (t *LocalDirectoryArchiveTransport) locationDir(location string) (string, bool) {
+	if t.Dir == "" {
+		return "", false
+	}
+
+	u, err := url.Parse(location)
+	if err != nil || strings.ToLower(u.Scheme) != "file" {
+		return "", false
+	}
+
+	// Symlinks are resolved so that a link under Dir can't point outside of it
+	root, err := filepath.Abs(t.Dir)
+	if err != nil {
+		return "", false
+	}
+	root, err = filepath.EvalSymlinks(root)
+	if err != nil {
+		return "", false
+	}
+
+	dir, err := filepath.EvalSymlinks(filepath.Clean(filepath.FromSlash(u.Path)))
+	if err != nil {
+		return "", false
+	}
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return dir, true
+}
+
+func (t *LocalDirectoryArchiveTransport) Publish(communityID types.HexBytes, files ArchiveFiles) (string, error) {
+	if t.Dir == "" {
+		return "", ErrArchiveTransportNotConfigured
+	}
+
+	dir, err := filepath.Abs(filepath.Join(t.Dir, communityID.String()))
+	if err != nil {
+		return "", err
+	}
+
+	err = copyArchiveFile(files.IndexPath, filepath.Join(dir, archiveIndexFileName))
+	if err != nil {
+		return "", err
+	}
+
+	err = copyArchiveFile(files.DataPath, filepath.Join(dir, archiveDataFileName))
+	if err != nil {
+		return "", err
+	}
+
+	if t.BaseURL != "" {
+		return strings.TrimSuffix(t.BaseURL, "/") + "/" + communityID.String(), nil
+	}
+
+	return (&url.URL{Scheme: "file", Path: filepath.ToSlash(dir)}).String(), nil
+}
+
+func (t *LocalDirectoryArchiveTransport) Unpublish(communityID types.HexBytes) {
+	if t.Dir == "" {
+		return
+	}
+	_ = os.RemoveAll(filepath.Join(t.Dir, communityID.String()))
+}
+
+func (t *LocalDirectoryArchiveTransport) Fetch(communityID types.HexBytes, location string, files ArchiveFiles, cancel chan struct{}) error {
+	dir, ok := t.locationDir(location)
+	if !ok {
+		return ErrArchiveLocationNotAllowed
+	}
+
+	indexPath, err := archiveFileIn(dir, archiveIndexFileName)
+	if err != nil {
+		return err
+	}
+	err = copyArchiveFile(indexPath, files.IndexPath)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-cancel:
+		return ErrHistoryArchiveDownloadCancelled
+	default:
+	}
+
+	dataPath, err := archiveFileIn(dir, archiveDataFileName)
+	if err != nil {
+		return err
+	}
+	return copyArchiveFile(dataPath, files.DataPath)
+}
+
+// archiveFileIn returns the path of the archive file in dir, resolving symlinks
+// so that the file can't be a link to a file outside of dir
+func archiveFileIn(dir, name string) (string, error) {
+	filePath, err := filepath.EvalSymlinks(filepath.Join(dir, name))
+	if err != nil {
+		return "", err
+	}
+	if filepath.Dir(filePath) != dir {
+		return "", ErrArchiveLocationNotAllowed
+	}
+	return filePath, nil
+}
+
+// HTTPArchiveTransport publishes archives by uploading them with PUT requests
+// to an HTTP(S) mirror and fetches archives over HTTPS from the allowed hosts,
+// so that advertised locations can't make the client reach arbitrary servers
+type HTTPArchiveTransport struct {
+	// UploadURL is the URL of the mirror the archives are uploaded to, under the community ID
+	UploadURL string
+	// PublicURL is the URL the mirror serves the archives at, UploadURL is used when empty
+	PublicURL string
+	// AllowedHosts are the hosts archives are fetched from, next to the host of PublicURL
+	AllowedHosts []string
+	Client       *http.Client
+}
+
+func (t *HTTPArchiveTransport) Name() string {
+	return "http"
+}
+
+func (t *HTTPArchiveTransport) client() *http.Client {
+	if t.Client != nil {
+		return t.Client
+	}
+	return &http.Client{Timeout: archiveTransportHTTPTimeout}
+}
+
+func (t *HTTPArchiveTransport) Supports(location string) bool {
+	u, err := url.Parse(location)
+	if err != nil || strings.ToLower(u.Scheme) != "https" {
+		return false
+	}
+
+	allowedHosts := t.AllowedHosts
+	mirrorURL := t.PublicURL
+	if mirrorURL == "" {
+		mirrorURL = t.UploadURL
+	}
+	if mirror, err := url.Parse(mirrorURL); err == nil && mirror.Host != "" {
+		allowedHosts = append([]string{mirror.Host}, allowedHosts...)
+	}
+
+	for _, host := range allowedHosts {
+		if strings.EqualFold(host, u.Host) || strings.EqualFold(host, u.Hostname()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *HTTPArchiveTransport) Publish(communityID types.HexBytes, files ArchiveFiles) (string, error) {
+	if t.UploadURL == "" {
+		return "", ErrArchiveTransportNotConfigured
+	}
+
+	uploadURL := strings.TrimSuffix(t.UploadURL, "/") + "/" + communityID.String()
+
+	err := t.upload(uploadURL+"/"+archiveIndexFileName, files.IndexPath)
+	if err != nil {
+		return "", err
+	}
+
+	err = t.upload(uploadURL+"/"+archiveDataFileName, files.DataPath)
+	if err != nil {
+		return "", err
+	}
+
+	if t.PublicURL != "" {
+		return strings.TrimSuffix(t.PublicURL, "/") + "/" + communityID.String(), nil
+	}
+	return uploadURL, nil
+}
+
+func (t *HTTPArchiveTransport) upload(uploadURL string, filePath string) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	req, err := http.NewRequest(http.MethodPut, uploadURL, file)
+	if err != nil {
+		return err
+	}
+
+	resp, err := t.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to upload archive file: %s", resp.Status)
+	}
+	return nil
+}
+
+// Unpublish leaves the archives on the mirror, they are replaced on the next upload
+func (t *HTTPArchiveTransport) Unpublish(communityID types.HexBytes) {}
+
+func (t *HTTPArchiveTransport) Fetch(communityID types.HexBytes, location string, files ArchiveFiles, cancel chan struct{}) error {
+	if !t.Supports(location) {
+		return ErrArchiveLocationNotAllowed
+	}
+	return fetchArchiveFilesOverHTTP(t.client(), strings.TrimSuffix(location, "/"), files, cancel)
+}
+
+func fetchArchiveFilesOverHTTP(client *http.Client, baseURL string, files ArchiveFiles, cancel chan struct{}) error {
+	ctx, cancelCtx := cancelContext(cancel)
+	defer cancelCtx()
+
+	download := func(fileURL string, filePath string) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, fileURL, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ErrHistoryArchiveDownloadCancelled
+			}
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("failed to download archive file: %s", resp.Status)
+		}
+
+		err = writeArchiveFile(filePath, resp.Body)
+		if err != nil && ctx.Err() != nil {
+			return ErrHistoryArchiveDownloadCancelled
+		}
+		return err
+	}
+
+	err := download(baseURL+"/"+archiveIndexFileName, files.IndexPath)
+	if err != nil {
+		return err
+	}
+	return download(baseURL+"/"+archiveDataFileName, files.DataPath)
+}
+
+// IPFSArchiveTransport publishes archives by adding them to an IPFS node through its HTTP API,
+// the advertised locations are `ipfs://<cid>` URIs fetched through an IPFS gateway
+type IPFSArchiveTransport struct {
+	// APIURL is the URL of the HTTP API of the IPFS node archives are added to
+	APIURL string
+	// GatewayURL is the IPFS gateway used to fetch archives, params.IpfsGatewayURL is used when empty
+	GatewayURL string
+	Client     *http.Client
+
+	mutex sync.Mutex
+	cids  map[string]string
+}
+
+type ipfsAddResponse struct {
+	Name string
+	Hash string
+}
+
+func (t *IPFSArchiveTransport) Name() string {
+	return "ipfs"
+}
+
+func (t *IPFSArchiveTransport) client() *http.Client {
+	if t.Client != nil {
+		return t.Client
+	}
+	return &http.Client{Timeout: archiveTransportHTTPTimeout}
+}
+
+func (t *IPFSArchiveTransport) Supports(location string) bool {
+	return locationScheme(location) == "ipfs"
+}
+
+func (t *IPFSArchiveTransport) Publish(communityID types.HexBytes, files ArchiveFiles) (string, error) {
+	if t.APIURL == "" {
+		return "", ErrArchiveTransportNotConfigured
+	}
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for name, filePath := range map[string]string{archiveIndexFileName: files.IndexPath, archiveDataFileName: files.DataPath} {
+		part, err := writer.CreateFormFile("file", name)
+		if err != nil {
+			return "", err
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(part, file)
+		file.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	err := writer.Close()
+	if err != nil {
+		return "", err
+	}
+
+	addURL := strings.TrimSuffix(t.APIURL, "/") + "/api/v0/add?pin=true&wrap-with-directory=true"
+	resp, err := t.client().Post(addURL, writer.FormDataContentType(), body)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", fmt.Errorf("failed to add archive to ipfs: %s", resp.Status)
+	}
+
+	// The API streams one entry per added file, the wrapping directory has an empty name
+	var cid string
+	decoder := json.NewDecoder(resp.Body)
+	for {
+		var entry ipfsAddResponse
+		err := decoder.Decode(&entry)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if entry.Name == "" {
+			cid = entry.Hash
+		}
+	}
+
+	if cid == "" {
+		return "", errors.New("ipfs didn't return the archive directory cid")
+	}
+
+	previousCID := t.setCID(communityID, cid)
+	if previousCID != "" && previousCID != cid {
+		t.unpin(previousCID)
+	}
+
+	return "ipfs://" + cid, nil
+}
+
+func (t *IPFSArchiveTransport) setCID(communityID types.HexBytes, cid string) string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.cids == nil {
+		t.cids = make(map[string]string)
+	}
+	previousCID := t.cids[communityID.String()]
+	if cid == "" {
+		delete(t.cids, communityID.String())
+	} else {
+		t.cids[communityID.String()] = cid
+	}
+	return previousCID
+}
+
+func (t *IPFSArchiveTransport) unpin(cid string) {
+	resp, err := t.client().Post(strings.TrimSuffix(t.APIURL, "/")+"/api/v0/pin/rm?arg="+url.QueryEscape(cid), "", nil)
+	if err == nil {
+		resp.Body.Close()
+	}
+}
+
+func (t *IPFSArchiveTransport) Unpublish(communityID types.HexBytes) {
+	cid := t.setCID(communityID, "")
+	if cid != "" && t.APIURL != "" {
+		t.unpin(cid)
+	}
+}
+
+func (t *IPFSArchiveTransport) Fetch(communityID types.HexBytes, location string, files ArchiveFiles, cancel chan struct{}) error {
+	gatewayURL := t.GatewayURL
+	if gatewayURL == "" {
+		gatewayURL = params.IpfsGatewayURL
+	}
+	if gatewayURL == "" {
+		return ErrArchiveTransportNotConfigured
+	}
+
+	cid := path.Clean(strings.TrimPrefix(location, "ipfs://"))
+	return fetchArchiveFilesOverHTTP(t.client(), strings.TrimSuffix(gatewayURL, "/")+"/"+cid, files, cancel)
+}
@@ -0,0 +1,161 @@
+package communities
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+func TestArchiveTransportSuite(t *testing.T) {
+	suite.Run(t, new(ArchiveTransportSuite))
+}
+
+type ArchiveTransportSuite struct {
+	suite.Suite
+
+	communityID types.HexBytes
+	files       ArchiveFiles
+}
+
+func (s *ArchiveTransportSuite) SetupTest() {
+	s.communityID = types.HexBytes{1, 2, 3}
+
+	dir := s.T().TempDir()
+	s.files = ArchiveFiles{
+		IndexPath: filepath.Join(dir, "index"),
+		DataPath:  filepath.Join(dir, "data"),
+	}
+	s.Require().NoError(os.WriteFile(s.files.IndexPath, []byte("index"), 0600))
+	s.Require().NoError(os.WriteFile(s.files.DataPath, []byte("data"), 0600))
+}
+
+func (s *ArchiveTransportSuite) requireFetchedFiles(transport ArchiveTransport, location string) {
+	s.Require().True(transport.Supports(location))
+
+	dir := s.T().TempDir()
+	files := ArchiveFiles{
+		IndexPath: filepath.Join(dir, "fetched", "index"),
+		DataPath:  filepath.Join(dir, "fetched", "data"),
+	}
+	s.Require().NoError(transport.Fetch(s.communityID, location, files, make(chan struct{})))
+
+	index, err := os.ReadFile(files.IndexPath)
+	s.Require().NoError(err)
+	s.Require().Equal("index", string(index))
+
+	data, err := os.ReadFile(files.DataPath)
+	s.Require().NoError(err)
+	s.Require().Equal("data", string(data))
+}
+
+func (s *ArchiveTransportSuite) TestLocalDirectoryTransport() {
+	transport := &LocalDirectoryArchiveTransport{Dir: s.T().TempDir()}
+
+	location, err := transport.Publish(s.communityID, s.files)
+	s.Require().NoError(err)
+	s.Require().True(strings.HasPrefix(location, "file://"))
+
+	s.requireFetchedFiles(transport, location)
+
+	transport.Unpublish(s.communityID)
+	_, err = os.Stat(filepath.Join(transport.Dir, s.communityID.String()))
+	s.Require().True(os.IsNotExist(err))
+
+	s.Require().False(transport.Supports("file:///etc"))
+	s.Require().False(transport.Supports("file://" + filepath.ToSlash(filepath.Join(transport.Dir, ".."))))
+	s.Require().False((&LocalDirectoryArchiveTransport{}).Supports(location))
+
+	// Links under the directory pointing outside of it aren't followed
+	outside := s.T().TempDir()
+	link := filepath.Join(transport.Dir, "link")
+	s.Require().NoError(os.Symlink(outside, link))
+	s.Require().False(transport.Supports("file://" + filepath.ToSlash(link)))
+
+	// Archive files linking outside of the directory aren't fetched
+	secret := filepath.Join(outside, "secret")
+	s.Require().NoError(os.WriteFile(secret, []byte("secret"), 0600))
+	linkedFilesDir := filepath.Join(transport.Dir, "linked-files")
+	s.Require().NoError(os.Mkdir(linkedFilesDir, 0700))
+	s.Require().NoError(os.Symlink(secret, filepath.Join(linkedFilesDir, archiveIndexFileName)))
+	fetchDir := s.T().TempDir()
+	err = transport.Fetch(s.communityID, "file://"+filepath.ToSlash(linkedFilesDir), ArchiveFiles{
+		IndexPath: filepath.Join(fetchDir, "index"),
+		DataPath:  filepath.Join(fetchDir, "data"),
+	}, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrArchiveLocationNotAllowed)
+
+	// The directory itself can be a link
+	linkedDir := filepath.Join(s.T().TempDir(), "linked")
+	s.Require().NoError(os.Symlink(transport.Dir, linkedDir))
+	linkedTransport := &LocalDirectoryArchiveTransport{Dir: linkedDir}
+	linkedLocation, err := linkedTransport.Publish(s.communityID, s.files)
+	s.Require().NoError(err)
+	s.requireFetchedFiles(linkedTransport, linkedLocation)
+
+	transport.BaseURL = "https://archives.example/"
+	location, err = transport.Publish(s.communityID, s.files)
+	s.Require().NoError(err)
+	s.Require().Equal("https://archives.example/"+s.communityID.String(), location)
+}
+
+func (s *ArchiveTransportSuite) TestHTTPTransport() {
+	var mutex sync.Mutex
+	stored := make(map[string][]byte)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			body, err := io.ReadAll(r.Body)
+			s.Require().NoError(err)
+			stored[r.URL.Path] = body
+		case http.MethodGet:
+			body, ok := stored[r.URL.Path]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write(body)
+		}
+	}))
+	defer server.Close()
+
+	_, err := (&HTTPArchiveTransport{}).Publish(s.communityID, s.files)
+	s.Require().ErrorIs(err, ErrArchiveTransportNotConfigured)
+
+	transport := &HTTPArchiveTransport{UploadURL: server.URL + "/archives", Client: server.Client()}
+
+	location, err := transport.Publish(s.communityID, s.files)
+	s.Require().NoError(err)
+	s.Require().Equal(server.URL+"/archives/"+s.communityID.String(), location)
+
+	s.requireFetchedFiles(transport, location)
+
+	err = transport.Fetch(s.communityID, server.URL+"/missing", s.files, make(chan struct{}))
+	s.Require().Error(err)
+
+	s.Require().False(transport.Supports("https://other.example/archives"))
+	s.Require().False(transport.Supports(strings.Replace(location, "https://", "http://", 1)))
+	s.Require().False((&HTTPArchiveTransport{}).Supports(location))
+	s.Require().True((&HTTPArchiveTransport{AllowedHosts: []string{"mirror.example"}}).Supports("https://mirror.example/archives"))
+
+	err = transport.Fetch(s.communityID, "https://other.example/archives", s.files, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrArchiveLocationNotAllowed)
+}
+
+func (s *ArchiveTransportSuite) TestHistoryArchiveLocations() {
+	s.Require().Nil(HistoryArchiveLocations("", nil))
+	s.Require().Equal([]string{"magnet:?xt=1", "https://mirror/1", "ipfs://cid"},
+		HistoryArchiveLocations("magnet:?xt=1", []string{"magnet:?xt=1", "https://mirror/1", "", "ipfs://cid"}))
+}
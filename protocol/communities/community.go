@@ -1297,6 +1297,11 @@ func (o *Community) ValidateRequestToJoin(signer *ecdsa.PublicKey, request *prot
 		return err
 	}
 
+	err = o.validateMembershipAnswers(request.MembershipAnswers)
+	if err != nil {
+		return err
+	}
+
 	if o.isBanned(signer) {
 		return ErrCantRequestAccess
 	}
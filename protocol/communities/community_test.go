@@ -461,6 +461,111 @@ func (s *CommunitySuite) TestValidateRequestToJoin() {
 	}
 }
 
+func (s *CommunitySuite) TestValidateMembershipAnswers() {
+	testCases := []struct {
+		name    string
+		answers []*protobuf.CommunityMembershipAnswer
+		err     error
+	}{
+		{
+			name: "all questions answered",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "role", Choices: []uint32{0, 1}},
+				{QuestionId: "link", Link: "https://example.com/me"},
+			},
+		},
+		{
+			name: "optional question skipped",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "role", Choices: []uint32{2}},
+			},
+		},
+		{
+			name: "required question missing",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "role", Choices: []uint32{2}},
+			},
+			err: ErrMembershipAnswerRequired,
+		},
+		{
+			name: "unknown question",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "role", Choices: []uint32{2}},
+				{QuestionId: "unknown", Text: "?"},
+			},
+			err: ErrMembershipQuestionNotFound,
+		},
+		{
+			name: "duplicate answer",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "why", Text: "I really like the project"},
+				{QuestionId: "role", Choices: []uint32{2}},
+			},
+			err: ErrInvalidMembershipAnswer,
+		},
+		{
+			name: "empty free text",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why"},
+				{QuestionId: "role", Choices: []uint32{2}},
+			},
+			err: ErrInvalidMembershipAnswer,
+		},
+		{
+			name: "choice out of range",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "role", Choices: []uint32{3}},
+			},
+			err: ErrInvalidMembershipAnswer,
+		},
+		{
+			name: "invalid link",
+			answers: []*protobuf.CommunityMembershipAnswer{
+				{QuestionId: "why", Text: "I like the project"},
+				{QuestionId: "role", Choices: []uint32{2}},
+				{QuestionId: "link", Link: "ftp://example.com"},
+			},
+			err: ErrInvalidMembershipAnswer,
+		},
+	}
+
+	config := s.configOnRequest()
+	config.CommunityDescription.Permissions.MembershipQuestions = []*protobuf.CommunityMembershipQuestion{
+		{
+			Id:       "why",
+			Type:     protobuf.CommunityMembershipQuestion_FREE_TEXT,
+			Question: "Why do you want to join?",
+			Required: true,
+		},
+		{
+			Id:       "role",
+			Type:     protobuf.CommunityMembershipQuestion_MULTIPLE_CHOICE,
+			Question: "What is your role?",
+			Choices:  []string{"developer", "designer", "other"},
+			Required: true,
+		},
+		{
+			Id:       "link",
+			Type:     protobuf.CommunityMembershipQuestion_SOCIAL_LINK,
+			Question: "Link to your profile",
+		},
+	}
+
+	org, err := New(config, &TimeSourceStub{}, &DescriptionEncryptorMock{}, nil)
+	s.Require().NoError(err)
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.Require().Equal(tc.err, org.ValidateMembershipAnswers(tc.answers))
+		})
+	}
+}
+
 func (s *CommunitySuite) TestCanPostCanView() {
 	chatID := "chat-id"
 	memberKey := common.PubkeyToHex(&s.member1.PublicKey)
@@ -0,0 +1,322 @@
+package communities
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"math/big"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/communities/token"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/services/wallet/bigint"
+)
+
+const (
+	controlNodeBundleVersion = 1
+
+	controlNodeBundleSaltLength = 32
+	controlNodeBundleScryptN    = 1 << 15
+	controlNodeBundleScryptR    = 8
+	controlNodeBundleScryptP    = 1
+	controlNodeBundleKeyLength  = 32
+)
+
+func controlNodeBundleKey(password string, salt []byte) ([]byte, error) {
+	return scrypt.Key([]byte(password), salt, controlNodeBundleScryptN, controlNodeBundleScryptR, controlNodeBundleScryptP, controlNodeBundleKeyLength)
+}
+
+// EncryptControlNodeBundle signs the bundle with the community private key and encrypts it with the password
+func EncryptControlNodeBundle(bundle *protobuf.CommunityControlNodeBundle, password string) ([]byte, error) {
+	privateKey, err := crypto.ToECDSA(bundle.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	marshaledBundle, err := proto.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(marshaledBundle), privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(&protobuf.SignedCommunityControlNodeBundle{
+		Bundle:    marshaledBundle,
+		Signature: signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, controlNodeBundleSaltLength)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := controlNodeBundleKey(password, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedPayload, err := common.Encrypt(payload, key, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&protobuf.CommunityControlNodeBundleEnvelope{
+		Version: controlNodeBundleVersion,
+		Salt:    salt,
+		Payload: encryptedPayload,
+	})
+}
+
+// DecryptControlNodeBundle decrypts the bundle and verifies that it has been signed with
+// the community private key it contains and that it describes the same community
+func DecryptControlNodeBundle(data []byte, password string) (*protobuf.CommunityControlNodeBundle, *ecdsa.PrivateKey, error) {
+	envelope := &protobuf.CommunityControlNodeBundleEnvelope{}
+	err := proto.Unmarshal(data, envelope)
+	if err != nil {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	if envelope.Version != controlNodeBundleVersion {
+		return nil, nil, ErrUnsupportedControlNodeBundleVersion
+	}
+
+	key, err := controlNodeBundleKey(password, envelope.Salt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	payload, err := common.Decrypt(envelope.Payload, key)
+	if err != nil {
+		return nil, nil, ErrControlNodeBundleDecryptionFailed
+	}
+
+	signedBundle := &protobuf.SignedCommunityControlNodeBundle{}
+	err = proto.Unmarshal(payload, signedBundle)
+	if err != nil {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	bundle := &protobuf.CommunityControlNodeBundle{}
+	err = proto.Unmarshal(signedBundle.Bundle, bundle)
+	if err != nil {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	privateKey, err := crypto.ToECDSA(bundle.PrivateKey)
+	if err != nil {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256(signedBundle.Bundle), signedBundle.Signature)
+	if err != nil || !common.IsPubKeyEqual(signer, &privateKey.PublicKey) {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	if bundle.Community == nil || !bytes.Equal(bundle.Community.Id, crypto.CompressPubkey(&privateKey.PublicKey)) {
+		return nil, nil, ErrInvalidControlNodeBundle
+	}
+
+	return bundle, privateKey, nil
+}
+
+// BuildControlNodeBundle collects the control node state of the community which is not
+// part of the synced community: all the requests to join with their revealed addresses,
+// the deployed community tokens and the history archive state
+func (m *Manager) BuildControlNodeBundle(syncCommunity *protobuf.SyncInstallationCommunity) (*protobuf.CommunityControlNodeBundle, error) {
+	community, err := m.GetByID(syncCommunity.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() {
+		return nil, ErrNotControlNode
+	}
+
+	requestsToJoin, err := m.persistence.GetCommunityRequestsToJoinWithRevealedAddresses(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	syncCommunity.RequestsToJoin = nil
+	for _, requestToJoin := range requestsToJoin {
+		syncCommunity.RequestsToJoin = append(syncCommunity.RequestsToJoin, requestToJoin.ToSyncProtobuf())
+	}
+	// The control node is the device importing the bundle
+	syncCommunity.ControlNode = nil
+
+	communityTokens, err := m.persistence.GetCommunityTokens(community.IDString())
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []*protobuf.CommunityControlNodeBundleToken
+	for _, communityToken := range communityTokens {
+		tokens = append(tokens, communityTokenToControlNodeBundleToken(communityToken))
+	}
+
+	magnetlinkClock, err := m.persistence.GetMagnetlinkMessageClock(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	lastMagnetlinkURI, err := m.persistence.GetLastSeenMagnetlink(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	lastMessageArchiveEndDate, err := m.persistence.GetLastMessageArchiveEndDate(community.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &protobuf.CommunityControlNodeBundle{
+		Clock:      uint64(time.Now().UnixMilli()),
+		PrivateKey: crypto.FromECDSA(community.PrivateKey()),
+		Community:  syncCommunity,
+		Tokens:     tokens,
+		ArchiveInfo: &protobuf.CommunityControlNodeBundleArchiveInfo{
+			MagnetlinkClock:           magnetlinkClock,
+			LastMagnetlinkUri:         lastMagnetlinkURI,
+			LastMessageArchiveEndDate: lastMessageArchiveEndDate,
+		},
+	}, nil
+}
+
+// ImportControlNodeBundleState restores the state collected by BuildControlNodeBundle
+func (m *Manager) ImportControlNodeBundleState(bundle *protobuf.CommunityControlNodeBundle) error {
+	communityID := bundle.Community.Id
+
+	community, err := m.GetByID(communityID)
+	if err != nil {
+		return err
+	}
+
+	for _, syncRequestToJoin := range bundle.Community.RequestsToJoin {
+		requestToJoin := new(RequestToJoin)
+		requestToJoin.InitFromSyncProtobuf(syncRequestToJoin)
+
+		err = m.persistence.SaveRequestToJoin(requestToJoin)
+		if err != nil && err != ErrOldRequestToJoin {
+			return err
+		}
+
+		if len(requestToJoin.RevealedAccounts) > 0 {
+			err = m.persistence.SaveRequestToJoinRevealedAddresses(requestToJoin.ID, requestToJoin.RevealedAccounts)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, bundleToken := range bundle.Tokens {
+		communityToken := controlNodeBundleTokenToCommunityToken(community.IDString(), bundleToken)
+
+		exists, err := m.persistence.HasCommunityToken(communityToken.CommunityID, communityToken.Address, communityToken.ChainID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		err = m.persistence.AddCommunityToken(communityToken)
+		if err != nil {
+			return err
+		}
+	}
+
+	if bundle.ArchiveInfo != nil {
+		return m.importControlNodeBundleArchiveInfo(community, bundle.ArchiveInfo)
+	}
+
+	return nil
+}
+
+func (m *Manager) importControlNodeBundleArchiveInfo(community *Community, archiveInfo *protobuf.CommunityControlNodeBundleArchiveInfo) error {
+	exists, err := m.persistence.HasCommunityArchiveInfo(community.ID())
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		err = m.persistence.SaveCommunityArchiveInfo(community.ID(), archiveInfo.MagnetlinkClock, archiveInfo.LastMessageArchiveEndDate)
+	} else {
+		err = m.persistence.UpdateMagnetlinkMessageClock(community.ID(), archiveInfo.MagnetlinkClock)
+		if err != nil {
+			return err
+		}
+		err = m.persistence.UpdateLastMessageArchiveEndDate(community.ID(), archiveInfo.LastMessageArchiveEndDate)
+	}
+	if err != nil {
+		return err
+	}
+
+	return m.persistence.UpdateLastSeenMagnetlink(community.ID(), archiveInfo.LastMagnetlinkUri)
+}
+
+func communityTokenToControlNodeBundleToken(communityToken *token.CommunityToken) *protobuf.CommunityControlNodeBundleToken {
+	supply := "0"
+	if communityToken.Supply != nil && communityToken.Supply.Int != nil {
+		supply = communityToken.Supply.String()
+	}
+
+	return &protobuf.CommunityControlNodeBundleToken{
+		TokenType:          communityToken.TokenType,
+		Address:            communityToken.Address,
+		Name:               communityToken.Name,
+		Symbol:             communityToken.Symbol,
+		Description:        communityToken.Description,
+		Supply:             supply,
+		InfiniteSupply:     communityToken.InfiniteSupply,
+		Transferable:       communityToken.Transferable,
+		RemoteSelfDestruct: communityToken.RemoteSelfDestruct,
+		ChainId:            uint64(communityToken.ChainID),
+		DeployState:        uint32(communityToken.DeployState),
+		Image:              communityToken.Base64Image,
+		Decimals:           uint32(communityToken.Decimals),
+		Deployer:           communityToken.Deployer,
+		PrivilegesLevel:    uint32(communityToken.PrivilegesLevel),
+		TransactionHash:    communityToken.TransactionHash,
+		Version:            communityToken.Version,
+	}
+}
+
+func controlNodeBundleTokenToCommunityToken(communityID string, bundleToken *protobuf.CommunityControlNodeBundleToken) *token.CommunityToken {
+	supply, ok := new(big.Int).SetString(bundleToken.Supply, 10)
+	if !ok {
+		supply = big.NewInt(0)
+	}
+
+	return &token.CommunityToken{
+		TokenType:          bundleToken.TokenType,
+		CommunityID:        communityID,
+		Address:            bundleToken.Address,
+		Name:               bundleToken.Name,
+		Symbol:             bundleToken.Symbol,
+		Description:        bundleToken.Description,
+		Supply:             &bigint.BigInt{Int: supply},
+		InfiniteSupply:     bundleToken.InfiniteSupply,
+		Transferable:       bundleToken.Transferable,
+		RemoteSelfDestruct: bundleToken.RemoteSelfDestruct,
+		ChainID:            int(bundleToken.ChainId),
+		DeployState:        token.DeployState(bundleToken.DeployState),
+		Base64Image:        bundleToken.Image,
+		Decimals:           int(bundleToken.Decimals),
+		Deployer:           bundleToken.Deployer,
+		PrivilegesLevel:    token.PrivilegesLevel(bundleToken.PrivilegesLevel),
+		TransactionHash:    bundleToken.TransactionHash,
+		Version:            bundleToken.Version,
+	}
+}
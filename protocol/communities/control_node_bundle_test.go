@@ -0,0 +1,88 @@
+package communities
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestControlNodeBundleSuite(t *testing.T) {
+	suite.Run(t, new(ControlNodeBundleSuite))
+}
+
+type ControlNodeBundleSuite struct {
+	suite.Suite
+}
+
+func (s *ControlNodeBundleSuite) newBundle() *protobuf.CommunityControlNodeBundle {
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	return &protobuf.CommunityControlNodeBundle{
+		Clock:      1,
+		PrivateKey: crypto.FromECDSA(key),
+		Community: &protobuf.SyncInstallationCommunity{
+			Id:     crypto.CompressPubkey(&key.PublicKey),
+			Joined: true,
+		},
+		Tokens: []*protobuf.CommunityControlNodeBundleToken{
+			{Address: "0x123", ChainId: 1, Supply: "100"},
+		},
+	}
+}
+
+func (s *ControlNodeBundleSuite) TestEncryptDecrypt() {
+	bundle := s.newBundle()
+
+	data, err := EncryptControlNodeBundle(bundle, "password")
+	s.Require().NoError(err)
+
+	decrypted, key, err := DecryptControlNodeBundle(data, "password")
+	s.Require().NoError(err)
+	s.Require().True(proto.Equal(bundle, decrypted))
+	s.Require().Equal(bundle.PrivateKey, crypto.FromECDSA(key))
+
+	_, _, err = DecryptControlNodeBundle(data, "wrong password")
+	s.Require().ErrorIs(err, ErrControlNodeBundleDecryptionFailed)
+}
+
+func (s *ControlNodeBundleSuite) TestDecryptTamperedBundle() {
+	bundle := s.newBundle()
+
+	data, err := EncryptControlNodeBundle(bundle, "password")
+	s.Require().NoError(err)
+
+	envelope := &protobuf.CommunityControlNodeBundleEnvelope{}
+	s.Require().NoError(proto.Unmarshal(data, envelope))
+	envelope.Payload[len(envelope.Payload)-1] ^= 0xff
+	data, err = proto.Marshal(envelope)
+	s.Require().NoError(err)
+
+	_, _, err = DecryptControlNodeBundle(data, "password")
+	s.Require().ErrorIs(err, ErrControlNodeBundleDecryptionFailed)
+
+	envelope.Version = controlNodeBundleVersion + 1
+	data, err = proto.Marshal(envelope)
+	s.Require().NoError(err)
+
+	_, _, err = DecryptControlNodeBundle(data, "password")
+	s.Require().ErrorIs(err, ErrUnsupportedControlNodeBundleVersion)
+}
+
+func (s *ControlNodeBundleSuite) TestDecryptBundleOfAnotherCommunity() {
+	bundle := s.newBundle()
+
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	bundle.Community.Id = crypto.CompressPubkey(&otherKey.PublicKey)
+
+	data, err := EncryptControlNodeBundle(bundle, "password")
+	s.Require().NoError(err)
+
+	_, _, err = DecryptControlNodeBundle(data, "password")
+	s.Require().ErrorIs(err, ErrInvalidControlNodeBundle)
+}
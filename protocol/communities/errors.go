@@ -51,3 +51,16 @@ var ErrPermissionToJoinNotSatisfied = errors.New("permission to join not satisfi
 var ErrBannedMemberNotFound = errors.New("banned member not found")
 var ErrGrantMemberPublicKeyIsDifferent = errors.New("grant member public key is different")
 var ErrEditSharedAddressesRequestOutdated = errors.New("outdated edit shares addresses request")
+var ErrMembershipQuestionNotFound = errors.New("membership question not found")
+var ErrInvalidMembershipAnswer = errors.New("invalid membership answer")
+var ErrMembershipAnswerRequired = errors.New("membership answer required")
+var ErrInvalidControlNodeBundle = errors.New("invalid control node bundle")
+var ErrUnsupportedControlNodeBundleVersion = errors.New("unsupported control node bundle version")
+var ErrControlNodeBundleDecryptionFailed = errors.New("control node bundle can't be decrypted, wrong password?")
+var ErrArchiveTransportNotConfigured = errors.New("archive transport not configured")
+var ErrArchiveLocationNotAllowed = errors.New("archive location not allowed")
+var ErrHistoryArchiveDownloadCancelled = errors.New("history archive download cancelled")
+var ErrNoHistoryArchiveLocation = errors.New("no history archive location could be fetched")
+var ErrHistoryArchiveIndexNotSigned = errors.New("history archive index is not signed")
+var ErrInvalidHistoryArchiveIndexSignature = errors.New("invalid history archive index signature")
+var ErrHistoryArchiveHashMismatch = errors.New("history archive data doesn't match its hash")
@@ -198,6 +198,8 @@ type ArchiveService interface {
 	GetHistoryArchiveDownloadTask(communityID string) *HistoryArchiveDownloadTask
 	AddHistoryArchiveDownloadTask(communityID string, task *HistoryArchiveDownloadTask)
 	DownloadHistoryArchivesByMagnetlink(communityID types.HexBytes, magnetlink string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error)
+	DownloadHistoryArchives(communityID types.HexBytes, locations []string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error)
+	GetHistoryArchiveLocations(communityID types.HexBytes) ([]string, error)
 	TorrentFileExists(communityID string) bool
 }
 
@@ -209,6 +211,11 @@ type ArchiveManagerConfig struct {
 	Identity      *ecdsa.PrivateKey
 	Encryptor     *encryption.Protocol
 	Publisher     Publisher
+	// Transports publish the history archives next to BitTorrent
+	Transports []ArchiveTransport
+	// DataDir is the directory archives are downloaded to over the transports,
+	// and stored in when BitTorrent isn't configured
+	DataDir string
 }
 
 func (t *HistoryArchiveDownloadTask) IsCancelled() bool {
@@ -491,6 +498,8 @@ type Subscription struct {
 	DownloadingHistoryArchivesStartedSignal  *signal.DownloadingHistoryArchivesStartedSignal
 	DownloadingHistoryArchivesFinishedSignal *signal.DownloadingHistoryArchivesFinishedSignal
 	ImportingHistoryArchiveMessagesSignal    *signal.ImportingHistoryArchiveMessagesSignal
+	HistoryArchiveVerificationFailedSignal   *signal.HistoryArchiveVerificationFailedSignal
+	UnsignedHistoryArchiveImportedSignal     *signal.UnsignedHistoryArchiveImportedSignal
 	CommunityEventsMessage                   *CommunityEventsMessage
 	AcceptedRequestsToJoin                   []types.HexBytes
 	RejectedRequestsToJoin                   []types.HexBytes
@@ -3032,6 +3041,7 @@ func (m *Manager) HandleCommunityRequestToJoin(signer *ecdsa.PublicKey, receiver
 		State:              RequestToJoinStatePending,
 		RevealedAccounts:   request.RevealedAccounts,
 		CustomizationColor: multiaccountscommon.IDToColorFallbackToBlue(request.CustomizationColor),
+		MembershipAnswers:  request.MembershipAnswers,
 	}
 	requestToJoin.CalculateID()
 
@@ -4025,6 +4035,7 @@ func (m *Manager) CreateRequestToJoin(request *requests.RequestToJoinCommunity,
 		RevealedAccounts:     make([]*protobuf.RevealedAccount, 0),
 		CustomizationColor:   customizationColor,
 		ShareFutureAddresses: request.ShareFutureAddresses,
+		MembershipAnswers:    request.MembershipAnswers,
 	}
 
 	requestToJoin.CalculateID()
@@ -4067,6 +4078,22 @@ func (m *Manager) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*Reque
 	return m.persistence.PendingRequestsToJoinForCommunity(id)
 }
 
+// MembersDirectory returns a page of the members of the community matching the query
+func (m *Manager) MembersDirectory(query *MembersDirectoryQuery) (*MembersDirectoryPage, error) {
+	community, err := m.GetByID(query.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The directory might lag behind for communities saved before it existed
+	err = m.persistence.updateMembersDirectory(community)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.persistence.MembersDirectory(query)
+}
+
 func (m *Manager) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*RequestToJoin, error) {
 	m.logger.Info("fetching declined invitations", zap.String("community-id", id.String()))
 	return m.persistence.DeclinedRequestsToJoinForCommunity(id)
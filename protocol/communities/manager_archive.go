@@ -63,6 +63,13 @@ type ArchiveManager struct {
 	historyArchiveTasksWaitGroup sync.WaitGroup
 	historyArchiveTasks          sync.Map // stores `chan struct{}`
 
+	// transports publish the archives, the first one being BitTorrent
+	transports []ArchiveTransport
+	// fetchTransports download the archives from the advertised locations
+	fetchTransports       []ArchiveTransport
+	archiveLocations      map[string][]string
+	archiveLocationsMutex sync.RWMutex
+
 	logger      *zap.Logger
 	persistence *Persistence
 	transport   *transport.Transport
@@ -78,10 +85,11 @@ type ArchiveManager struct {
 // build command will import and build the torrent deps for the Desktop OSes.
 // NOTE: It is intentional that this file contains the identical function name as in "manager_archive_nop.go"
 func NewArchiveManager(amc *ArchiveManagerConfig) *ArchiveManager {
-	return &ArchiveManager{
+	m := &ArchiveManager{
 		torrentConfig:               amc.TorrentConfig,
 		torrentTasks:                make(map[string]metainfo.Hash),
 		historyArchiveDownloadTasks: make(map[string]*HistoryArchiveDownloadTask),
+		archiveLocations:            make(map[string][]string),
 
 		logger:      amc.Logger,
 		persistence: amc.Persistence,
@@ -92,6 +100,11 @@ func NewArchiveManager(amc *ArchiveManagerConfig) *ArchiveManager {
 		publisher:          amc.Publisher,
 		ArchiveFileManager: NewArchiveFileManager(amc),
 	}
+
+	m.transports = append([]ArchiveTransport{&torrentArchiveTransport{manager: m}}, amc.Transports...)
+	m.fetchTransports = append(append([]ArchiveTransport{}, m.transports...), defaultArchiveFetchTransports()...)
+
+	return m
 }
 
 func (m *ArchiveManager) SetOnline(online bool) {
@@ -390,67 +403,129 @@ func (m *ArchiveManager) StopHistoryArchiveTasksInterval(communityID types.HexBy
 	}
 }
 
+// SeedHistoryArchiveTorrent publishes the history archives of the community through all the archive transports
 func (m *ArchiveManager) SeedHistoryArchiveTorrent(communityID types.HexBytes) error {
 	m.UnseedHistoryArchiveTorrent(communityID)
 
+	files := m.archiveFiles(communityID.String())
+
+	var locations []string
+	var lastErr error
+	for _, transport := range m.transports {
+		location, err := transport.Publish(communityID, files)
+		if err != nil {
+			m.logger.Error("failed to publish history archive", zap.String("transport", transport.Name()), zap.Error(err))
+			lastErr = err
+			continue
+		}
+		locations = append(locations, location)
+	}
+
+	if len(locations) == 0 {
+		return lastErr
+	}
+
+	m.archiveLocationsMutex.Lock()
+	m.archiveLocations[communityID.String()] = locations
+	m.archiveLocationsMutex.Unlock()
+
+	m.publisher.publish(&Subscription{
+		HistoryArchivesSeedingSignal: &signal.HistoryArchivesSeedingSignal{
+			CommunityID: communityID.String(),
+		},
+	})
+
+	m.logger.Debug("publishing history archive", zap.String("id", communityID.String()), zap.Strings("locations", locations))
+	return nil
+}
+
+func (m *ArchiveManager) seedTorrent(communityID types.HexBytes) (string, error) {
 	id := communityID.String()
 	torrentFile := torrentFile(m.torrentConfig.TorrentDir, id)
 
 	metaInfo, err := metainfo.LoadFromFile(torrentFile)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	info, err := metaInfo.UnmarshalInfo()
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	hash := metaInfo.HashInfoBytes()
 	m.torrentTasks[id] = hash
 
-	if err != nil {
-		return err
-	}
-
 	torrent, err := m.torrentClient.AddTorrent(metaInfo)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	torrent.DownloadAll()
 
-	m.publisher.publish(&Subscription{
-		HistoryArchivesSeedingSignal: &signal.HistoryArchivesSeedingSignal{
-			CommunityID: communityID.String(),
-		},
-	})
+	return metaInfo.Magnet(nil, &info).String(), nil
+}
 
-	magnetLink := metaInfo.Magnet(nil, &info).String()
+// UnseedHistoryArchiveTorrent stops publishing the history archives of the community
+func (m *ArchiveManager) UnseedHistoryArchiveTorrent(communityID types.HexBytes) {
+	id := communityID.String()
 
-	m.logger.Debug("seeding torrent", zap.String("id", id), zap.String("magnetLink", magnetLink))
-	return nil
+	m.archiveLocationsMutex.Lock()
+	_, published := m.archiveLocations[id]
+	delete(m.archiveLocations, id)
+	m.archiveLocationsMutex.Unlock()
+
+	// Torrents are also added when downloading archives
+	unseeded := m.unseedTorrent(communityID)
+
+	if published {
+		for _, transport := range m.transports {
+			transport.Unpublish(communityID)
+		}
+	}
+
+	if unseeded || published {
+		m.publisher.publish(&Subscription{
+			HistoryArchivesUnseededSignal: &signal.HistoryArchivesUnseededSignal{
+				CommunityID: id,
+			},
+		})
+	}
 }
 
-func (m *ArchiveManager) UnseedHistoryArchiveTorrent(communityID types.HexBytes) {
+func (m *ArchiveManager) unseedTorrent(communityID types.HexBytes) bool {
 	id := communityID.String()
 
 	hash, exists := m.torrentTasks[id]
+	if !exists || !m.torrentClientStarted() {
+		return false
+	}
 
-	if exists {
-		torrent, ok := m.torrentClient.Torrent(hash)
-		if ok {
-			m.logger.Debug("Unseeding and dropping torrent for community: ", zap.Any("id", id))
-			torrent.Drop()
-			delete(m.torrentTasks, id)
-
-			m.publisher.publish(&Subscription{
-				HistoryArchivesUnseededSignal: &signal.HistoryArchivesUnseededSignal{
-					CommunityID: id,
-				},
-			})
-		}
+	torrent, ok := m.torrentClient.Torrent(hash)
+	if !ok {
+		return false
+	}
+
+	m.logger.Debug("Unseeding and dropping torrent for community: ", zap.Any("id", id))
+	torrent.Drop()
+	delete(m.torrentTasks, id)
+	return true
+}
+
+// GetHistoryArchiveLocations returns the locations the history archives of the community are published at
+func (m *ArchiveManager) GetHistoryArchiveLocations(communityID types.HexBytes) ([]string, error) {
+	m.archiveLocationsMutex.RLock()
+	locations, ok := m.archiveLocations[communityID.String()]
+	m.archiveLocationsMutex.RUnlock()
+	if ok {
+		return locations, nil
+	}
+
+	magnetlink, err := m.GetHistoryArchiveMagnetlink(communityID)
+	if err != nil {
+		return nil, err
 	}
+	return []string{magnetlink}, nil
 }
 
 func (m *ArchiveManager) IsSeedingHistoryArchiveTorrent(communityID types.HexBytes) bool {
@@ -468,6 +543,159 @@ func (m *ArchiveManager) AddHistoryArchiveDownloadTask(communityID string, task
 	m.historyArchiveDownloadTasks[communityID] = task
 }
 
+// DownloadHistoryArchives downloads the history archives of the community from the first
+// location that can be fetched, falling back to the next location on failure
+func (m *ArchiveManager) DownloadHistoryArchives(communityID types.HexBytes, locations []string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	errs := []error{ErrNoHistoryArchiveLocation}
+
+	for _, location := range locations {
+		transport := m.fetchTransport(location)
+		if transport == nil {
+			m.logger.Debug("no archive transport supports location", zap.String("location", location))
+			continue
+		}
+
+		downloadTaskInfo, err := m.fetchHistoryArchives(transport, communityID, location, cancelTask)
+		if err == nil {
+			return downloadTaskInfo, nil
+		}
+
+		m.logger.Debug("failed to fetch history archive",
+			zap.String("transport", transport.Name()),
+			zap.String("location", location),
+			zap.Error(err))
+		errs = append(errs, err)
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (m *ArchiveManager) fetchTransport(location string) ArchiveTransport {
+	for _, transport := range m.fetchTransports {
+		if transport.Supports(location) {
+			return transport
+		}
+	}
+	return nil
+}
+
+func (m *ArchiveManager) fetchHistoryArchives(transport ArchiveTransport, communityID types.HexBytes, location string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	id := communityID.String()
+
+	// Archive files are fetched to a staging directory first so that a failed
+	// download doesn't corrupt the archives downloaded so far. BitTorrent is
+	// the exception as the torrent client writes to the archive directory itself.
+	var stagingFiles ArchiveFiles
+	if _, ok := transport.(*torrentArchiveTransport); !ok {
+		if m.dataDir == "" {
+			return nil, ErrArchiveTransportNotConfigured
+		}
+		stagingDir := path.Join(m.dataDir, "."+id+".download")
+		defer os.RemoveAll(stagingDir)
+
+		stagingFiles = ArchiveFiles{
+			IndexPath: path.Join(stagingDir, archiveIndexFileName),
+			DataPath:  path.Join(stagingDir, archiveDataFileName),
+		}
+	}
+
+	err := transport.Fetch(communityID, location, stagingFiles, cancelTask)
+	if err == ErrHistoryArchiveDownloadCancelled {
+		m.logger.Debug("cancelled fetching history archive", zap.String("location", location))
+		return &HistoryArchiveDownloadTaskInfo{Cancelled: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := os.Stat(stagingFiles.IndexPath); err == nil {
+		// Make sure the index can be read before replacing the current archives
+		_, err = m.loadVerifiedHistoryArchiveIndex(m.identity, communityID, stagingFiles.IndexPath)
+		if err != nil {
+			return nil, err
+		}
+
+		files := m.archiveFiles(id)
+		err = os.MkdirAll(path.Dir(files.IndexPath), 0700)
+		if err != nil {
+			return nil, err
+		}
+		err = moveArchiveFile(stagingFiles.DataPath, files.DataPath)
+		if err != nil {
+			return nil, err
+		}
+		err = moveArchiveFile(stagingFiles.IndexPath, files.IndexPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return m.saveDownloadedArchiveIDs(communityID)
+}
+
+// saveDownloadedArchiveIDs records the archives of the index which haven't been downloaded before,
+// newest first, so that they are imported
+func (m *ArchiveManager) saveDownloadedArchiveIDs(communityID types.HexBytes) (*HistoryArchiveDownloadTaskInfo, error) {
+	index, err := m.ArchiveFileManager.LoadHistoryArchiveIndexFromFile(m.identity, communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	existingArchiveIDs, err := m.persistence.GetDownloadedMessageArchiveIDs(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadTaskInfo := &HistoryArchiveDownloadTaskInfo{
+		TotalDownloadedArchivesCount: len(existingArchiveIDs),
+		TotalArchivesCount:           len(index.Archives),
+	}
+
+	existing := make(map[string]bool, len(existingArchiveIDs))
+	for _, hash := range existingArchiveIDs {
+		existing[hash] = true
+	}
+
+	archiveHashes := make(archiveMDSlice, 0, len(index.Archives))
+	for hash, metadata := range index.Archives {
+		if !existing[hash] {
+			archiveHashes = append(archiveHashes, &archiveMetadata{hash: hash, from: metadata.Metadata.From})
+		}
+	}
+
+	if len(archiveHashes) == 0 {
+		return downloadTaskInfo, nil
+	}
+
+	sort.Sort(sort.Reverse(archiveHashes))
+
+	m.publisher.publish(&Subscription{
+		DownloadingHistoryArchivesStartedSignal: &signal.DownloadingHistoryArchivesStartedSignal{
+			CommunityID: communityID.String(),
+		},
+	})
+
+	for _, hd := range archiveHashes {
+		err = m.persistence.SaveMessageArchiveID(communityID, hd.hash)
+		if err != nil {
+			m.logger.Error("couldn't save message archive ID", zap.Error(err))
+			continue
+		}
+		downloadTaskInfo.TotalDownloadedArchivesCount++
+
+		metadata := index.Archives[hd.hash]
+		m.publisher.publish(&Subscription{
+			HistoryArchiveDownloadedSignal: &signal.HistoryArchiveDownloadedSignal{
+				CommunityID: communityID.String(),
+				From:        int(metadata.Metadata.From),
+				To:          int(metadata.Metadata.To),
+			},
+		})
+	}
+
+	return downloadTaskInfo, nil
+}
+
 func (m *ArchiveManager) DownloadHistoryArchivesByMagnetlink(communityID types.HexBytes, magnetlink string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
 
 	id := communityID.String()
@@ -644,6 +872,46 @@ func (m *ArchiveManager) TorrentFileExists(communityID string) bool {
 	return err == nil
 }
 
+// torrentArchiveTransport distributes the archives over BitTorrent. The torrent client
+// seeds and downloads the archive files in place, in the torrent data directory.
+type torrentArchiveTransport struct {
+	manager *ArchiveManager
+}
+
+func (t *torrentArchiveTransport) Name() string {
+	return "torrent"
+}
+
+func (t *torrentArchiveTransport) Supports(location string) bool {
+	return locationScheme(location) == "magnet"
+}
+
+func (t *torrentArchiveTransport) Publish(communityID types.HexBytes, files ArchiveFiles) (string, error) {
+	if !t.manager.torrentClientStarted() {
+		return "", ErrArchiveTransportNotConfigured
+	}
+	return t.manager.seedTorrent(communityID)
+}
+
+func (t *torrentArchiveTransport) Unpublish(communityID types.HexBytes) {
+	t.manager.unseedTorrent(communityID)
+}
+
+func (t *torrentArchiveTransport) Fetch(communityID types.HexBytes, location string, files ArchiveFiles, cancel chan struct{}) error {
+	if !t.manager.torrentClientStarted() {
+		return ErrArchiveTransportNotConfigured
+	}
+
+	downloadTaskInfo, err := t.manager.DownloadHistoryArchivesByMagnetlink(communityID, location, cancel)
+	if err != nil {
+		return err
+	}
+	if downloadTaskInfo.Cancelled {
+		return ErrHistoryArchiveDownloadCancelled
+	}
+	return nil
+}
+
 func topicsAsByteArrays(topics []types.TopicType) [][]byte {
 	var topicsAsByteArrays [][]byte
 	for _, t := range topics {
@@ -31,6 +31,7 @@ import (
 
 type ArchiveFileManager struct {
 	torrentConfig *params.TorrentConfig
+	dataDir       string
 
 	logger      *zap.Logger
 	persistence *Persistence
@@ -43,6 +44,7 @@ type ArchiveFileManager struct {
 func NewArchiveFileManager(amc *ArchiveManagerConfig) *ArchiveFileManager {
 	return &ArchiveFileManager{
 		torrentConfig: amc.TorrentConfig,
+		dataDir:       amc.DataDir,
 		logger:        amc.Logger,
 		persistence:   amc.Persistence,
 		identity:      amc.Identity,
@@ -61,13 +63,14 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 		to = endDate
 	}
 
-	archiveDir := m.torrentConfig.DataDir + "/" + communityID.String()
+	archiveDir := path.Join(m.archiveDataDir(), communityID.String())
 	torrentDir := m.torrentConfig.TorrentDir
 	indexPath := archiveDir + "/index"
 	dataPath := archiveDir + "/data"
 
 	wakuMessageArchiveIndexProto := &protobuf.WakuMessageArchiveIndex{}
 	wakuMessageArchiveIndex := make(map[string]*protobuf.WakuMessageArchiveIndexMetadata)
+	archiveHashes := make(map[string][]byte)
 	archiveIDs := make([]string, 0)
 
 	if _, err := os.Stat(archiveDir); os.IsNotExist(err) {
@@ -85,7 +88,7 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 
 	_, err := os.Stat(indexPath)
 	if err == nil {
-		wakuMessageArchiveIndexProto, err = m.LoadHistoryArchiveIndexFromFile(m.identity, communityID)
+		wakuMessageArchiveIndexProto, err = m.loadHistoryArchiveIndex(m.identity, communityID, indexPath)
 		if err != nil {
 			return archiveIDs, err
 		}
@@ -96,6 +99,17 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 	for hash, metadata := range wakuMessageArchiveIndexProto.Archives {
 		offset = offset + metadata.Size
 		wakuMessageArchiveIndex[hash] = metadata
+
+		archiveHash := wakuMessageArchiveIndexProto.ArchiveHashes[hash]
+		if len(archiveHash) == 0 {
+			// Archives created before indexes were signed don't have a hash yet
+			data, err := readHistoryArchiveData(dataPath, metadata)
+			if err != nil {
+				return archiveIDs, err
+			}
+			archiveHash = historyArchiveHash(data)
+		}
+		archiveHashes[hash] = archiveHash
 	}
 
 	var encodedArchives []*EncodedArchiveData
@@ -216,6 +230,7 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 			archiveID := crypto.Keccak256Hash(wakuMessageArchiveIndexMetadataBytes).String()
 			archiveIDs = append(archiveIDs, archiveID)
 			wakuMessageArchiveIndex[archiveID] = wakuMessageArchiveIndexMetadata
+			archiveHashes[archiveID] = historyArchiveHash(encodedArchive)
 			encodedArchives = append(encodedArchives, &EncodedArchiveData{bytes: encodedArchive, padding: padding})
 			offset = offset + uint64(rawSize) + uint64(padding)
 		}
@@ -236,7 +251,21 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 			dataBytes = append(dataBytes, make([]byte, encodedArchiveData.padding)...)
 		}
 
+		community, err := m.persistence.GetByID(&m.identity.PublicKey, communityID)
+		if err != nil {
+			return archiveIDs, err
+		}
+		if community == nil || community.PrivateKey() == nil {
+			return archiveIDs, ErrNotControlNode
+		}
+
 		wakuMessageArchiveIndexProto.Archives = wakuMessageArchiveIndex
+		wakuMessageArchiveIndexProto.ArchiveHashes = archiveHashes
+		err = signHistoryArchiveIndex(communityID, wakuMessageArchiveIndexProto, community.PrivateKey())
+		if err != nil {
+			return archiveIDs, err
+		}
+
 		indexBytes, err := proto.Marshal(wakuMessageArchiveIndexProto)
 		if err != nil {
 			return archiveIDs, err
@@ -335,8 +364,17 @@ func (m *ArchiveFileManager) createHistoryArchiveTorrent(communityID types.HexBy
 	return archiveIDs, nil
 }
 
+// archiveDataDir returns the directory the archives are stored in. BitTorrent seeds
+// the archives from its own data directory, which takes precedence when configured
+func (m *ArchiveFileManager) archiveDataDir() string {
+	if m.torrentConfig != nil && m.torrentConfig.DataDir != "" {
+		return m.torrentConfig.DataDir
+	}
+	return m.dataDir
+}
+
 func (m *ArchiveFileManager) archiveIndexFile(communityID string) string {
-	return path.Join(m.torrentConfig.DataDir, communityID, "index")
+	return path.Join(m.archiveDataDir(), communityID, "index")
 }
 
 func (m *ArchiveFileManager) createWakuMessageArchive(from time.Time, to time.Time, messages []types.Message, topics [][]byte) *protobuf.WakuMessageArchive {
@@ -407,7 +445,7 @@ func (m *ArchiveFileManager) GetHistoryArchiveMagnetlink(communityID types.HexBy
 }
 
 func (m *ArchiveFileManager) archiveDataFile(communityID string) string {
-	return path.Join(m.torrentConfig.DataDir, communityID, "data")
+	return path.Join(m.archiveDataDir(), communityID, "data")
 }
 
 func (m *ArchiveFileManager) ExtractMessagesFromHistoryArchive(communityID types.HexBytes, archiveID string) ([]*protobuf.WakuMessage, error) {
@@ -418,30 +456,36 @@ func (m *ArchiveFileManager) ExtractMessagesFromHistoryArchive(communityID types
 		return nil, err
 	}
 
-	dataFile, err := os.Open(m.archiveDataFile(id))
-	if err != nil {
-		return nil, err
-	}
-	defer dataFile.Close()
-
 	m.logger.Debug("extracting messages from history archive",
 		zap.String("communityID", communityID.String()),
 		zap.String("archiveID", archiveID))
-	metadata := index.Archives[archiveID]
+	metadata, ok := index.Archives[archiveID]
+	if !ok {
+		return nil, ErrHistoryArchiveHashMismatch
+	}
 
-	_, err = dataFile.Seek(int64(metadata.Offset), 0)
+	m.logger.Debug("loading history archive data into memory", zap.Float64("data_size_MB", float64(metadata.Size-metadata.Padding)/1024.0/1024.0))
+	data, err := readHistoryArchiveData(m.archiveDataFile(id), metadata)
 	if err != nil {
-		m.logger.Error("failed to seek archive data file", zap.Error(err))
+		m.logger.Error("failed failed to read archive data", zap.Error(err))
 		return nil, err
 	}
 
-	data := make([]byte, metadata.Size-metadata.Padding)
-	m.logger.Debug("loading history archive data into memory", zap.Float64("data_size_MB", float64(metadata.Size-metadata.Padding)/1024.0/1024.0))
-	_, err = dataFile.Read(data)
+	err = verifyHistoryArchive(index, archiveID, data)
 	if err != nil {
-		m.logger.Error("failed failed to read archive data", zap.Error(err))
+		m.logger.Warn("history archive verification failed", zap.String("archiveID", archiveID), zap.Error(err))
+		m.publishHistoryArchiveVerificationFailed(communityID, archiveID, err)
 		return nil, err
 	}
+	if len(index.Signature) == 0 {
+		m.logger.Warn("importing unsigned legacy history archive", zap.String("archiveID", archiveID))
+		m.publisher.publish(&Subscription{
+			UnsignedHistoryArchiveImportedSignal: &signal.UnsignedHistoryArchiveImportedSignal{
+				CommunityID: communityID.String(),
+				ArchiveID:   archiveID,
+			},
+		})
+	}
 
 	archive := &protobuf.WakuMessageArchive{}
 
@@ -474,10 +518,92 @@ func (m *ArchiveFileManager) ExtractMessagesFromHistoryArchive(communityID types
 	return archive.Messages, nil
 }
 
+func (m *ArchiveFileManager) archiveFiles(communityID string) ArchiveFiles {
+	return ArchiveFiles{
+		IndexPath: m.archiveIndexFile(communityID),
+		DataPath:  m.archiveDataFile(communityID),
+	}
+}
+
+// LoadHistoryArchiveIndexFromFile loads the archive index of the community and verifies that it has been signed by the community,
+// unsigned legacy indexes are accepted during the transition period until the community publishes a signed one
 func (m *ArchiveFileManager) LoadHistoryArchiveIndexFromFile(myKey *ecdsa.PrivateKey, communityID types.HexBytes) (*protobuf.WakuMessageArchiveIndex, error) {
+	return m.loadVerifiedHistoryArchiveIndex(myKey, communityID, m.archiveIndexFile(communityID.String()))
+}
+
+func (m *ArchiveFileManager) loadVerifiedHistoryArchiveIndex(myKey *ecdsa.PrivateKey, communityID types.HexBytes, indexPath string) (*protobuf.WakuMessageArchiveIndex, error) {
+	index, err := m.loadHistoryArchiveIndex(myKey, communityID, indexPath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyHistoryArchiveIndex(communityID, index)
+	if err == ErrHistoryArchiveIndexNotSigned {
+		accept, aErr := m.acceptUnsignedHistoryArchiveIndex(communityID)
+		if aErr != nil {
+			return nil, aErr
+		}
+		if accept {
+			m.logger.Warn("loading unsigned legacy history archive index", zap.String("communityID", communityID.String()))
+			return index, nil
+		}
+	}
+	if err != nil {
+		m.logger.Warn("history archive index verification failed", zap.String("communityID", communityID.String()), zap.Error(err))
+		m.publishHistoryArchiveVerificationFailed(communityID, "", err)
+		return nil, err
+	}
+
+	err = m.persistence.SaveSignedHistoryArchiveIndex(communityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// acceptUnsignedHistoryArchiveIndex returns whether an unsigned index of the community can be loaded,
+// which is the case until the transition deadline unless the community has already published a signed index
+func (m *ArchiveFileManager) acceptUnsignedHistoryArchiveIndex(communityID types.HexBytes) (bool, error) {
+	if time.Now().After(unsignedHistoryArchiveIndexesDeadline) {
+		return false, nil
+	}
+	signed, err := m.persistence.HasSignedHistoryArchiveIndex(communityID)
+	if err != nil {
+		return false, err
+	}
+	return !signed, nil
+}
+
+func (m *ArchiveFileManager) publishHistoryArchiveVerificationFailed(communityID types.HexBytes, archiveID string, err error) {
+	m.publisher.publish(&Subscription{
+		HistoryArchiveVerificationFailedSignal: &signal.HistoryArchiveVerificationFailedSignal{
+			CommunityID: communityID.String(),
+			ArchiveID:   archiveID,
+			Error:       err.Error(),
+		},
+	})
+}
+
+// readHistoryArchiveData reads the data of an archive, without padding
+func readHistoryArchiveData(dataPath string, metadata *protobuf.WakuMessageArchiveIndexMetadata) ([]byte, error) {
+	dataFile, err := os.Open(dataPath)
+	if err != nil {
+		return nil, err
+	}
+	defer dataFile.Close()
+
+	data := make([]byte, metadata.Size-metadata.Padding)
+	_, err = dataFile.ReadAt(data, int64(metadata.Offset))
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func (m *ArchiveFileManager) loadHistoryArchiveIndex(myKey *ecdsa.PrivateKey, communityID types.HexBytes, indexPath string) (*protobuf.WakuMessageArchiveIndex, error) {
 	wakuMessageArchiveIndexProto := &protobuf.WakuMessageArchiveIndex{}
 
-	indexPath := m.archiveIndexFile(communityID.String())
 	indexData, err := os.ReadFile(indexPath)
 	if err != nil {
 		return nil, err
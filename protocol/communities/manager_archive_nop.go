@@ -83,6 +83,14 @@ func (tmm *ArchiveManagerNop) DownloadHistoryArchivesByMagnetlink(communityID ty
 	return nil, nil
 }
 
+func (tmm *ArchiveManagerNop) DownloadHistoryArchives(communityID types.HexBytes, locations []string, cancelTask chan struct{}) (*HistoryArchiveDownloadTaskInfo, error) {
+	return nil, nil
+}
+
+func (tmm *ArchiveManagerNop) GetHistoryArchiveLocations(communityID types.HexBytes) ([]string, error) {
+	return nil, nil
+}
+
 func (tmm *ArchiveManagerNop) TorrentFileExists(communityID string) bool {
 	return false
 }
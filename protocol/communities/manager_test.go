@@ -860,6 +860,155 @@ func (s *ManagerSuite) TestCreateHistoryArchiveTorrentFromMessages_ShouldAppendA
 	s.Require().Len(index.Archives, 2)
 }
 
+func (s *ManagerSuite) TestHistoryArchiveVerification() {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	topic := types.BytesToTopic(transport.ToTopic(chatID))
+	topics := []types.TopicType{topic}
+
+	startDate := time.Date(2020, 1, 1, 00, 00, 00, 0, time.UTC)
+	endDate := time.Date(2020, 1, 7, 00, 00, 00, 0, time.UTC)
+	partition := 7 * 24 * time.Hour
+
+	message1 := buildMessage(startDate.Add(1*time.Hour), topic, []byte{1})
+	s.Require().NoError(s.manager.StoreWakuMessage(&message1))
+
+	archiveIDs, err := s.archiveManager.CreateHistoryArchiveTorrentFromDB(community.ID(), topics, startDate, endDate, partition, false)
+	s.Require().NoError(err)
+	s.Require().Len(archiveIDs, 1)
+
+	index, err := s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().NoError(err)
+	s.Require().NotEmpty(index.Signature)
+	s.Require().Len(index.ArchiveHashes, 1)
+
+	_, err = s.archiveManager.ExtractMessagesFromHistoryArchive(community.ID(), archiveIDs[0])
+	s.Require().NoError(err)
+
+	subscription := s.manager.Subscribe()
+
+	// Tamper with the archive data
+	dataPath := s.archiveManager.archiveDataFile(community.IDString())
+	data, err := os.ReadFile(dataPath)
+	s.Require().NoError(err)
+	data[0] ^= 0xff
+	s.Require().NoError(os.WriteFile(dataPath, data, 0600))
+
+	_, err = s.archiveManager.ExtractMessagesFromHistoryArchive(community.ID(), archiveIDs[0])
+	s.Require().ErrorIs(err, ErrHistoryArchiveHashMismatch)
+
+	sub := <-subscription
+	s.Require().NotNil(sub.HistoryArchiveVerificationFailedSignal)
+	s.Require().Equal(archiveIDs[0], sub.HistoryArchiveVerificationFailedSignal.ArchiveID)
+
+	writeIndex := func(index *protobuf.WakuMessageArchiveIndex) {
+		indexBytes, err := proto.Marshal(index)
+		s.Require().NoError(err)
+		s.Require().NoError(os.WriteFile(s.archiveManager.archiveIndexFile(community.IDString()), indexBytes, 0600))
+	}
+
+	// Sign the index with another key
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.Require().NoError(signHistoryArchiveIndex(community.ID(), index, otherKey))
+	writeIndex(index)
+
+	_, err = s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().ErrorIs(err, ErrInvalidHistoryArchiveIndexSignature)
+
+	sub = <-subscription
+	s.Require().NotNil(sub.HistoryArchiveVerificationFailedSignal)
+	s.Require().Empty(sub.HistoryArchiveVerificationFailedSignal.ArchiveID)
+
+	// Unsigned indexes are rejected once the community has published a signed one
+	index.Signature = nil
+	index.ArchiveHashes = nil
+	writeIndex(index)
+
+	_, err = s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().ErrorIs(err, ErrHistoryArchiveIndexNotSigned)
+
+	sub = <-subscription
+	s.Require().NotNil(sub.HistoryArchiveVerificationFailedSignal)
+}
+
+func (s *ManagerSuite) TestUnsignedLegacyHistoryArchiveIndex() {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	topic := types.BytesToTopic(transport.ToTopic(chatID))
+	topics := []types.TopicType{topic}
+
+	startDate := time.Date(2020, 1, 1, 00, 00, 00, 0, time.UTC)
+	endDate := time.Date(2020, 1, 7, 00, 00, 00, 0, time.UTC)
+	partition := 7 * 24 * time.Hour
+
+	message1 := buildMessage(startDate.Add(1*time.Hour), topic, []byte{1})
+	s.Require().NoError(s.manager.StoreWakuMessage(&message1))
+
+	archiveIDs, err := s.archiveManager.CreateHistoryArchiveTorrentFromDB(community.ID(), topics, startDate, endDate, partition, false)
+	s.Require().NoError(err)
+	s.Require().Len(archiveIDs, 1)
+
+	// Strip the signature, as published by control nodes that predate archive signing
+	indexPath := s.archiveManager.archiveIndexFile(community.IDString())
+	indexBytes, err := os.ReadFile(indexPath)
+	s.Require().NoError(err)
+	index := &protobuf.WakuMessageArchiveIndex{}
+	s.Require().NoError(proto.Unmarshal(indexBytes, index))
+	index.Signature = nil
+	index.ArchiveHashes = nil
+	indexBytes, err = proto.Marshal(index)
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(indexPath, indexBytes, 0600))
+
+	subscription := s.manager.Subscribe()
+
+	// Unsigned legacy indexes are accepted during the transition period, with a signal
+	legacyIndex, err := s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().NoError(err)
+	s.Require().Empty(legacyIndex.Signature)
+
+	_, err = s.archiveManager.ExtractMessagesFromHistoryArchive(community.ID(), archiveIDs[0])
+	s.Require().NoError(err)
+
+	sub := <-subscription
+	s.Require().NotNil(sub.UnsignedHistoryArchiveImportedSignal)
+	s.Require().Equal(archiveIDs[0], sub.UnsignedHistoryArchiveImportedSignal.ArchiveID)
+
+	// They are rejected after the transition deadline
+	deadline := unsignedHistoryArchiveIndexesDeadline
+	unsignedHistoryArchiveIndexesDeadline = time.Now().Add(-time.Hour)
+	_, err = s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	unsignedHistoryArchiveIndexesDeadline = deadline
+	s.Require().ErrorIs(err, ErrHistoryArchiveIndexNotSigned)
+
+	// Appending archives signs legacy indexes
+
+	message2 := buildMessage(endDate.Add(1*time.Hour), topic, []byte{2})
+	s.Require().NoError(s.manager.StoreWakuMessage(&message2))
+
+	_, err = s.archiveManager.CreateHistoryArchiveTorrentFromDB(community.ID(), topics, endDate, endDate.Add(partition), partition, false)
+	s.Require().NoError(err)
+
+	index, err = s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(index.ArchiveHashes, 2)
+
+	_, err = s.archiveManager.ExtractMessagesFromHistoryArchive(community.ID(), archiveIDs[0])
+	s.Require().NoError(err)
+
+	// Once a signed index is loaded, unsigned ones are rejected
+	index.Signature = nil
+	indexBytes, err = proto.Marshal(index)
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(indexPath, indexBytes, 0600))
+
+	_, err = s.archiveManager.LoadHistoryArchiveIndexFromFile(s.manager.identity, community.ID())
+	s.Require().ErrorIs(err, ErrHistoryArchiveIndexNotSigned)
+}
+
 func (s *ManagerSuite) TestSeedHistoryArchiveTorrent() {
 	err := s.archiveManager.StartTorrentClient()
 	s.Require().NoError(err)
@@ -927,6 +1076,63 @@ func (s *ManagerSuite) TestUnseedHistoryArchiveTorrent() {
 	s.Require().Equal(ok, false)
 }
 
+func (s *ManagerSuite) TestDownloadHistoryArchives_FallsBackAcrossLocations() {
+	community, chatID, err := s.buildCommunityWithChat()
+	s.Require().NoError(err)
+
+	topic := types.BytesToTopic(transport.ToTopic(chatID))
+	topics := []types.TopicType{topic}
+
+	startDate := time.Date(2020, 1, 1, 00, 00, 00, 0, time.UTC)
+	endDate := time.Date(2020, 1, 14, 00, 00, 00, 0, time.UTC)
+	partition := 7 * 24 * time.Hour
+
+	message1 := buildMessage(startDate.Add(1*time.Hour), topic, []byte{1})
+	message2 := buildMessage(startDate.Add(8*24*time.Hour), topic, []byte{2})
+	s.Require().NoError(s.manager.StoreWakuMessage(&message1))
+	s.Require().NoError(s.manager.StoreWakuMessage(&message2))
+
+	archiveIDs, err := s.archiveManager.CreateHistoryArchiveTorrentFromDB(community.ID(), topics, startDate, endDate, partition, false)
+	s.Require().NoError(err)
+	s.Require().Len(archiveIDs, 2)
+
+	directoryTransport := &LocalDirectoryArchiveTransport{Dir: s.T().TempDir()}
+	location, err := directoryTransport.Publish(community.ID(), s.archiveManager.archiveFiles(community.IDString()))
+	s.Require().NoError(err)
+
+	_, downloader := s.buildManagers(nil)
+	downloader.fetchTransports = append([]ArchiveTransport{directoryTransport}, downloader.fetchTransports...)
+	downloader.SetTorrentConfig(&params.TorrentConfig{
+		Enabled:    true,
+		DataDir:    s.T().TempDir(),
+		TorrentDir: s.T().TempDir(),
+	})
+
+	locations := []string{"unsupported://location", "file:///non/existing/archive", location}
+
+	// Archives are only downloaded to the configured data directory
+	_, err = downloader.DownloadHistoryArchives(community.ID(), locations, make(chan struct{}))
+	s.Require().ErrorIs(err, ErrArchiveTransportNotConfigured)
+
+	downloader.dataDir = s.T().TempDir()
+	downloadTaskInfo, err := downloader.DownloadHistoryArchives(community.ID(), locations, make(chan struct{}))
+	s.Require().NoError(err)
+	s.Require().False(downloadTaskInfo.Cancelled)
+	s.Require().Equal(2, downloadTaskInfo.TotalArchivesCount)
+	s.Require().Equal(2, downloadTaskInfo.TotalDownloadedArchivesCount)
+
+	archiveIDsToImport, err := downloader.GetMessageArchiveIDsToImport(community.ID())
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(archiveIDs, archiveIDsToImport)
+
+	messages, err := downloader.ExtractMessagesFromHistoryArchive(community.ID(), archiveIDs[0])
+	s.Require().NoError(err)
+	s.Require().Len(messages, 1)
+
+	_, err = downloader.DownloadHistoryArchives(community.ID(), locations[:2], make(chan struct{}))
+	s.Require().ErrorIs(err, ErrNoHistoryArchiveLocation)
+}
+
 func (s *ManagerSuite) TestCheckChannelPermissions_NoPermissions() {
 
 	m, _, tm := s.setupManagerForTokenPermissions()
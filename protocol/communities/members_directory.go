@@ -0,0 +1,126 @@
+package communities
+
+import (
+	"slices"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	defaultMembersDirectoryLimit = 50
+	maxMembersDirectoryLimit     = 500
+)
+
+// MembersDirectoryQuery describes a page of the members directory of a community.
+// All the filters are optional and combined with AND.
+type MembersDirectoryQuery struct {
+	CommunityID []byte
+	Cursor      string
+	Limit       uint64
+	// Search matches display names and ENS names (case insensitive) or public key prefixes
+	Search string
+	Roles  []protobuf.CommunityMember_Roles
+	// OnlineOnly restricts the results to members whose latest status update marks them as online
+	OnlineOnly bool
+	// PermissionID restricts the results to members granted what the given token permission gives
+	PermissionID string
+	JoinedAfter  uint64
+	JoinedBefore uint64
+}
+
+type MembersDirectoryEntry struct {
+	PublicKey   string                         `json:"publicKey"`
+	DisplayName string                         `json:"displayName"`
+	EnsName     string                         `json:"ensName"`
+	Role        protobuf.CommunityMember_Roles `json:"role"`
+	Online      bool                           `json:"online"`
+	// JoinedAt is the clock of the first community description listing the member,
+	// 0 when unknown. Members who joined at an unknown time come last
+	JoinedAt uint64 `json:"joinedAt"`
+}
+
+type MembersDirectoryPage struct {
+	Cursor  string                   `json:"cursor"`
+	Members []*MembersDirectoryEntry `json:"members"`
+}
+
+type membersDirectoryMember struct {
+	role        protobuf.CommunityMember_Roles
+	permissions map[string]struct{}
+}
+
+// membersDirectoryMembers computes the directory state of each member out of the community description
+func (o *Community) membersDirectoryMembers() map[string]*membersDirectoryMember {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	members := make(map[string]*membersDirectoryMember, len(o.config.CommunityDescription.Members))
+	for pk, member := range o.config.CommunityDescription.Members {
+		members[pk] = &membersDirectoryMember{
+			role:        highestMemberRole(member),
+			permissions: make(map[string]struct{}),
+		}
+	}
+
+	communityID := o.IDString()
+	for _, permission := range o.config.CommunityDescription.TokenPermissions {
+		var channels []*protobuf.CommunityChat
+		for channelID, chat := range o.config.CommunityDescription.Chats {
+			if slices.Contains(permission.ChatIds, ChatID(communityID, channelID)) {
+				channels = append(channels, chat)
+			}
+		}
+
+		for pk, member := range members {
+			if memberGrantedPermission(pk, member.role, permission, channels) {
+				member.permissions[permission.Id] = struct{}{}
+			}
+		}
+	}
+
+	return members
+}
+
+// memberGrantedPermission tells whether the member has been granted what the permission gives,
+// channels being the channels the permission applies to
+func memberGrantedPermission(pk string, role protobuf.CommunityMember_Roles, permission *protobuf.CommunityTokenPermission, channels []*protobuf.CommunityChat) bool {
+	switch permission.Type {
+	case protobuf.CommunityTokenPermission_BECOME_MEMBER:
+		return true
+	case protobuf.CommunityTokenPermission_BECOME_ADMIN:
+		return role == protobuf.CommunityMember_ROLE_ADMIN
+	case protobuf.CommunityTokenPermission_BECOME_TOKEN_MASTER:
+		return role == protobuf.CommunityMember_ROLE_TOKEN_MASTER
+	case protobuf.CommunityTokenPermission_BECOME_TOKEN_OWNER:
+		return role == protobuf.CommunityMember_ROLE_OWNER
+	case protobuf.CommunityTokenPermission_CAN_VIEW_CHANNEL, protobuf.CommunityTokenPermission_CAN_VIEW_AND_POST_CHANNEL:
+		for _, chat := range channels {
+			member, ok := chat.Members[pk]
+			if !ok {
+				continue
+			}
+			if permission.Type == protobuf.CommunityTokenPermission_CAN_VIEW_CHANNEL ||
+				member.ChannelRole == protobuf.CommunityMember_CHANNEL_ROLE_POSTER {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func highestMemberRole(member *protobuf.CommunityMember) protobuf.CommunityMember_Roles {
+	roles := make(map[protobuf.CommunityMember_Roles]bool, len(member.Roles))
+	for _, role := range member.Roles {
+		roles[role] = true
+	}
+
+	switch {
+	case roles[protobuf.CommunityMember_ROLE_OWNER]:
+		return protobuf.CommunityMember_ROLE_OWNER
+	case roles[protobuf.CommunityMember_ROLE_TOKEN_MASTER]:
+		return protobuf.CommunityMember_ROLE_TOKEN_MASTER
+	case roles[protobuf.CommunityMember_ROLE_ADMIN]:
+		return protobuf.CommunityMember_ROLE_ADMIN
+	}
+	return protobuf.CommunityMember_ROLE_NONE
+}
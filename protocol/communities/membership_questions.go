@@ -0,0 +1,95 @@
+package communities
+
+import (
+	"net/url"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const maxMembershipAnswerLength = 1000
+
+func (o *Community) MembershipQuestions() []*protobuf.CommunityMembershipQuestion {
+	if o != nil &&
+		o.config != nil &&
+		o.config.CommunityDescription != nil &&
+		o.config.CommunityDescription.Permissions != nil {
+		return o.config.CommunityDescription.Permissions.MembershipQuestions
+	}
+	return nil
+}
+
+// ValidateMembershipAnswers checks the answers given by an applicant against
+// the membership questions of the community
+func (o *Community) ValidateMembershipAnswers(answers []*protobuf.CommunityMembershipAnswer) error {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	return o.validateMembershipAnswers(answers)
+}
+
+func (o *Community) validateMembershipAnswers(answers []*protobuf.CommunityMembershipAnswer) error {
+	questions := make(map[string]*protobuf.CommunityMembershipQuestion)
+	for _, question := range o.MembershipQuestions() {
+		questions[question.Id] = question
+	}
+
+	answered := make(map[string]struct{}, len(answers))
+	for _, answer := range answers {
+		question, ok := questions[answer.QuestionId]
+		if !ok {
+			return ErrMembershipQuestionNotFound
+		}
+
+		if _, ok := answered[answer.QuestionId]; ok {
+			return ErrInvalidMembershipAnswer
+		}
+		answered[answer.QuestionId] = struct{}{}
+
+		if !validMembershipAnswer(question, answer) {
+			return ErrInvalidMembershipAnswer
+		}
+	}
+
+	for _, question := range questions {
+		if _, ok := answered[question.Id]; question.Required && !ok {
+			return ErrMembershipAnswerRequired
+		}
+	}
+
+	return nil
+}
+
+func validMembershipAnswer(question *protobuf.CommunityMembershipQuestion, answer *protobuf.CommunityMembershipAnswer) bool {
+	switch question.Type {
+	case protobuf.CommunityMembershipQuestion_FREE_TEXT:
+		return len(answer.Text) > 0 && len(answer.Text) <= maxMembershipAnswerLength
+
+	case protobuf.CommunityMembershipQuestion_MULTIPLE_CHOICE:
+		if len(answer.Choices) == 0 {
+			return false
+		}
+		selected := make(map[uint32]struct{}, len(answer.Choices))
+		for _, choice := range answer.Choices {
+			if int(choice) >= len(question.Choices) {
+				return false
+			}
+			if _, ok := selected[choice]; ok {
+				return false
+			}
+			selected[choice] = struct{}{}
+		}
+		return true
+
+	case protobuf.CommunityMembershipQuestion_SOCIAL_LINK:
+		if len(answer.Link) > maxMembershipAnswerLength {
+			return false
+		}
+		link, err := url.ParseRequestURI(answer.Link)
+		if err != nil {
+			return false
+		}
+		return (link.Scheme == "https" || link.Scheme == "http") && link.Host != ""
+	}
+
+	return false
+}
@@ -198,7 +198,11 @@ func (p *Persistence) SaveCommunity(community *Community) error {
 	if err != nil {
 		return err
 	}
-	return p.saveCommunity(record)
+	err = p.saveCommunity(record)
+	if err != nil {
+		return err
+	}
+	return p.updateMembersDirectory(community)
 }
 
 func (p *Persistence) DeleteCommunityEvents(id types.HexBytes) error {
@@ -226,7 +230,10 @@ func (p *Persistence) SaveCommunityEvents(community *Community) error {
 func (p *Persistence) DeleteCommunity(id types.HexBytes) error {
 	_, err := p.db.Exec(`DELETE FROM communities_communities WHERE id = ?;
 						 DELETE FROM communities_events WHERE id = ?;
-						 DELETE FROM communities_shards WHERE community_id = ?`, id, id, id)
+						 DELETE FROM communities_shards WHERE community_id = ?;
+						 DELETE FROM communities_members_directory WHERE community_id = ?;
+						 DELETE FROM communities_members_directory_permissions WHERE community_id = ?;
+						 DELETE FROM communities_members_directory_clocks WHERE community_id = ?`, id, id, id, id, id, id)
 	return err
 }
 
@@ -406,7 +413,81 @@ func (p *Persistence) SaveRequestToJoin(request *RequestToJoin) (err error) {
 	}
 
 	_, err = tx.Exec(`INSERT OR REPLACE INTO communities_requests_to_join(id,public_key,clock,ens_name,customization_color,chat_id,community_id,state,share_future_addresses) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`, request.ID, request.PublicKey, request.Clock, request.ENSName, request.CustomizationColor, request.ChatID, request.CommunityID, request.State, request.ShareFutureAddresses)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// Requests updated without answers (e.g. state changes) keep the answers
+	// of the original application
+	if len(request.MembershipAnswers) == 0 {
+		return nil
+	}
+
+	return saveRequestToJoinMembershipAnswers(tx, request.ID, request.MembershipAnswers)
+}
+
+func saveRequestToJoinMembershipAnswers(tx *sql.Tx, requestID types.HexBytes, answers []*protobuf.CommunityMembershipAnswer) error {
+	_, err := tx.Exec(`DELETE FROM communities_requests_to_join_answers WHERE request_id = ?`, requestID)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(`INSERT INTO communities_requests_to_join_answers (request_id, question_id, answer) VALUES (?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, answer := range answers {
+		encodedAnswer, err := proto.Marshal(answer)
+		if err != nil {
+			return err
+		}
+
+		_, err = stmt.Exec(requestID, answer.QuestionId, encodedAnswer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *Persistence) GetRequestToJoinMembershipAnswers(requestID []byte) ([]*protobuf.CommunityMembershipAnswer, error) {
+	rows, err := p.db.Query(`SELECT answer FROM communities_requests_to_join_answers WHERE request_id = ? ORDER BY rowid`, requestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var answers []*protobuf.CommunityMembershipAnswer
+	for rows.Next() {
+		var encodedAnswer []byte
+		err := rows.Scan(&encodedAnswer)
+		if err != nil {
+			return nil, err
+		}
+
+		answer := &protobuf.CommunityMembershipAnswer{}
+		err = proto.Unmarshal(encodedAnswer, answer)
+		if err != nil {
+			return nil, err
+		}
+		answers = append(answers, answer)
+	}
+
+	return answers, rows.Err()
+}
+
+func (p *Persistence) populateMembershipAnswers(requests []*RequestToJoin) error {
+	for _, request := range requests {
+		answers, err := p.GetRequestToJoinMembershipAnswers(request.ID)
+		if err != nil {
+			return err
+		}
+		request.MembershipAnswers = answers
+	}
+	return nil
 }
 
 func (p *Persistence) SaveRequestToJoinRevealedAddresses(requestID types.HexBytes, revealedAccounts []*protobuf.RevealedAccount) (err error) {
@@ -742,6 +823,15 @@ func (p *Persistence) RequestsToJoinForCommunityWithState(id []byte, state Reque
 		}
 		requests = append(requests, request)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = p.populateMembershipAnswers(requests)
+	if err != nil {
+		return nil, err
+	}
+
 	return requests, nil
 }
 
@@ -803,6 +893,10 @@ func (p *Persistence) DeletePendingRequestToJoin(id []byte) error {
 		return err
 	}
 	_, err = p.db.Exec(`DELETE FROM communities_requests_to_join_revealed_addresses WHERE request_id = ?`, id)
+	if err != nil {
+		return err
+	}
+	_, err = p.db.Exec(`DELETE FROM communities_requests_to_join_answers WHERE request_id = ?`, id)
 
 	return err
 }
@@ -1017,6 +1111,17 @@ func (p *Persistence) GetMagnetlinkMessageClock(communityID types.HexBytes) (uin
 	return magnetlinkClock, err
 }
 
+// HasSignedHistoryArchiveIndex returns whether a history archive index signed by the community has been verified
+func (p *Persistence) HasSignedHistoryArchiveIndex(communityID types.HexBytes) (exists bool, err error) {
+	err = p.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM communities_signed_archive_indexes WHERE community_id = ?)`, communityID.String()).Scan(&exists)
+	return exists, err
+}
+
+func (p *Persistence) SaveSignedHistoryArchiveIndex(communityID types.HexBytes) error {
+	_, err := p.db.Exec(`INSERT INTO communities_signed_archive_indexes (community_id) VALUES (?)`, communityID.String())
+	return err
+}
+
 func (p *Persistence) SaveCommunityArchiveInfo(communityID types.HexBytes, clock uint64, lastArchiveEndDate uint64) error {
 	_, err := p.db.Exec(`INSERT INTO communities_archive_info (magnetlink_clock, last_message_archive_end_date, community_id) VALUES (?, ?, ?)`,
 		clock,
@@ -1465,6 +1570,11 @@ func (p *Persistence) GetCommunityRequestsToJoinWithRevealedAddresses(communityI
 		}
 	}
 
+	err = p.populateMembershipAnswers(requests)
+	if err != nil {
+		return nil, err
+	}
+
 	return requests, nil
 }
 
@@ -2192,3 +2302,255 @@ func (p *Persistence) UpdateAndPruneEncryptionKeyRequests(communityID types.HexB
 
 	return nil
 }
+
+// updateMembersDirectory brings the members directory of the community in line
+// with its description. Only members whose role or granted permissions changed are written.
+func (p *Persistence) updateMembersDirectory(community *Community) (err error) {
+	if community.config.CommunityDescription == nil {
+		return nil
+	}
+
+	tx, err := p.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	communityID := community.ID()
+	clock := community.Clock()
+
+	var directoryClock uint64
+	err = tx.QueryRow(`SELECT clock FROM communities_members_directory_clocks WHERE community_id = ?`, communityID).Scan(&directoryClock)
+	if err == nil && directoryClock == clock {
+		return nil
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	// Members are considered to have joined with the first description listing them. When the directory
+	// is first built, e.g. after the migration or when joining the community, the members already listed
+	// joined at an unknown time
+	joinedAt := clock
+	if err == sql.ErrNoRows {
+		joinedAt = 0
+	}
+
+	current, err := p.membersDirectoryMembers(tx, communityID)
+	if err != nil {
+		return err
+	}
+
+	members := community.membersDirectoryMembers()
+
+	for pk := range current {
+		if _, ok := members[pk]; ok {
+			continue
+		}
+		_, err = tx.Exec(`DELETE FROM communities_members_directory WHERE community_id = ? AND public_key = ?`, communityID, pk)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`DELETE FROM communities_members_directory_permissions WHERE community_id = ? AND public_key = ?`, communityID, pk)
+		if err != nil {
+			return err
+		}
+	}
+
+	for pk, member := range members {
+		currentMember, ok := current[pk]
+		if !ok {
+			_, err = tx.Exec(`INSERT INTO communities_members_directory (community_id, public_key, role, joined_at) VALUES (?, ?, ?, ?)`, communityID, pk, member.role, joinedAt)
+			if err != nil {
+				return err
+			}
+			currentMember = &membersDirectoryMember{permissions: make(map[string]struct{})}
+		} else if currentMember.role != member.role {
+			_, err = tx.Exec(`UPDATE communities_members_directory SET role = ? WHERE community_id = ? AND public_key = ?`, member.role, communityID, pk)
+			if err != nil {
+				return err
+			}
+		}
+
+		for permissionID := range currentMember.permissions {
+			if _, ok := member.permissions[permissionID]; ok {
+				continue
+			}
+			_, err = tx.Exec(`DELETE FROM communities_members_directory_permissions WHERE community_id = ? AND public_key = ? AND permission_id = ?`, communityID, pk, permissionID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for permissionID := range member.permissions {
+			if _, ok := currentMember.permissions[permissionID]; ok {
+				continue
+			}
+			_, err = tx.Exec(`INSERT INTO communities_members_directory_permissions (community_id, public_key, permission_id) VALUES (?, ?, ?)`, communityID, pk, permissionID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.Exec(`INSERT INTO communities_members_directory_clocks (community_id, clock) VALUES (?, ?)`, communityID, clock)
+	return err
+}
+
+func (p *Persistence) membersDirectoryMembers(tx *sql.Tx, communityID []byte) (map[string]*membersDirectoryMember, error) {
+	members := make(map[string]*membersDirectoryMember)
+
+	rows, err := tx.Query(`SELECT public_key, role FROM communities_members_directory WHERE community_id = ?`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var pk string
+		member := &membersDirectoryMember{permissions: make(map[string]struct{})}
+		err = rows.Scan(&pk, &member.role)
+		if err != nil {
+			return nil, err
+		}
+		members[pk] = member
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	permissionRows, err := tx.Query(`SELECT public_key, permission_id FROM communities_members_directory_permissions WHERE community_id = ?`, communityID)
+	if err != nil {
+		return nil, err
+	}
+	defer permissionRows.Close()
+
+	for permissionRows.Next() {
+		var pk, permissionID string
+		err = permissionRows.Scan(&pk, &permissionID)
+		if err != nil {
+			return nil, err
+		}
+		if member, ok := members[pk]; ok {
+			member.permissions[permissionID] = struct{}{}
+		}
+	}
+
+	return members, permissionRows.Err()
+}
+
+func (p *Persistence) MembersDirectory(query *MembersDirectoryQuery) (*MembersDirectoryPage, error) {
+	now := uint64(time.Now().Unix())
+
+	// See status_update.proto for the meaning of each status type
+	args := []interface{}{
+		protobuf.StatusUpdate_AUTOMATIC, now - 5*60,
+		protobuf.StatusUpdate_ALWAYS_ONLINE, now - 14*24*60*60,
+		query.CommunityID,
+	}
+	conditions := []string{"d.community_id = ?"}
+
+	if query.Cursor != "" {
+		conditions = append(conditions, "cursor <= ?")
+		args = append(args, query.Cursor)
+	}
+
+	if query.Search != "" {
+		search := "%" + escapeLikePattern(query.Search) + "%"
+		keyPrefix := escapeLikePattern(strings.TrimPrefix(query.Search, "0x")) + "%"
+		conditions = append(conditions, `(c.display_name LIKE ? ESCAPE '\' OR e.name LIKE ? ESCAPE '\' OR substr(d.public_key, 3) LIKE ? ESCAPE '\')`)
+		args = append(args, search, search, keyPrefix)
+	}
+
+	if len(query.Roles) > 0 {
+		conditions = append(conditions, fmt.Sprintf("d.role IN (%s)", strings.Repeat("?, ", len(query.Roles)-1)+"?"))
+		for _, role := range query.Roles {
+			args = append(args, role)
+		}
+	}
+
+	if query.OnlineOnly {
+		conditions = append(conditions, "online")
+	}
+
+	if query.PermissionID != "" {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM communities_members_directory_permissions dp WHERE dp.community_id = d.community_id AND dp.public_key = d.public_key AND dp.permission_id = ?)`)
+		args = append(args, query.PermissionID)
+	}
+
+	if query.JoinedAfter != 0 {
+		conditions = append(conditions, "d.joined_at >= ?")
+		args = append(args, query.JoinedAfter)
+	}
+
+	// Members who joined at an unknown time are only matched without a joined range
+	if query.JoinedBefore != 0 {
+		conditions = append(conditions, "d.joined_at > 0 AND d.joined_at <= ?")
+		args = append(args, query.JoinedBefore)
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultMembersDirectoryLimit
+	} else if limit > maxMembersDirectoryLimit {
+		limit = maxMembersDirectoryLimit
+	}
+	// We fetch limit + 1 to check for pagination
+	args = append(args, limit+1)
+
+	rows, err := p.db.Query(fmt.Sprintf( // nolint: gosec
+		`SELECT
+			d.public_key,
+			COALESCE(c.display_name, ''),
+			COALESCE(e.name, ''),
+			d.role,
+			d.joined_at,
+			COALESCE((s.status_type = ? AND s.clock >= ?) OR (s.status_type = ? AND s.clock >= ?), 0) AS online,
+			substr('0000000000000000000000000000000000000000000000000000000000000000' || d.joined_at, -64, 64) || d.public_key AS cursor
+		FROM communities_members_directory d
+		LEFT JOIN contacts c ON c.id = d.public_key
+		LEFT JOIN ens_verification_records e ON e.public_key = d.public_key AND e.verified
+		LEFT JOIN status_updates s ON s.public_key = d.public_key
+		WHERE %s
+		ORDER BY cursor DESC
+		LIMIT ?`, strings.Join(conditions, " AND ")), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	page := &MembersDirectoryPage{}
+	var cursors []string
+	for rows.Next() {
+		var cursor string
+		entry := &MembersDirectoryEntry{}
+		err = rows.Scan(&entry.PublicKey, &entry.DisplayName, &entry.EnsName, &entry.Role, &entry.JoinedAt, &entry.Online, &cursor)
+		if err != nil {
+			return nil, err
+		}
+		page.Members = append(page.Members, entry)
+		cursors = append(cursors, cursor)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if uint64(len(page.Members)) > limit {
+		page.Cursor = cursors[limit]
+		page.Members = page.Members[:limit]
+	}
+
+	return page, nil
+}
+
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
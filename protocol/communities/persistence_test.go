@@ -766,6 +766,157 @@ func (s *PersistenceSuite) TestAllNonApprovedCommunitiesRequestsToJoin() {
 	s.Require().Len(result, 6) // all except RequestToJoinStateAccepted
 }
 
+func (s *PersistenceSuite) TestSaveRequestToJoinMembershipAnswers() {
+	identity, err := crypto.GenerateKey()
+	s.Require().NoError(err, "crypto.GenerateKey shouldn't give any error")
+
+	community := s.makeNewCommunity(identity)
+
+	rtj := &RequestToJoin{
+		ID:          types.HexBytes{1, 2, 3, 4, 5, 6, 7, 8},
+		PublicKey:   common.PubkeyToHex(&identity.PublicKey),
+		Clock:       uint64(time.Now().Unix()),
+		CommunityID: community.ID(),
+		State:       RequestToJoinStatePending,
+		MembershipAnswers: []*protobuf.CommunityMembershipAnswer{
+			{QuestionId: "why", Text: "I like the project"},
+			{QuestionId: "role", Choices: []uint32{0, 2}},
+			{QuestionId: "link", Link: "https://example.com/me"},
+		},
+	}
+	err = s.db.SaveRequestToJoin(rtj)
+	s.Require().NoError(err, "SaveRequestToJoin shouldn't give any error")
+
+	requests, err := s.db.PendingRequestsToJoinForCommunity(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(requests, 1)
+	s.Require().Len(requests[0].MembershipAnswers, 3)
+	for i, answer := range requests[0].MembershipAnswers {
+		s.Require().True(proto.Equal(rtj.MembershipAnswers[i], answer))
+	}
+
+	// Answers are kept once the request has been accepted
+	err = s.db.SetRequestToJoinState(rtj.PublicKey, community.ID(), RequestToJoinStateAccepted)
+	s.Require().NoError(err)
+
+	answers, err := s.db.GetRequestToJoinMembershipAnswers(rtj.ID)
+	s.Require().NoError(err)
+	s.Require().Len(answers, 3)
+
+	// Saving again replaces the previous answers
+	rtj.MembershipAnswers = rtj.MembershipAnswers[:1]
+	err = s.db.SaveRequestToJoin(rtj)
+	s.Require().NoError(err)
+
+	answers, err = s.db.GetRequestToJoinMembershipAnswers(rtj.ID)
+	s.Require().NoError(err)
+	s.Require().Len(answers, 1)
+	s.Require().Equal("why", answers[0].QuestionId)
+}
+
+func (s *PersistenceSuite) TestMembersDirectory() {
+	community := s.makeNewCommunity(s.identity)
+
+	memberKeys := make([]string, 3)
+	for i := range memberKeys {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		memberKeys[i] = common.PubkeyToHex(&key.PublicKey)
+	}
+
+	description := community.config.CommunityDescription
+	description.Clock = 1
+	description.Members = map[string]*protobuf.CommunityMember{
+		memberKeys[0]: &protobuf.CommunityMember{},
+	}
+	description.TokenPermissions = map[string]*protobuf.CommunityTokenPermission{
+		"admin-permission": &protobuf.CommunityTokenPermission{
+			Id:   "admin-permission",
+			Type: protobuf.CommunityTokenPermission_BECOME_ADMIN,
+		},
+	}
+	s.Require().NoError(s.db.SaveCommunity(community))
+
+	description.Clock = 2
+	description.Members[memberKeys[1]] = &protobuf.CommunityMember{Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}}
+	description.Members[memberKeys[2]] = &protobuf.CommunityMember{}
+	s.Require().NoError(s.db.SaveCommunity(community))
+
+	_, err := s.db.db.Exec(`INSERT INTO contacts (id, address, name, alias, identicon, photo, tribute_to_talk, display_name) VALUES (?, '', '', '', '', '', '', 'alice')`, memberKeys[0])
+	s.Require().NoError(err)
+	_, err = s.db.db.Exec(`INSERT INTO status_updates (public_key, status_type, clock) VALUES (?, ?, ?)`, memberKeys[2], protobuf.StatusUpdate_AUTOMATIC, time.Now().Unix())
+	s.Require().NoError(err)
+
+	// Newest members come first, the members listed when the directory was built joined at an unknown time
+	page, err := s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 2)
+	s.Require().NotEmpty(page.Cursor)
+	s.Require().Equal(uint64(2), page.Members[0].JoinedAt)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), Limit: 2, Cursor: page.Cursor})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Empty(page.Cursor)
+	s.Require().Equal(memberKeys[0], page.Members[0].PublicKey)
+	s.Require().Equal("alice", page.Members[0].DisplayName)
+	s.Require().Equal(uint64(0), page.Members[0].JoinedAt)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), Search: "ALI"})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(memberKeys[0], page.Members[0].PublicKey)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), Search: memberKeys[1][:12]})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(memberKeys[1], page.Members[0].PublicKey)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), Roles: []protobuf.CommunityMember_Roles{protobuf.CommunityMember_ROLE_ADMIN}})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(memberKeys[1], page.Members[0].PublicKey)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), PermissionID: "admin-permission"})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(memberKeys[1], page.Members[0].PublicKey)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), OnlineOnly: true})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 1)
+	s.Require().Equal(memberKeys[2], page.Members[0].PublicKey)
+	s.Require().True(page.Members[0].Online)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), JoinedBefore: 2})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 2)
+	s.Require().NotContains([]string{page.Members[0].PublicKey, page.Members[1].PublicKey}, memberKeys[0])
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), JoinedAfter: 1})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 2)
+
+	// Removed members and revoked roles are reflected with the next description
+	description.Clock = 3
+	delete(description.Members, memberKeys[0])
+	description.Members[memberKeys[1]].Roles = nil
+	s.Require().NoError(s.db.SaveCommunity(community))
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID()})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 2)
+
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID(), PermissionID: "admin-permission"})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 0)
+
+	s.Require().NoError(s.db.DeleteCommunity(community.ID()))
+	page, err = s.db.MembersDirectory(&MembersDirectoryQuery{CommunityID: community.ID()})
+	s.Require().NoError(err)
+	s.Require().Len(page.Members, 0)
+}
+
 func (s *PersistenceSuite) TestSaveShardInfo() {
 	communityID := types.HexBytes{1, 2, 3, 4, 5, 6, 7, 8}
 	clock := uint64(1)
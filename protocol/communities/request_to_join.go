@@ -35,6 +35,7 @@ type RequestToJoin struct {
 	RevealedAccounts     []*protobuf.RevealedAccount            `json:"revealedAccounts,omitempty"`
 	CustomizationColor   multiaccountscommon.CustomizationColor `json:"customizationColor,omitempty"`
 	ShareFutureAddresses bool                                   `json:"shareFutureAddresses"`
+	MembershipAnswers    []*protobuf.CommunityMembershipAnswer  `json:"membershipAnswers,omitempty"`
 }
 
 func (r *RequestToJoin) CalculateID() {
@@ -48,6 +49,7 @@ func (r *RequestToJoin) ToCommunityRequestToJoinProtobuf() *protobuf.CommunityRe
 		CommunityId:        r.CommunityID,
 		RevealedAccounts:   r.RevealedAccounts,
 		CustomizationColor: multiaccountscommon.ColorToIDFallbackToBlue(r.CustomizationColor),
+		MembershipAnswers:  r.MembershipAnswers,
 	}
 }
 
@@ -63,6 +65,7 @@ func (r *RequestToJoin) ToSyncProtobuf() *protobuf.SyncCommunityRequestsToJoin {
 		RevealedAccounts:     r.RevealedAccounts,
 		CustomizationColor:   multiaccountscommon.ColorToIDFallbackToBlue(r.CustomizationColor),
 		ShareFutureAddresses: r.ShareFutureAddresses,
+		MembershipAnswers:    r.MembershipAnswers,
 	}
 }
 
@@ -77,6 +80,7 @@ func (r *RequestToJoin) InitFromSyncProtobuf(proto *protobuf.SyncCommunityReques
 	r.RevealedAccounts = proto.RevealedAccounts
 	r.CustomizationColor = multiaccountscommon.IDToColorFallbackToBlue(proto.CustomizationColor)
 	r.ShareFutureAddresses = proto.ShareFutureAddresses
+	r.MembershipAnswers = proto.MembershipAnswers
 }
 
 func (r *RequestToJoin) Empty() bool {
@@ -711,6 +711,64 @@ func (s *MessengerCommunitiesSuite) TestImportCommunity() {
 	s.Require().NoError(err)
 }
 
+func (s *MessengerCommunitiesSuite) TestImportCommunityControlNodeBundle() {
+	ctx := context.Background()
+
+	community, _ := s.createCommunity()
+
+	s.advertiseCommunityTo(community, s.owner, s.bob)
+	s.joinCommunity(community, s.owner, s.bob)
+
+	bundle, err := s.owner.ExportCommunityControlNodeBundle(&requests.ExportCommunityControlNodeBundle{
+		CommunityID: community.ID(),
+		Password:    "bundle-password",
+	})
+	s.Require().NoError(err)
+
+	_, err = s.alice.ImportCommunityControlNodeBundle(ctx, &requests.ImportCommunityControlNodeBundle{
+		Bundle:   bundle,
+		Password: "wrong-password",
+	})
+	s.Require().ErrorIs(err, communities.ErrControlNodeBundleDecryptionFailed)
+
+	response, err := s.alice.ImportCommunityControlNodeBundle(ctx, &requests.ImportCommunityControlNodeBundle{
+		Bundle:   bundle,
+		Password: "bundle-password",
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+
+	importedCommunity, err := s.alice.communitiesManager.GetByID(community.ID())
+	s.Require().NoError(err)
+	s.Require().True(importedCommunity.IsControlNode())
+	s.Require().True(importedCommunity.HasMember(&s.bob.identity.PublicKey))
+
+	// Requests to join and revealed addresses are carried over
+	requestsToJoin, err := s.alice.communitiesManager.GetCommunityRequestsToJoinWithRevealedAddresses(community.ID())
+	s.Require().NoError(err)
+	s.Require().Len(requestsToJoin, 1)
+	s.Require().Equal(common.PubkeyToHex(&s.bob.identity.PublicKey), requestsToJoin[0].PublicKey)
+	s.Require().NotEmpty(requestsToJoin[0].RevealedAccounts)
+
+	newDescription := "new description set post import"
+	_, err = s.alice.EditCommunity(&requests.EditCommunity{
+		CommunityID: community.ID(),
+		CreateCommunity: requests.CreateCommunity{
+			Membership:  protobuf.CommunityPermissions_MANUAL_ACCEPT,
+			Name:        community.Name(),
+			Color:       community.Color(),
+			Description: newDescription,
+		},
+	})
+	s.Require().NoError(err)
+
+	// bob receives new description
+	_, err = WaitOnMessengerResponse(s.bob, func(r *MessengerResponse) bool {
+		return len(r.Communities()) > 0 && r.Communities()[0].DescriptionText() == newDescription
+	}, "new description not received")
+	s.Require().NoError(err)
+}
+
 func (s *MessengerCommunitiesSuite) TestRemovePrivateKey() {
 	description := &requests.CreateCommunity{
 		Membership:  protobuf.CommunityPermissions_AUTO_ACCEPT,
@@ -480,6 +480,148 @@ func (s *EncryptionServiceTestSuite) TestConversation() {
 	s.Equal(cleartext2, decryptedPayload1.DecryptedMessage, "It correctly decrypts the payload using X3DH")
 }
 
+func (s *EncryptionServiceTestSuite) establishConversation(aliceKey, bobKey *ecdsa.PrivateKey) {
+	bobBundle, err := s.bob.GetBundle(bobKey)
+	s.Require().NoError(err)
+
+	aliceBundle, err := s.alice.GetBundle(aliceKey)
+	s.Require().NoError(err)
+
+	_, err = s.alice.ProcessPublicBundle(aliceKey, bobBundle)
+	s.Require().NoError(err)
+
+	_, err = s.bob.ProcessPublicBundle(bobKey, aliceBundle)
+	s.Require().NoError(err)
+
+	s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+	s.sendAndReceive(s.bob, bobKey, s.alice, aliceKey, cleartext)
+	s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+}
+
+func (s *EncryptionServiceTestSuite) sendAndReceive(sender *Protocol, senderKey *ecdsa.PrivateKey, receiver *Protocol, receiverKey *ecdsa.PrivateKey, payload []byte) {
+	message, err := sender.BuildEncryptedMessage(senderKey, &receiverKey.PublicKey, payload)
+	s.Require().NoError(err)
+
+	response, err := receiver.HandleMessage(receiverKey, &senderKey.PublicKey, message.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(payload, response.DecryptedMessage)
+}
+
+// One party has reset the session, the other one has to drop the session
+// derived from the previous X3DH key exchange
+func (s *EncryptionServiceTestSuite) TestSessionResetByOneParty() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	s.establishConversation(aliceKey, bobKey)
+
+	s.Require().NoError(s.alice.ResetSession(&bobKey.PublicKey))
+	// Sessions established within the same millisecond can't be ordered
+	time.Sleep(2 * time.Millisecond)
+
+	// Alice uses X3DH again with the same bundle of bob
+	message, err := s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+	s.Require().NotNil(message.Message.GetEncryptedMessage()[bobInstallationID].GetX3DHHeader())
+
+	response, err := s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, message.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(cleartext, response.DecryptedMessage)
+
+	s.sendAndReceive(s.bob, bobKey, s.alice, aliceKey, cleartext)
+	s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+}
+
+// A delayed message from a session which has since been reset doesn't replace the current session
+func (s *EncryptionServiceTestSuite) TestSessionResetWithDelayedMessage() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobSubscriptions, err := s.bob.Start(bobKey)
+	s.Require().NoError(err)
+	defer func() { s.Require().NoError(s.bob.Stop()) }()
+
+	bobBundle, err := s.bob.GetBundle(bobKey)
+	s.Require().NoError(err)
+	_, err = s.alice.ProcessPublicBundle(aliceKey, bobBundle)
+	s.Require().NoError(err)
+
+	firstMessage, err := s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+	delayedMessage, err := s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+
+	response, err := s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, firstMessage.Message, defaultMessageID)
+	s.Require().NoError(err)
+	s.Require().Equal(cleartext, response.DecryptedMessage)
+
+	s.Require().NoError(s.alice.ResetSession(&bobKey.PublicKey))
+	// Sessions established within the same millisecond can't be ordered
+	time.Sleep(2 * time.Millisecond)
+	s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+
+	// The delayed message was sent using the previous session, which isn't restored
+	_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, delayedMessage.Message, defaultMessageID)
+	s.Require().ErrorIs(err, ErrOutdatedSession)
+
+	select {
+	case <-bobSubscriptions.DecryptionFailures:
+		s.Fail("decryption failure published for an outdated session")
+	default:
+	}
+
+	for i := 0; i < 3; i++ {
+		s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+		s.sendAndReceive(s.bob, bobKey, s.alice, aliceKey, cleartext)
+	}
+}
+
+// Bob has lost the session, alice's messages can't be decrypted until both reset it
+func (s *EncryptionServiceTestSuite) TestSessionReset() {
+	aliceKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	bobSubscriptions, err := s.bob.Start(bobKey)
+	s.Require().NoError(err)
+	defer func() { s.Require().NoError(s.bob.Stop()) }()
+
+	s.establishConversation(aliceKey, bobKey)
+
+	s.Require().NoError(s.bob.ResetSession(&aliceKey.PublicKey))
+
+	message, err := s.alice.BuildEncryptedMessage(aliceKey, &bobKey.PublicKey, cleartext)
+	s.Require().NoError(err)
+
+	_, err = s.bob.HandleMessage(bobKey, &aliceKey.PublicKey, message.Message, defaultMessageID)
+	s.Require().Error(err)
+
+	select {
+	case failure := <-bobSubscriptions.DecryptionFailures:
+		s.Require().True(failure.PublicKey.Equal(&aliceKey.PublicKey))
+		s.Require().Equal(aliceInstallationID, failure.InstallationID)
+	default:
+		s.Fail("decryption failure not published")
+	}
+
+	// Bob notifies alice using a new session, alice resets the session on her side too
+	s.sendAndReceive(s.bob, bobKey, s.alice, aliceKey, cleartext)
+	s.Require().NoError(s.alice.ResetSession(&bobKey.PublicKey))
+
+	for i := 0; i < 3; i++ {
+		s.sendAndReceive(s.alice, aliceKey, s.bob, bobKey, cleartext)
+		s.sendAndReceive(s.bob, bobKey, s.alice, aliceKey, cleartext)
+	}
+}
+
 // Previous implementation allowed max maxSkip keys in the same receiving chain
 // leading to a problem whereby dropped messages would accumulate and eventually
 // we would not be able to decrypt any new message anymore.
@@ -1,6 +1,7 @@
 package encryption
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"database/sql"
 	"encoding/hex"
@@ -29,6 +30,8 @@ var (
 	ErrHashRatchetSeqNoTooHigh    = errors.New("hash ratchet seq no is too high")
 	ErrHashRatchetGroupIDNotFound = errors.New("hash ratchet group id not found")
 	ErrNoEncryptionKey            = errors.New("no encryption key found for the community")
+	// ErrOutdatedSession means that the message was sent using a session which has since been reset
+	ErrOutdatedSession = errors.New("message sent using an outdated session")
 )
 
 // If we have no bundles, we use a constant so that the message can reach any device.
@@ -282,10 +285,32 @@ func (s *encryptor) DecryptPayload(myIdentityKey *ecdsa.PrivateKey, theirIdentit
 		}
 
 		theirIdentityKeyC := crypto.CompressPubkey(theirIdentityKey)
-		err = s.persistence.AddRatchetInfo(symmetricKey, theirIdentityKeyC, bundleID, nil, theirInstallationID)
+
+		// A different key for an existing ratchet info means that either they have reset the session,
+		// or that the message is a delayed one from a session established before. Only a newer session
+		// replaces the current one, otherwise the sessions would replace each other when messages from
+		// both are interleaved, e.g. when fetching history
+		previousDRInfo, err := s.persistence.GetRatchetInfo(bundleID, theirIdentityKeyC, theirInstallationID)
 		if err != nil {
 			return nil, err
 		}
+		if previousDRInfo == nil || !bytes.Equal(previousDRInfo.Sk, symmetricKey) {
+			if previousDRInfo != nil {
+				if x3dhHeader.GetTimestamp() <= previousDRInfo.Timestamp {
+					s.logger.Debug("message sent using an outdated session, keeping current session")
+					return nil, ErrOutdatedSession
+				}
+				s.logger.Debug("session has been reset, dropping previous session")
+				if err = s.persistence.DeleteSession(previousDRInfo.ID); err != nil {
+					return nil, err
+				}
+			}
+
+			err = s.persistence.AddRatchetInfo(symmetricKey, theirIdentityKeyC, bundleID, nil, theirInstallationID, x3dhHeader.GetTimestamp())
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if drHeader := msg.GetDRHeader(); drHeader != nil {
@@ -356,6 +381,15 @@ func (s *encryptor) DecryptPayload(myIdentityKey *ecdsa.PrivateKey, theirIdentit
 	return nil, errors.New("no key specified")
 }
 
+// ResetSession drops the ratchet infos and the double ratchet sessions established with the
+// specified identity, the next message will be encrypted using X3DH with their latest bundle
+func (s *encryptor) ResetSession(theirIdentityKey *ecdsa.PublicKey) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.persistence.ResetRatchetInfos(crypto.CompressPubkey(theirIdentityKey))
+}
+
 func (s *encryptor) createNewSession(drInfo *RatchetInfo, sk []byte, keyPair crypto.DHPair) (dr.Session, error) {
 	var err error
 	var session dr.Session
@@ -549,8 +583,9 @@ func (s *encryptor) EncryptPayload(theirIdentityKey *ecdsa.PublicKey, myIdentity
 
 			if drInfo.EphemeralKey != nil {
 				dmp.X3DHHeader = &X3DHHeader{
-					Key: drInfo.EphemeralKey,
-					Id:  drInfo.BundleID,
+					Key:       drInfo.EphemeralKey,
+					Id:        drInfo.BundleID,
+					Timestamp: drInfo.Timestamp,
 				}
 			}
 
@@ -578,14 +613,16 @@ func (s *encryptor) EncryptPayload(theirIdentityKey *ecdsa.PublicKey, myIdentity
 		theirIdentityKeyC := crypto.CompressPubkey(theirIdentityKey)
 		ourEphemeralKeyC := crypto.CompressPubkey(ourEphemeralKey)
 
-		err = s.persistence.AddRatchetInfo(sharedKey, theirIdentityKeyC, theirSignedPreKey, ourEphemeralKeyC, installationID)
+		timestamp := uint64(time.Now().UnixMilli())
+		err = s.persistence.AddRatchetInfo(sharedKey, theirIdentityKeyC, theirSignedPreKey, ourEphemeralKeyC, installationID, timestamp)
 		if err != nil {
 			return nil, nil, err
 		}
 
 		x3dhHeader := &X3DHHeader{
-			Key: ourEphemeralKeyC,
-			Id:  theirSignedPreKey,
+			Key:       ourEphemeralKeyC,
+			Id:        theirSignedPreKey,
+			Timestamp: timestamp,
 		}
 
 		drInfo, err = s.persistence.GetRatchetInfo(theirSignedPreKey, theirIdentityKeyC, installationID)
@@ -21,6 +21,8 @@
 // 1636536507_add_index_bundles.up.sql (347B)
 // 1698137564_add_migration_index.up.sql (483B)
 // 1709200114_add_migration_index.up.sql (483B)
+// 1722100000_add_installations_revoked.up.sql (64B)
+// 1723400000_add_ratchet_info_timestamp.up.sql (73B)
 // doc.go (397B)
 
 package migrations
@@ -509,6 +511,46 @@ func _1709200114_add_migration_indexUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1722100000_add_installations_revokedUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\xc8\xcc\x2b\x2e\x49\xcc\xc9\x49\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\x4a\x2d\xcb\xcf\x4e\x4d\x51\x70\xf2\xf7\xf7\x71\x75\xf4\x53\x70\x71\x75\x73\x0c\xf5\x09\x51\x30\xb0\xe6\x02\x04\x00\x00\xff\xff\x1b\x1d\x4c\xff\x40\x00\x00\x00")
+
+func _1722100000_add_installations_revokedUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722100000_add_installations_revokedUpSql,
+		"1722100000_add_installations_revoked.up.sql",
+	)
+}
+
+func _1722100000_add_installations_revokedUpSql() (*asset, error) {
+	bytes, err := _1722100000_add_installations_revokedUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722100000_add_installations_revoked.up.sql", size: 64, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2e, 0xa4, 0x7b, 0xce, 0x2a, 0x98, 0x25, 0x37, 0x3c, 0xa5, 0x8c, 0xcf, 0x26, 0x69, 0x65, 0xa0, 0x4d, 0x84, 0x44, 0x28, 0xf6, 0xd1, 0xd7, 0xb, 0x2e, 0x53, 0x3a, 0x79, 0x81, 0x8f, 0xdb, 0x99}}
+	return a, nil
+}
+
+var __1723400000_add_ratchet_info_timestampUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4a\x2c\x49\xce\x48\x2d\x89\xcf\xcc\x4b\xcb\x8f\x2f\x33\x52\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\x28\xc9\xcc\x4d\x2d\x2e\x49\xcc\x2d\x50\xf0\xf4\x0b\x51\xf0\xf3\x07\xe2\x50\x1f\x1f\x05\x17\x57\x37\xc7\x50\x9f\x10\x05\x03\x6b\x2e\x00\xf2\xc2\xac\x99\x49\x00\x00\x00")
+
+func _1723400000_add_ratchet_info_timestampUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723400000_add_ratchet_info_timestampUpSql,
+		"1723400000_add_ratchet_info_timestamp.up.sql",
+	)
+}
+
+func _1723400000_add_ratchet_info_timestampUpSql() (*asset, error) {
+	bytes, err := _1723400000_add_ratchet_info_timestampUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723400000_add_ratchet_info_timestamp.up.sql", size: 73, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xfd, 0x63, 0x90, 0x36, 0x5f, 0x19, 0x2b, 0xd9, 0x85, 0x31, 0x56, 0x93, 0xf, 0xa6, 0xd2, 0xf1, 0x6e, 0xfd, 0x86, 0xa2, 0xb8, 0xca, 0x48, 0x56, 0x8b, 0xd0, 0x1d, 0xbf, 0x33, 0x65, 0x20}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\xbd\x6a\x2b\x31\x10\x85\xfb\x7d\x8a\x83\x1b\x37\x77\xa5\x1b\x08\x04\x02\x29\x52\xa6\xcf\x0b\x8c\xa5\x59\x69\xf0\x4a\xda\x68\x66\xfd\xf3\xf6\x61\x1d\x43\xdc\x65\xca\x0f\xbe\x73\xce\x78\x8f\xcf\x2c\x8a\x49\x66\x86\x28\x2a\x07\x56\xa5\x7e\xc5\x81\x03\xad\xca\xd8\x25\xb1\xbc\x1e\x5c\x68\xc5\xab\x91\xad\x3a\x4a\xf1\x45\x52\x27\x63\x7f\x7a\xde\x0d\xde\x23\x50\xdd\x1b\x32\xd5\x38\xf3\x2d\x4b\xa1\x46\xdd\xa4\x26\x9c\xc5\x32\x08\x4b\xe7\x49\x2e\x0e\xef\x86\x99\x49\x0d\x96\xc9\xf6\x0a\xcb\x8c\x40\xca\x5b\xcc\xd4\x3a\x52\x1b\x0f\x52\x23\x19\xb9\x0d\x7d\x4c\x0f\x64\x5b\x18\x68\x9e\x39\x62\xea\xad\xdc\x5c\xa5\xc2\x88\xd2\x39\x58\xeb\xd7\x7f\x20\x55\x36\x54\x2a\xac\x9b\x9f\xe9\xc4\xa8\xed\x5e\x0f\xaa\xf1\xef\x8f\x70\x6e\xfd\xa8\x20\x05\x5f\x16\x0e\xc6\xd1\x0d\xc3\x42\xe1\x48\x89\xa1\x5f\xb3\x18\x0f\x83\xf7\xa9\xbd\x26\xae\xbc\x59\x8f\x1b\xc7\xd2\xa2\x49\xe1\xb7\xa7\x97\xff\xf7\xc3\xb8\x1c\x13\x7e\x1a\xa4\x55\xc5\xd8\xe0\x9c\xff\x05\x2e\x35\xb8\xe1\x3b\x00\x00\xff\xff\x73\x18\x09\xa7\x8d\x01\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -641,7 +683,9 @@ var _bindata = map[string]func() (*asset, error){
 	"1636536507_add_index_bundles.up.sql":           _1636536507_add_index_bundlesUpSql,
 	"1698137564_add_migration_index.up.sql":         _1698137564_add_migration_indexUpSql,
 	"1709200114_add_migration_index.up.sql":         _1709200114_add_migration_indexUpSql,
-	"doc.go":                                        docGo,
+	"1722100000_add_installations_revoked.up.sql":   _1722100000_add_installations_revokedUpSql,
+	"1723400000_add_ratchet_info_timestamp.up.sql":  _1723400000_add_ratchet_info_timestampUpSql,
+	"doc.go": docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -711,7 +755,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1636536507_add_index_bundles.up.sql":           {_1636536507_add_index_bundlesUpSql, map[string]*bintree{}},
 	"1698137564_add_migration_index.up.sql":         {_1698137564_add_migration_indexUpSql, map[string]*bintree{}},
 	"1709200114_add_migration_index.up.sql":         {_1709200114_add_migration_indexUpSql, map[string]*bintree{}},
-	"doc.go":                                        {docGo, map[string]*bintree{}},
+	"1722100000_add_installations_revoked.up.sql":   {_1722100000_add_installations_revokedUpSql, map[string]*bintree{}},
+	"1723400000_add_ratchet_info_timestamp.up.sql":  {_1723400000_add_ratchet_info_timestampUpSql, map[string]*bintree{}},
+	"doc.go": {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
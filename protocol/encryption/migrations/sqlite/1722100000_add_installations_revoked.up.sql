@@ -0,0 +1 @@
+ALTER TABLE installations ADD COLUMN revoked BOOLEAN DEFAULT 0;
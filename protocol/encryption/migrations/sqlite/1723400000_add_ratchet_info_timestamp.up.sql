@@ -0,0 +1 @@
+ALTER TABLE ratchet_info_v2 ADD COLUMN timestamp INT NOT NULL DEFAULT 0;
@@ -27,6 +27,8 @@ type Installation struct {
 	Version uint32 `json:"version"`
 	// Enabled is whether the installation is enabled
 	Enabled bool `json:"enabled"`
+	// Revoked is whether the installation has been permanently revoked
+	Revoked bool `json:"revoked"`
 	// Timestamp is the last time we saw this device
 	Timestamp int64 `json:"timestamp"`
 	// InstallationMetadata
@@ -130,6 +132,11 @@ func (s *Multidevice) DisableInstallation(myIdentityKey *ecdsa.PublicKey, instal
 	return s.persistence.DisableInstallation(myIdentityKeyC, installationID)
 }
 
+func (s *Multidevice) RevokeInstallation(identity *ecdsa.PublicKey, installationID string, timestamp int64) error {
+	identityC := crypto.CompressPubkey(identity)
+	return s.persistence.RevokeInstallation(identityC, installationID, timestamp)
+}
+
 func GenerateInstallationID() string {
 	return uuid.New().String()
 }
@@ -14,7 +14,7 @@ func newSQLitePersistence(db *sql.DB) *sqlitePersistence {
 func (s *sqlitePersistence) GetActiveInstallations(maxInstallations int, identity []byte) ([]*Installation, error) {
 	stmt, err := s.db.Prepare(`SELECT installation_id, version
 				   FROM installations
-				   WHERE enabled = 1 AND identity = ?
+				   WHERE enabled = 1 AND NOT revoked AND identity = ?
 				   ORDER BY timestamp DESC
 				   LIMIT ?`)
 	if err != nil {
@@ -61,7 +61,7 @@ func (s *sqlitePersistence) GetInstallations(identity []byte) ([]*Installation,
 	var installations []*Installation
 
 	// We query both tables as sqlite does not support full outer joins
-	installationsStmt, err := s.db.Prepare(`SELECT installation_id, version, enabled, timestamp FROM installations WHERE identity = ?`)
+	installationsStmt, err := s.db.Prepare(`SELECT installation_id, version, enabled, revoked, timestamp FROM installations WHERE identity = ?`)
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +78,7 @@ func (s *sqlitePersistence) GetInstallations(identity []byte) ([]*Installation,
 			&installation.ID,
 			&installation.Version,
 			&installation.Enabled,
+			&installation.Revoked,
 			&installation.Timestamp,
 		)
 		if err != nil {
@@ -147,7 +148,7 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 	var insertedInstallations []*Installation
 
 	for _, installation := range installations {
-		stmt, err := tx.Prepare(`SELECT enabled, version
+		stmt, err := tx.Prepare(`SELECT enabled, revoked, version
 					 FROM installations
 					 WHERE identity = ? AND installation_id = ?
 					 LIMIT 1`)
@@ -157,15 +158,21 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 		defer stmt.Close()
 
 		var oldEnabled bool
+		var revoked bool
 		// We don't override version once we saw one
 		var oldVersion uint32
 		latestVersion := installation.Version
 
-		err = stmt.QueryRow(identity, installation.ID).Scan(&oldEnabled, &oldVersion)
+		err = stmt.QueryRow(identity, installation.ID).Scan(&oldEnabled, &revoked, &oldVersion)
 		if err != nil && err != sql.ErrNoRows {
 			return nil, err
 		}
 
+		// Revoked installations are never added back
+		if revoked {
+			continue
+		}
+
 		if err == sql.ErrNoRows {
 			stmt, err = tx.Prepare(`INSERT INTO installations(identity, installation_id, timestamp, enabled, version)
 						VALUES (?, ?, ?, ?, ?)`)
@@ -230,7 +237,7 @@ func (s *sqlitePersistence) AddInstallations(identity []byte, timestamp int64, i
 func (s *sqlitePersistence) EnableInstallation(identity []byte, installationID string) error {
 	stmt, err := s.db.Prepare(`UPDATE installations
 				   SET enabled = 1
-				   WHERE identity = ? AND installation_id = ?`)
+				   WHERE identity = ? AND installation_id = ? AND NOT revoked`)
 	if err != nil {
 		return err
 	}
@@ -253,6 +260,18 @@ func (s *sqlitePersistence) DisableInstallation(identity []byte, installationID
 	return err
 }
 
+// RevokeInstallation permanently disables the installation, it won't be added back
+func (s *sqlitePersistence) RevokeInstallation(identity []byte, installationID string, timestamp int64) error {
+	stmt, err := s.db.Prepare(`INSERT INTO installations(identity, installation_id, timestamp, enabled, revoked, version)
+				   VALUES (?, ?, ?, 0, 1, COALESCE((SELECT version FROM installations WHERE identity = ? AND installation_id = ?), 0))`)
+	if err != nil {
+		return err
+	}
+
+	_, err = stmt.Exec(identity, installationID, timestamp, identity, installationID)
+	return err
+}
+
 // SetInstallationMetadata sets the metadata for a given installation
 func (s *sqlitePersistence) SetInstallationMetadata(identity []byte, installationID string, metadata *InstallationMetadata) error {
 	stmt, err := s.db.Prepare(`INSERT INTO installation_metadata(name, device_type, fcm_token, identity, installation_id) VALUES(?,?,?,?,?)`)
@@ -235,6 +235,63 @@ func (s *SQLLitePersistenceTestSuite) TestEnableInstallation() {
 	s.Require().Equal(expected, actualInstallations)
 }
 
+func (s *SQLLitePersistenceTestSuite) TestRevokeInstallation() {
+	identity := []byte("alice")
+
+	installations := []*Installation{
+		{ID: "alice-1", Version: 1},
+		{ID: "alice-2", Version: 2},
+	}
+
+	_, err := s.service.AddInstallations(
+		identity,
+		1,
+		installations,
+		true,
+	)
+	s.Require().NoError(err)
+
+	err = s.service.RevokeInstallation(identity, "alice-1", 2)
+	s.Require().NoError(err)
+
+	// Installations which we haven't seen yet can be revoked
+	err = s.service.RevokeInstallation(identity, "alice-3", 2)
+	s.Require().NoError(err)
+
+	// Revoked installations are not added back from newer bundles
+	installations = []*Installation{
+		{ID: "alice-1", Version: 1},
+		{ID: "alice-2", Version: 2},
+		{ID: "alice-3", Version: 2},
+	}
+
+	addedInstallations, err := s.service.AddInstallations(
+		identity,
+		3,
+		installations,
+		true,
+	)
+	s.Require().NoError(err)
+	s.Require().Empty(addedInstallations)
+
+	// and can't be enabled
+	err = s.service.EnableInstallation(identity, "alice-1")
+	s.Require().NoError(err)
+
+	actualInstallations, err := s.service.GetActiveInstallations(3, identity)
+	s.Require().NoError(err)
+
+	expected := []*Installation{{ID: "alice-2", Version: 2, Enabled: true}}
+	s.Require().Equal(expected, actualInstallations)
+
+	allInstallations, err := s.service.GetInstallations(identity)
+	s.Require().NoError(err)
+	s.Require().Len(allInstallations, 3)
+	for _, installation := range allInstallations {
+		s.Require().Equal(installation.ID != "alice-2", installation.Revoked)
+	}
+}
+
 func (s *SQLLitePersistenceTestSuite) TestGetInstallations() {
 	identity := []byte("alice")
 
@@ -24,6 +24,8 @@ type RatchetInfo struct {
 	BundleID       []byte
 	EphemeralKey   []byte
 	InstallationID string
+	// Timestamp in ms at which the session was established, 0 when unknown
+	Timestamp uint64
 }
 
 // A safe max number of rows.
@@ -354,9 +356,9 @@ func (s *sqlitePersistence) GetPublicBundle(publicKey *ecdsa.PublicKey, installa
 }
 
 // AddRatchetInfo persists the specified ratchet info into the database
-func (s *sqlitePersistence) AddRatchetInfo(key []byte, identity []byte, bundleID []byte, ephemeralKey []byte, installationID string) error {
-	stmt, err := s.DB.Prepare(`INSERT INTO ratchet_info_v2(symmetric_key, identity, bundle_id, ephemeral_key, installation_id)
-				   VALUES(?, ?, ?, ?, ?)`)
+func (s *sqlitePersistence) AddRatchetInfo(key []byte, identity []byte, bundleID []byte, ephemeralKey []byte, installationID string, timestamp uint64) error {
+	stmt, err := s.DB.Prepare(`INSERT INTO ratchet_info_v2(symmetric_key, identity, bundle_id, ephemeral_key, installation_id, timestamp)
+				   VALUES(?, ?, ?, ?, ?, ?)`)
 	if err != nil {
 		return err
 	}
@@ -368,6 +370,7 @@ func (s *sqlitePersistence) AddRatchetInfo(key []byte, identity []byte, bundleID
 		bundleID,
 		ephemeralKey,
 		installationID,
+		timestamp,
 	)
 
 	return err
@@ -375,7 +378,7 @@ func (s *sqlitePersistence) AddRatchetInfo(key []byte, identity []byte, bundleID
 
 // GetRatchetInfo retrieves the existing RatchetInfo for a specified bundle ID and interlocutor public key from the database
 func (s *sqlitePersistence) GetRatchetInfo(bundleID []byte, theirIdentity []byte, installationID string) (*RatchetInfo, error) {
-	stmt, err := s.DB.Prepare(`SELECT ratchet_info_v2.identity, ratchet_info_v2.symmetric_key, bundles.private_key, bundles.signed_pre_key, ratchet_info_v2.ephemeral_key, ratchet_info_v2.installation_id
+	stmt, err := s.DB.Prepare(`SELECT ratchet_info_v2.identity, ratchet_info_v2.symmetric_key, bundles.private_key, bundles.signed_pre_key, ratchet_info_v2.ephemeral_key, ratchet_info_v2.installation_id, ratchet_info_v2.timestamp
 				   FROM ratchet_info_v2 JOIN bundles ON bundle_id = signed_pre_key
 				   WHERE ratchet_info_v2.identity = ? AND ratchet_info_v2.installation_id = ? AND bundle_id = ?
 				   LIMIT 1`)
@@ -395,6 +398,7 @@ func (s *sqlitePersistence) GetRatchetInfo(bundleID []byte, theirIdentity []byte
 		&ratchetInfo.PublicKey,
 		&ratchetInfo.EphemeralKey,
 		&ratchetInfo.InstallationID,
+		&ratchetInfo.Timestamp,
 	)
 	switch err {
 	case sql.ErrNoRows:
@@ -409,7 +413,7 @@ func (s *sqlitePersistence) GetRatchetInfo(bundleID []byte, theirIdentity []byte
 
 // GetAnyRatchetInfo retrieves any existing RatchetInfo for a specified interlocutor public key from the database
 func (s *sqlitePersistence) GetAnyRatchetInfo(identity []byte, installationID string) (*RatchetInfo, error) {
-	stmt, err := s.DB.Prepare(`SELECT symmetric_key, bundles.private_key, signed_pre_key, bundle_id, ephemeral_key
+	stmt, err := s.DB.Prepare(`SELECT symmetric_key, bundles.private_key, signed_pre_key, bundle_id, ephemeral_key, ratchet_info_v2.timestamp
 				   FROM ratchet_info_v2 JOIN bundles ON bundle_id = signed_pre_key
 				   WHERE expired = 0 AND ratchet_info_v2.identity = ? AND ratchet_info_v2.installation_id = ?
 				   LIMIT 1`)
@@ -429,6 +433,7 @@ func (s *sqlitePersistence) GetAnyRatchetInfo(identity []byte, installationID st
 		&ratchetInfo.PublicKey,
 		&ratchetInfo.BundleID,
 		&ratchetInfo.EphemeralKey,
+		&ratchetInfo.Timestamp,
 	)
 	switch err {
 	case sql.ErrNoRows:
@@ -461,6 +466,76 @@ func (s *sqlitePersistence) RatchetInfoConfirmed(bundleID []byte, theirIdentity
 	return err
 }
 
+// ResetRatchetInfos removes all the ratchet infos established with the specified identity,
+// along with their double ratchet sessions and message keys
+func (s *sqlitePersistence) ResetRatchetInfos(theirIdentity []byte) (err error) {
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	rows, err := tx.Query(`SELECT bundle_id, installation_id FROM ratchet_info_v2 WHERE identity = ?`, theirIdentity)
+	if err != nil {
+		return err
+	}
+
+	var sessionIDs [][]byte
+	for rows.Next() {
+		var bundleID []byte
+		var installationID string
+		if err = rows.Scan(&bundleID, &installationID); err != nil {
+			rows.Close()
+			return err
+		}
+		sessionIDs = append(sessionIDs, append(bundleID, []byte(installationID)...))
+	}
+	rows.Close()
+
+	for _, sessionID := range sessionIDs {
+		if err = deleteSession(tx, sessionID); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`DELETE FROM ratchet_info_v2 WHERE identity = ?`, theirIdentity)
+	return err
+}
+
+// DeleteSession removes the double ratchet session with the specified ID and its message keys
+func (s *sqlitePersistence) DeleteSession(sessionID []byte) (err error) {
+	tx, err := s.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	return deleteSession(tx, sessionID)
+}
+
+func deleteSession(tx *sql.Tx, sessionID []byte) error {
+	_, err := tx.Exec(`DELETE FROM keys WHERE session_id = ?`, sessionID)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM sessions WHERE id = ?`, sessionID)
+	return err
+}
+
 type sqliteKeysStorage struct {
 	db *sql.DB
 }
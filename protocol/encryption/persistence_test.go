@@ -251,6 +251,7 @@ func (s *SQLLitePersistenceTestSuite) TestRatchetInfoPrivateBundle() {
 		bundle.GetBundle().GetSignedPreKeys()["2"].GetSignedPreKey(),
 		[]byte("ephemeral-public-key"),
 		"1",
+		1,
 	)
 	s.Require().NoError(err)
 
@@ -266,6 +267,7 @@ func (s *SQLLitePersistenceTestSuite) TestRatchetInfoPrivateBundle() {
 	s.Equal(bundle.GetBundle().GetSignedPreKeys()["2"].GetSignedPreKey(), ratchetInfo.BundleID, "It returns the bundle id")
 	s.Equal([]byte("ephemeral-public-key"), ratchetInfo.EphemeralKey, "It returns the ratchet ephemeral key")
 	s.Equal("1", ratchetInfo.InstallationID, "It returns the right installation id")
+	s.Equal(uint64(1), ratchetInfo.Timestamp, "It returns the timestamp of the session")
 }
 
 func (s *SQLLitePersistenceTestSuite) TestRatchetInfoPublicBundle() {
@@ -289,6 +291,7 @@ func (s *SQLLitePersistenceTestSuite) TestRatchetInfoPublicBundle() {
 		signedPreKey,
 		[]byte("public-ephemeral-key"),
 		installationID,
+		1,
 	)
 	s.Require().NoError(err)
 
@@ -324,6 +327,7 @@ func (s *SQLLitePersistenceTestSuite) TestRatchetInfoNoBundle() {
 		[]byte("non-existing-bundle"),
 		[]byte("non-existing-ephemeral-key"),
 		"none",
+		1,
 	)
 
 	s.Error(err, "It returns an error")
@@ -23,11 +23,12 @@ import (
 //go:generate protoc --go_out=. ./protocol_message.proto
 
 const (
-	protocolVersion                = 1
-	sharedSecretNegotiationVersion = 1
-	partitionedTopicMinVersion     = 1
-	defaultMinVersion              = 0
-	maxKeysChannelSize             = 10000
+	protocolVersion                  = 1
+	sharedSecretNegotiationVersion   = 1
+	partitionedTopicMinVersion       = 1
+	defaultMinVersion                = 0
+	maxKeysChannelSize               = 10000
+	maxDecryptionFailuresChannelSize = 100
 )
 
 type PartitionTopicMode int
@@ -126,9 +127,19 @@ type Subscriptions struct {
 	SharedSecrets      []*sharedsecret.Secret
 	SendContactCode    <-chan struct{}
 	NewHashRatchetKeys chan []*HashRatchetInfo
+	DecryptionFailures chan *DecryptionFailure
 	Quit               chan struct{}
 }
 
+// DecryptionFailure is published when a message sent using the double ratchet could not be decrypted,
+// which most likely means that the session with the sender is out of sync
+type DecryptionFailure struct {
+	PublicKey      *ecdsa.PublicKey
+	InstallationID string
+	MessageID      []byte
+	Error          error
+}
+
 func (p *Protocol) Start(myIdentity *ecdsa.PrivateKey) (*Subscriptions, error) {
 	// Propagate currently cached shared secrets.
 	secrets, err := p.secret.All()
@@ -139,6 +150,7 @@ func (p *Protocol) Start(myIdentity *ecdsa.PrivateKey) (*Subscriptions, error) {
 		SharedSecrets:      secrets,
 		SendContactCode:    p.publisher.Start(),
 		NewHashRatchetKeys: make(chan []*HashRatchetInfo, maxKeysChannelSize),
+		DecryptionFailures: make(chan *DecryptionFailure, maxDecryptionFailuresChannelSize),
 		Quit:               make(chan struct{}),
 	}
 	return p.subscriptions, nil
@@ -599,6 +611,12 @@ func (p *Protocol) DisableInstallation(myIdentityKey *ecdsa.PublicKey, installat
 	return p.multidevice.DisableInstallation(myIdentityKey, installationID)
 }
 
+// RevokeInstallation permanently disables an installation of the given identity,
+// it won't be used anymore nor added back from future bundles.
+func (p *Protocol) RevokeInstallation(identity *ecdsa.PublicKey, installationID string, timestamp int64) error {
+	return p.multidevice.RevokeInstallation(identity, installationID, timestamp)
+}
+
 // GetOurInstallations returns all the installations available given an identity
 func (p *Protocol) GetOurInstallations(myIdentityKey *ecdsa.PublicKey) ([]*multidevice.Installation, error) {
 	return p.multidevice.GetOurInstallations(myIdentityKey)
@@ -801,6 +819,11 @@ func (p *Protocol) HandleMessage(
 			return response, err
 		}
 
+		// Messages from a session which has since been reset are expected, e.g. when fetching history,
+		// they don't mean that the current session is out of sync
+		if err != nil && err != ErrOutdatedSession {
+			p.publishDecryptionFailure(theirPublicKey, protocolMessage.GetInstallationId(), encryptedMessage, messageID, err)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -848,6 +871,38 @@ func (p *Protocol) HandleMessage(
 	return nil, ErrNoPayload
 }
 
+// publishDecryptionFailure notifies the subscribers about a message which could not be decrypted,
+// only messages using X3DH or the double ratchet are taken into account
+func (p *Protocol) publishDecryptionFailure(theirPublicKey *ecdsa.PublicKey, theirInstallationID string, encryptedMessage map[string]*EncryptedMessageProtocol, messageID []byte, err error) {
+	if p.subscriptions == nil {
+		return
+	}
+
+	msg := p.encryptor.GetMessage(encryptedMessage)
+	if msg == nil || (msg.GetDRHeader() == nil && msg.GetX3DHHeader() == nil) {
+		return
+	}
+
+	failure := &DecryptionFailure{
+		PublicKey:      theirPublicKey,
+		InstallationID: theirInstallationID,
+		MessageID:      messageID,
+		Error:          err,
+	}
+
+	select {
+	case p.subscriptions.DecryptionFailures <- failure:
+	default:
+		p.logger.Warn("decryption failures channel full, dropping failure")
+	}
+}
+
+// ResetSession drops the sessions established with the specified identity, so that they are
+// established again using X3DH with their latest bundle
+func (p *Protocol) ResetSession(theirIdentityKey *ecdsa.PublicKey) error {
+	return p.encryptor.ResetSession(theirIdentityKey)
+}
+
 func (p *Protocol) ShouldAdvertiseBundle(publicKey *ecdsa.PublicKey, time int64) (bool, error) {
 	return p.publisher.ShouldAdvertiseBundle(publicKey, time)
 }
@@ -0,0 +1,240 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// JSONLDMetadata is the article and media data found in the JSON-LD
+// (https://json-ld.org/) scripts of a page, using the schema.org vocabulary.
+type JSONLDMetadata struct {
+	Headline      string
+	Author        string
+	Publisher     string
+	DatePublished string
+	Duration      string
+	EmbedURL      string
+	Audio         bool
+	Width         int
+	Height        int
+}
+
+type jsonLDObject struct {
+	Type          json.RawMessage   `json:"@type"`
+	Graph         []json.RawMessage `json:"@graph"`
+	Headline      string            `json:"headline"`
+	Name          string            `json:"name"`
+	Author        json.RawMessage   `json:"author"`
+	Publisher     json.RawMessage   `json:"publisher"`
+	DatePublished string            `json:"datePublished"`
+	UploadDate    string            `json:"uploadDate"`
+	Duration      string            `json:"duration"`
+	EmbedURL      string            `json:"embedUrl"`
+	ContentURL    string            `json:"contentUrl"`
+	Width         json.RawMessage   `json:"width"`
+	Height        json.RawMessage   `json:"height"`
+}
+
+var jsonLDMediaTypes = map[string]bool{
+	"VideoObject":    true,
+	"AudioObject":    true,
+	"PodcastEpisode": true,
+	"MusicRecording": true,
+}
+
+var iso8601DurationRegexp = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// GetJSONLDMetadata merges the data of the articles and media objects
+// described by the page, the first object defining a field wins
+func GetJSONLDMetadata(bodyBytes []byte) JSONLDMetadata {
+	var metadata JSONLDMetadata
+
+	htmlTokens := html.NewTokenizer(bytes.NewBuffer(bodyBytes))
+	inScript := false
+	for {
+		tt := htmlTokens.Next()
+		switch tt {
+		case html.ErrorToken:
+			return metadata
+		case html.StartTagToken:
+			t := htmlTokens.Token()
+			inScript = false
+			if t.Data != "script" {
+				continue
+			}
+			for _, attr := range t.Attr {
+				if attr.Key == "type" && strings.ToLower(strings.TrimSpace(attr.Val)) == "application/ld+json" {
+					inScript = true
+				}
+			}
+		case html.TextToken:
+			if inScript {
+				metadata.merge(htmlTokens.Text())
+			}
+		case html.EndTagToken:
+			inScript = false
+		}
+	}
+}
+
+// merge adds the data of the JSON-LD document, which is an object, an array
+// of objects or an object with a @graph of objects
+func (m *JSONLDMetadata) merge(document []byte) {
+	document = bytes.TrimSpace(document)
+	if len(document) == 0 {
+		return
+	}
+
+	if document[0] == '[' {
+		var objects []json.RawMessage
+		if json.Unmarshal(document, &objects) != nil {
+			return
+		}
+		for _, object := range objects {
+			m.merge(object)
+		}
+		return
+	}
+
+	var object jsonLDObject
+	if json.Unmarshal(document, &object) != nil {
+		return
+	}
+	for _, child := range object.Graph {
+		m.merge(child)
+	}
+
+	isArticle, isMedia, isAudio := false, false, false
+	for _, t := range jsonLDStrings(object.Type) {
+		isArticle = isArticle || strings.HasSuffix(t, "Article") || t == "BlogPosting"
+		isMedia = isMedia || jsonLDMediaTypes[t]
+		isAudio = isAudio || (jsonLDMediaTypes[t] && t != "VideoObject")
+	}
+	if !isArticle && !isMedia {
+		return
+	}
+
+	setIfEmpty(&m.Headline, object.Headline)
+	if isMedia {
+		setIfEmpty(&m.Headline, object.Name)
+	}
+	setIfEmpty(&m.Author, jsonLDName(object.Author))
+	setIfEmpty(&m.Publisher, jsonLDName(object.Publisher))
+	setIfEmpty(&m.DatePublished, object.DatePublished)
+	setIfEmpty(&m.DatePublished, object.UploadDate)
+
+	if !isMedia || m.EmbedURL != "" {
+		return
+	}
+	m.Duration = object.Duration
+	m.EmbedURL = object.EmbedURL
+	setIfEmpty(&m.EmbedURL, object.ContentURL)
+	m.Audio = isAudio
+	m.Width = jsonLDDimension(object.Width)
+	m.Height = jsonLDDimension(object.Height)
+}
+
+func setIfEmpty(field *string, value string) {
+	if *field == "" {
+		*field = strings.TrimSpace(value)
+	}
+}
+
+// jsonLDStrings returns the value of a property which is either a string or an array of strings
+func jsonLDStrings(raw json.RawMessage) []string {
+	var values []string
+	if json.Unmarshal(raw, &values) == nil {
+		return values
+	}
+	var value string
+	if json.Unmarshal(raw, &value) == nil {
+		return []string{value}
+	}
+	return nil
+}
+
+// jsonLDName returns the name of a person or organization, which is either
+// a string, an object with a name or an array of them
+func jsonLDName(raw json.RawMessage) string {
+	var name string
+	if json.Unmarshal(raw, &name) == nil {
+		return name
+	}
+
+	var object struct {
+		Name string `json:"name"`
+	}
+	if json.Unmarshal(raw, &object) == nil {
+		return object.Name
+	}
+
+	var array []json.RawMessage
+	if json.Unmarshal(raw, &array) != nil {
+		return ""
+	}
+	names := make([]string, 0, len(array))
+	for _, item := range array {
+		if name := jsonLDName(item); name != "" {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// jsonLDDimension returns a width or height in pixels, which is either
+// a number, a numeric string or a QuantitativeValue
+func jsonLDDimension(raw json.RawMessage) int {
+	var object struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if json.Unmarshal(raw, &object) == nil && object.Value != nil {
+		raw = object.Value
+	}
+
+	value, err := strconv.ParseFloat(strings.Trim(string(raw), `"`), 64)
+	if err != nil || value < 0 {
+		return 0
+	}
+	return int(value)
+}
+
+// parseISO8601Duration returns the number of seconds of a duration
+// such as PT1H2M30S, only days, hours, minutes and seconds are supported
+func parseISO8601Duration(duration string) uint32 {
+	matches := iso8601DurationRegexp.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(duration)))
+	if matches == nil {
+		return 0
+	}
+
+	var seconds float64
+	for i, unit := range []float64{24 * 60 * 60, 60 * 60, 60, 1} {
+		if matches[i+1] == "" {
+			continue
+		}
+		value, err := strconv.ParseFloat(matches[i+1], 64)
+		if err != nil {
+			return 0
+		}
+		seconds += value * unit
+	}
+	return uint32(seconds)
+}
+
+// parseLinkPreviewTime returns the time in milliseconds since epoch of
+// a date as found in OpenGraph and JSON-LD data, or 0 if it can't be parsed
+func parseLinkPreviewTime(value string) uint64 {
+	value = strings.TrimSpace(value)
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05Z0700", "2006-01-02T15:04:05", "2006-01-02"} {
+		t, err := time.Parse(layout, value)
+		if err == nil && t.Unix() > 0 {
+			return uint64(t.UnixMilli())
+		}
+	}
+	return 0
+}
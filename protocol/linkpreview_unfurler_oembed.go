@@ -1,12 +1,17 @@
 package protocol
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	neturl "net/url"
+	"strconv"
+	"strings"
 
 	"go.uber.org/zap"
+	"golang.org/x/net/html"
 
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/protobuf"
@@ -35,9 +40,131 @@ func NewOEmbedUnfurler(oembedEndpoint string,
 	}
 }
 
+// OEmbedResponse is the subset of the oEmbed response fields we use,
+// see https://oembed.com/#section2.3.
 type OEmbedResponse struct {
-	Title        string `json:"title"`
-	ThumbnailURL string `json:"thumbnail_url"`
+	Type         string          `json:"type"`
+	Title        string          `json:"title"`
+	AuthorName   string          `json:"author_name"`
+	ProviderName string          `json:"provider_name"`
+	ThumbnailURL string          `json:"thumbnail_url"`
+	Width        oembedDimension `json:"width"`
+	Height       oembedDimension `json:"height"`
+}
+
+// oembedDimension accepts both numbers and numeric strings, because providers
+// don't agree on the type. Other values, such as "100%", are ignored.
+type oembedDimension int
+
+func (d *oembedDimension) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseFloat(strings.Trim(string(data), `"`), 64)
+	if err != nil || value < 0 {
+		*d = 0
+		return nil
+	}
+	*d = oembedDimension(value)
+	return nil
+}
+
+// isEmbeddable returns whether the dimensions describe a player
+func (r *OEmbedResponse) isEmbeddable() bool {
+	return r.Type == "video" || r.Type == "rich"
+}
+
+// GetOEmbedURL returns the oEmbed URL advertised by the page through a
+// <link type="application/json+oembed"> tag, see https://oembed.com/#section4.
+func GetOEmbedURL(bodyBytes []byte, pageURL *neturl.URL) string {
+	htmlTokens := html.NewTokenizer(bytes.NewBuffer(bodyBytes))
+	for {
+		tt := htmlTokens.Next()
+		switch tt {
+		case html.ErrorToken:
+			return ""
+		case html.StartTagToken, html.SelfClosingTagToken:
+			t := htmlTokens.Token()
+			if t.Data != "link" {
+				continue
+			}
+
+			isOEmbed := false
+			href := ""
+			for _, attr := range t.Attr {
+				switch attr.Key {
+				case "type":
+					v := strings.ToLower(strings.TrimSpace(attr.Val))
+					isOEmbed = v == "application/json+oembed" || v == "text/json+oembed"
+				case "href":
+					href = attr.Val
+				}
+			}
+
+			if isOEmbed && href != "" {
+				return resolveOEmbedDiscoveryURL(pageURL, href)
+			}
+		}
+	}
+}
+
+// resolveLinkPreviewURL resolves ref against the page URL,
+// only http and https URLs are returned
+func resolveLinkPreviewURL(pageURL *neturl.URL, ref string) string {
+	u, err := neturl.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return ""
+	}
+	u = pageURL.ResolveReference(u)
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	return u.String()
+}
+
+// resolveOEmbedDiscoveryURL resolves the oEmbed endpoint advertised by a page. The page author
+// picks the endpoint, so only https endpoints on public hosts are followed, the unfurling
+// domain lists are applied by the HTTP client as for any other request
+func resolveOEmbedDiscoveryURL(pageURL *neturl.URL, ref string) string {
+	oembedURL := resolveLinkPreviewURL(pageURL, ref)
+	if oembedURL == "" {
+		return ""
+	}
+	u, err := neturl.Parse(oembedURL)
+	if err != nil || u.Scheme != "https" || !isPublicLinkPreviewHost(u.Hostname()) {
+		return ""
+	}
+	return oembedURL
+}
+
+// isPublicLinkPreviewHost returns false for local host names and for loopback,
+// private, link-local and unspecified IP addresses
+func isPublicLinkPreviewHost(hostname string) bool {
+	hostname = strings.Trim(strings.ToLower(hostname), ".")
+	if hostname == "" || hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") || strings.HasSuffix(hostname, ".local") {
+		return false
+	}
+	ip := net.ParseIP(hostname)
+	if ip == nil {
+		return true
+	}
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsUnspecified()
+}
+
+func fetchOEmbed(logger *zap.Logger, httpClient *http.Client, oembedURL string) (*OEmbedResponse, error) {
+	headers := map[string]string{
+		"accept":          headerAcceptJSON,
+		"accept-language": headerAcceptLanguage,
+		"user-agent":      headerUserAgent,
+	}
+	oembedBytes, err := fetchBody(logger, httpClient, oembedURL, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var oembedResponse OEmbedResponse
+	err = json.Unmarshal(oembedBytes, &oembedResponse)
+	if err != nil {
+		return nil, err
+	}
+	return &oembedResponse, nil
 }
 
 func (u *OEmbedUnfurler) newOEmbedURL() (*neturl.URL, error) {
@@ -65,21 +192,7 @@ func (u OEmbedUnfurler) Unfurl() (*common.LinkPreview, error) {
 		return preview, err
 	}
 
-	headers := map[string]string{
-		"accept":          headerAcceptJSON,
-		"accept-language": headerAcceptLanguage,
-		"user-agent":      headerUserAgent,
-	}
-	oembedBytes, err := fetchBody(u.logger, u.httpClient, oembedURL.String(), headers)
-	if err != nil {
-		return preview, err
-	}
-
-	var oembedResponse OEmbedResponse
-	if err != nil {
-		return preview, err
-	}
-	err = json.Unmarshal(oembedBytes, &oembedResponse)
+	oembedResponse, err := fetchOEmbed(u.logger, u.httpClient, oembedURL.String())
 	if err != nil {
 		return preview, err
 	}
@@ -89,5 +202,7 @@ func (u OEmbedUnfurler) Unfurl() (*common.LinkPreview, error) {
 	}
 
 	preview.Title = oembedResponse.Title
+	preview.Author = oembedResponse.AuthorName
+	preview.SiteName = oembedResponse.ProviderName
 	return preview, nil
 }
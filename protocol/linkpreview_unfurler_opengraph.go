@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/http"
 	neturl "net/url"
 	"strings"
@@ -17,10 +18,22 @@ import (
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
+// OpenGraphMetadata also reads the Twitter Card tags, the OpenGraph tags take
+// precedence because metabolize keeps the last tag found in the list.
 type OpenGraphMetadata struct {
-	Title        string `json:"title" meta:"og:title"`
-	Description  string `json:"description" meta:"og:description"`
-	ThumbnailURL string `json:"thumbnailUrl" meta:"og:image"`
+	Title          string `json:"title" meta:"twitter:title,og:title"`
+	Description    string `json:"description" meta:"twitter:description,og:description"`
+	ThumbnailURL   string `json:"thumbnailUrl" meta:"twitter:image:src,twitter:image,og:image"`
+	SiteName       string `json:"siteName" meta:"og:site_name"`
+	Author         string `json:"author" meta:"author"`
+	TwitterCreator string `json:"twitterCreator" meta:"twitter:creator"`
+	PublishedTime  string `json:"publishedTime" meta:"article:published_time"`
+	VideoURL       string `json:"videoUrl" meta:"twitter:player,og:video,og:video:url,og:video:secure_url"`
+	VideoWidth     int64  `json:"videoWidth" meta:"twitter:player:width,og:video:width"`
+	VideoHeight    int64  `json:"videoHeight" meta:"twitter:player:height,og:video:height"`
+	AudioURL       string `json:"audioUrl" meta:"og:audio,og:audio:url,og:audio:secure_url"`
+	// Duration is in seconds
+	Duration int64 `json:"duration" meta:"music:duration,video:duration"`
 }
 
 // OpenGraphUnfurler should be preferred over OEmbedUnfurler because oEmbed
@@ -96,6 +109,20 @@ func (u *OpenGraphUnfurler) Unfurl() (*common.LinkPreview, error) {
 	if err != nil {
 		return preview, fmt.Errorf("failed to parse OpenGraph data")
 	}
+	jsonLDMetadata := GetJSONLDMetadata(bodyBytes)
+
+	// The oEmbed data complements the OpenGraph data, it is fetched on a
+	// best-effort basis.
+	var oembedResponse *OEmbedResponse
+	if oembedURL := GetOEmbedURL(bodyBytes, u.url); oembedURL != "" {
+		oembedResponse, err = fetchOEmbed(u.logger, u.httpClient, oembedURL)
+		if err != nil {
+			u.logger.Info("failed to fetch discovered oEmbed", zap.String("url", u.url.String()), zap.Error(err))
+		}
+	}
+	if oembedResponse == nil {
+		oembedResponse = &OEmbedResponse{}
+	}
 
 	faviconPath := GetFavicon(bodyBytes)
 	t, err := fetchImage(u.logger, u.httpClient, faviconPath, false)
@@ -107,12 +134,17 @@ func (u *OpenGraphUnfurler) Unfurl() (*common.LinkPreview, error) {
 	// There are URLs like https://wikipedia.org/ that don't have an OpenGraph
 	// title tag, but article pages do. In the future, we can fallback to the
 	// website's title by using the <title> tag.
-	if ogMetadata.Title == "" {
+	title := ogMetadata.Title
+	setIfEmpty(&title, jsonLDMetadata.Headline)
+	setIfEmpty(&title, oembedResponse.Title)
+	if title == "" {
 		return preview, fmt.Errorf("missing required title in OpenGraph response")
 	}
 
-	if ogMetadata.ThumbnailURL != "" {
-		t, err := fetchImage(u.logger, u.httpClient, ogMetadata.ThumbnailURL, true)
+	thumbnailURL := ogMetadata.ThumbnailURL
+	setIfEmpty(&thumbnailURL, oembedResponse.ThumbnailURL)
+	if thumbnailURL != "" {
+		t, err := fetchImage(u.logger, u.httpClient, thumbnailURL, true)
 		if err != nil {
 			// Given we want to fetch thumbnails on a best-effort basis, if an error
 			// happens we simply log it.
@@ -122,12 +154,68 @@ func (u *OpenGraphUnfurler) Unfurl() (*common.LinkPreview, error) {
 		}
 	}
 
-	preview.Title = ogMetadata.Title
+	preview.Title = title
 	preview.Description = ogMetadata.Description
+	u.setMediaMetadata(preview, &ogMetadata, &jsonLDMetadata, oembedResponse)
 
 	return preview, nil
 }
 
+// setMediaMetadata fills the article and media fields of the preview,
+// preferring the OpenGraph data, then the JSON-LD data and then the oEmbed data
+func (u *OpenGraphUnfurler) setMediaMetadata(preview *common.LinkPreview, og *OpenGraphMetadata, jsonLD *JSONLDMetadata, oembed *OEmbedResponse) {
+	setIfEmpty(&preview.SiteName, og.SiteName)
+	setIfEmpty(&preview.SiteName, jsonLD.Publisher)
+	setIfEmpty(&preview.SiteName, oembed.ProviderName)
+
+	setIfEmpty(&preview.Author, og.Author)
+	setIfEmpty(&preview.Author, jsonLD.Author)
+	setIfEmpty(&preview.Author, oembed.AuthorName)
+	setIfEmpty(&preview.Author, og.TwitterCreator)
+
+	preview.PublishedAt = parseLinkPreviewTime(og.PublishedTime)
+	if preview.PublishedAt == 0 {
+		preview.PublishedAt = parseLinkPreviewTime(jsonLD.DatePublished)
+	}
+
+	if og.Duration > 0 && og.Duration <= math.MaxUint32 {
+		preview.Duration = uint32(og.Duration)
+	} else {
+		preview.Duration = parseISO8601Duration(jsonLD.Duration)
+	}
+
+	var embed common.LinkPreviewEmbed
+	switch {
+	case og.VideoURL != "":
+		embed.Type = protobuf.UnfurledLinkEmbed_VIDEO
+		embed.URL = resolveLinkPreviewURL(u.url, og.VideoURL)
+		embed.Width = int(og.VideoWidth)
+		embed.Height = int(og.VideoHeight)
+	case og.AudioURL != "":
+		embed.Type = protobuf.UnfurledLinkEmbed_AUDIO
+		embed.URL = resolveLinkPreviewURL(u.url, og.AudioURL)
+	case jsonLD.EmbedURL != "":
+		embed.Type = protobuf.UnfurledLinkEmbed_VIDEO
+		if jsonLD.Audio {
+			embed.Type = protobuf.UnfurledLinkEmbed_AUDIO
+		}
+		embed.URL = resolveLinkPreviewURL(u.url, jsonLD.EmbedURL)
+		embed.Width = jsonLD.Width
+		embed.Height = jsonLD.Height
+	}
+	if embed.URL == "" {
+		return
+	}
+	if embed.Width <= 0 || embed.Height <= 0 {
+		embed.Width, embed.Height = 0, 0
+		if oembed.isEmbeddable() {
+			embed.Width = int(oembed.Width)
+			embed.Height = int(oembed.Height)
+		}
+	}
+	preview.Embed = &embed
+}
+
 func fetchImage(logger *zap.Logger, httpClient *http.Client, url string, getDimensions bool) (common.LinkPreviewThumbnail, error) {
 	var thumbnail common.LinkPreviewThumbnail
 
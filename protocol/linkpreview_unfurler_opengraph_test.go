@@ -0,0 +1,118 @@
+package protocol
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	neturl "net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const linkPreviewFixturesPath = "../_assets/tests/linkpreview/"
+
+var updateLinkPreviewGolden = flag.Bool("update-link-preview-golden", false, "update the link preview golden files")
+
+// TestOpenGraphUnfurlerGolden unfurls recorded pages and compares the previews
+// to the golden files, run with -update-link-preview-golden to regenerate them.
+// Images are not stubbed, so previews don't have a favicon nor a thumbnail.
+func TestOpenGraphUnfurlerGolden(t *testing.T) {
+	testCases := []struct {
+		name      string
+		url       string
+		oembedURL string
+	}{
+		{
+			name:      "youtube",
+			url:       "https://www.youtube.com/watch?v=mzOyYtfXkb0",
+			oembedURL: "https://www.youtube.com/oembed?format=json&url=https%3A%2F%2Fwww.youtube.com%2Fwatch%3Fv%3DmzOyYtfXkb0",
+		},
+		{
+			name: "article",
+			url:  "https://news.example.com/2024/03/waku-v2",
+		},
+		{
+			name: "podcast",
+			url:  "https://podcasts.example.com/episodes/42",
+		},
+		{
+			name:      "oembed",
+			url:       "https://videos.example.org/w/9c9de5e8",
+			oembedURL: "https://videos.example.org/api/oembed?url=https%3A%2F%2Fvideos.example.org%2Fw%2F9c9de5e8",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := StubTransport{}
+			if tc.oembedURL != "" {
+				oembed, err := ioutil.ReadFile(linkPreviewFixturesPath + tc.name + ".oembed.json")
+				require.NoError(t, err)
+				transport.AddURLMatcher(tc.oembedURL, oembed, nil)
+			}
+			page, err := ioutil.ReadFile(linkPreviewFixturesPath + tc.name + ".html")
+			require.NoError(t, err)
+			transport.AddURLMatcher(tc.url, page, nil)
+
+			u, err := neturl.Parse(tc.url)
+			require.NoError(t, err)
+			preview, err := NewOpenGraphUnfurler(u, zap.NewNop(), &http.Client{Transport: &transport}).Unfurl()
+			require.NoError(t, err)
+
+			actual, err := json.MarshalIndent(preview, "", "  ")
+			require.NoError(t, err)
+
+			goldenPath := linkPreviewFixturesPath + tc.name + ".golden.json"
+			if *updateLinkPreviewGolden {
+				require.NoError(t, ioutil.WriteFile(goldenPath, append(actual, '\n'), 0600))
+			}
+			expected, err := ioutil.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(actual))
+		})
+	}
+}
+
+func TestGetOEmbedURL(t *testing.T) {
+	pageURL, err := neturl.Parse("https://status.app/blog/post")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		html     string
+		expected string
+	}{
+		{`<link rel="alternate" type="application/json+oembed" href="https://status.app/oembed?url=x">`, "https://status.app/oembed?url=x"},
+		{`<link type="text/json+oembed" href="/oembed?url=x" />`, "https://status.app/oembed?url=x"},
+		{`<link rel="alternate" type="text/xml+oembed" href="/oembed?url=x">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="javascript:alert(1)">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="http://status.app/oembed?url=x">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="https://127.0.0.1/oembed?url=x">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="https://192.168.1.1/oembed?url=x">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="https://[fe80::1]/oembed?url=x">`, ""},
+		{`<link rel="alternate" type="application/json+oembed" href="https://localhost/oembed?url=x">`, ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, GetOEmbedURL([]byte(tc.html), pageURL), tc.html)
+	}
+}
+
+func TestParseLinkPreviewMediaValues(t *testing.T) {
+	require.Equal(t, uint32(3725), parseISO8601Duration("PT1H2M5S"))
+	require.Equal(t, uint32(90061), parseISO8601Duration("P1DT1H1M1S"))
+	require.Equal(t, uint32(45), parseISO8601Duration("pt45.9s"))
+	require.Equal(t, uint32(0), parseISO8601Duration("1:02:05"))
+
+	require.Equal(t, uint64(1710408600000), parseLinkPreviewTime("2024-03-14T09:30:00Z"))
+	require.Equal(t, uint64(1710408600000), parseLinkPreviewTime("2024-03-14T10:30:00+0100"))
+	require.Equal(t, uint64(1705881600000), parseLinkPreviewTime("2024-01-22"))
+	require.Equal(t, uint64(0), parseLinkPreviewTime("yesterday"))
+
+	require.Equal(t, 1280, jsonLDDimension(json.RawMessage(`1280`)))
+	require.Equal(t, 720, jsonLDDimension(json.RawMessage(`"720"`)))
+	require.Equal(t, 480, jsonLDDimension(json.RawMessage(`{"@type": "QuantitativeValue", "value": 480}`)))
+	require.Equal(t, 0, jsonLDDimension(nil))
+}
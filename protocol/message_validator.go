@@ -13,6 +13,7 @@ import (
 
 const maxChatMessageTextLength = 4096
 const maxStatusMessageText = 128
+const maxStatusEmojiLength = 16
 
 // maxWhisperDrift is how many milliseconds we allow the clock value to differ
 // from whisperTimestamp
@@ -57,6 +58,10 @@ func ValidateStatusUpdate(message *protobuf.StatusUpdate) error {
 		return fmt.Errorf("custom text shouldn't be longer than %d", maxStatusMessageText)
 	}
 
+	if len([]rune(message.Emoji)) > maxStatusEmojiLength {
+		return fmt.Errorf("emoji shouldn't be longer than %d", maxStatusEmojiLength)
+	}
+
 	return nil
 
 }
@@ -140,6 +140,7 @@ type Messenger struct {
 	modifiedInstallations      *stringBoolMap
 	installationID             string
 	mailserverCycle            mailserverCycle
+	storenodeSelection         storenodeSelection
 	communityStorenodes        *storenodes.CommunityStorenodes
 	database                   *sql.DB
 	multiAccounts              *multiaccounts.Database
@@ -162,6 +163,12 @@ type Messenger struct {
 		wait chan struct{}
 		once sync.Once
 	}
+	importedContactRequestsLimiter *rate.Limiter
+	// spamProtection rate limits the incoming messages per sender
+	spamProtection *spamProtection
+	// appliedStatusScheduleEnd is the end of the last do not disturb window applied to the user status
+	appliedStatusScheduleEnd uint64
+	statusScheduleMutex      sync.Mutex
 
 	connectionState       connection.State
 	telemetryClient       *telemetry.Client
@@ -196,6 +203,14 @@ type Messenger struct {
 	peersyncingOffers   map[string]uint64
 	peersyncingRequests map[string]uint64
 
+	sessionDecryptionFailures      map[string]*sessionDecryptionFailures
+	sessionResetsHandled           map[string]uint64
+	sessionDecryptionFailuresMutex sync.Mutex
+
+	contactRequestsMutex sync.Mutex
+	heldContactRequests  map[string]*CurrentMessageState
+	contactRequestStamps map[string]cachedContactRequestStamp
+
 	mvdsStatusChangeEvent chan datasyncnode.PeerStatusChangeEvent
 }
 
@@ -528,6 +543,8 @@ func NewMessenger(
 		Identity:      identity,
 		Encryptor:     encryptionProtocol,
 		Publisher:     communitiesManager,
+		Transports:    c.archiveTransports,
+		DataDir:       c.archiveDataDir,
 	}
 
 	// Depending on the OS go will choose whether to use the "communities/manager_archive_nop.go" or
@@ -621,8 +638,14 @@ func NewMessenger(
 			wait chan struct{}
 			once sync.Once
 		}{wait: make(chan struct{})},
-		browserDatabase: c.browserDatabase,
-		httpServer:      c.httpServer,
+		importedContactRequestsLimiter: rate.NewLimiter(rate.Every(importedContactRequestsRate), importedContactRequestsBurst),
+		browserDatabase:                c.browserDatabase,
+		httpServer:                     c.httpServer,
+		sessionDecryptionFailures:      make(map[string]*sessionDecryptionFailures),
+		sessionResetsHandled:           make(map[string]uint64),
+		heldContactRequests:            make(map[string]*CurrentMessageState),
+		contactRequestStamps:           make(map[string]cachedContactRequestStamp),
+		spamProtection:                 newSpamProtection(c.maxIncomingMessagesPerSenderPerMinute, c.spamQuarantineScore),
 		shutdownTasks: []func() error{
 			ensVerifier.Stop,
 			pushNotificationClient.Stop,
@@ -689,6 +712,8 @@ func NewMessenger(
 	if anonMetricsServer != nil {
 		messenger.shutdownTasks = append(messenger.shutdownTasks, anonMetricsServer.Stop)
 	}
+	// The push notification server can be started and stopped while the messenger runs
+	messenger.shutdownTasks = append(messenger.shutdownTasks, messenger.StopPushNotificationsServer)
 
 	if c.envelopesMonitorConfig != nil {
 		interceptor := EnvelopeEventsInterceptor{c.envelopesMonitorConfig.EnvelopeEventsHandler, messenger}
@@ -838,6 +863,7 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 	m.watchPendingCommunityRequestToJoin()
 	m.broadcastLatestUserStatus()
 	m.timeoutAutomaticStatusUpdates()
+	m.watchUserStatusSchedule()
 	if !m.config.featureFlags.DisableCheckingForBackup {
 		m.startBackupLoop()
 	}
@@ -878,6 +904,10 @@ func (m *Messenger) Start() (*MessengerResponse, error) {
 		return nil, err
 	}
 
+	if err := m.loadQuarantinedSenders(); err != nil {
+		return nil, err
+	}
+
 	go m.checkForMissingMessagesLoop()
 
 	controlledCommunities, err := m.communitiesManager.Controlled()
@@ -1450,6 +1480,7 @@ func (m *Messenger) handleEncryptionLayerSubscriptions(subscriptions *encryption
 				if err := m.transport.CleanMessagesProcessed(m.getTimesource().GetCurrentTime() - messageCacheIntervalMs); err != nil {
 					m.logger.Error("failed to clean processed messages", zap.Error(err))
 				}
+				m.cleanDeliveryTimeline()
 
 			case keys := <-subscriptions.NewHashRatchetKeys:
 				if m.communitiesManager == nil {
@@ -1458,6 +1489,8 @@ func (m *Messenger) handleEncryptionLayerSubscriptions(subscriptions *encryption
 				if err := m.communitiesManager.NewHashRatchetKeys(keys); err != nil {
 					m.logger.Error("failed to invalidate cache for decrypted communities", zap.Error(err))
 				}
+			case failure := <-subscriptions.DecryptionFailures:
+				m.handleDecryptionFailure(failure)
 			case <-subscriptions.Quit:
 				m.logger.Debug("quitting encryption subscription loop")
 				return
@@ -1490,6 +1523,7 @@ func (m *Messenger) handleENSVerified(records []*ens.VerificationRecord) {
 	}
 
 	m.PublishMessengerResponse(&MessengerResponse{Contacts: contacts})
+	m.handleHeldContactRequests(records)
 }
 
 func (m *Messenger) handleENSVerificationSubscription(c chan []*ens.VerificationRecord) {
@@ -3134,6 +3168,8 @@ type ReceivedMessageState struct {
 	AllBookmarks            map[string]*browsers.Bookmark
 	AllVerificationRequests []*verification.Request
 	AllTrustStatus          map[string]verification.TrustStatus
+	// MutedContacts are the members of muted contact groups
+	MutedContacts map[string]bool
 }
 
 // addNewMessageNotification takes a common.Message and generates a new NotificationBody and appends it to the
@@ -3159,7 +3195,7 @@ func (r *ReceivedMessageState) addNewMessageNotification(publicKey ecdsa.PublicK
 		return fmt.Errorf("contact ID '%s' not present", contactID)
 	}
 
-	if !chat.Muted {
+	if !chat.Muted && (!r.MutedContacts[contactID] || m.Mentioned) {
 		if showMessageNotification(publicKey, m, chat, responseTo) {
 			notification, err := NewMessageNotification(m.ID, m, chat, contact, r.ResolvePrimaryName, profilePicturesVisibility)
 			if err != nil {
@@ -3543,7 +3579,13 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 				publicKey := msg.SigPubKey()
 
 				m.handleInstallations(msg.EncryptionLayer.Installations)
-				err := m.handleSharedSecrets(msg.EncryptionLayer.SharedSecrets)
+				err := m.checkSafetyNumbersChanged(messageState.Response, msg.EncryptionLayer.Installations)
+				if err != nil {
+					// log and continue, non-critical error
+					logger.Warn("failed to check safety numbers", zap.Error(err))
+				}
+
+				err = m.handleSharedSecrets(msg.EncryptionLayer.SharedSecrets)
 				if err != nil {
 					// log and continue, non-critical error
 					logger.Warn("failed to handle shared secrets")
@@ -3578,6 +3620,12 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 					continue
 				}
 
+				// Drop spam before anything is persisted
+				if m.shouldDropIncomingMessage(msg, senderID, contact) {
+					logger.Debug("dropping message over the sender rate limit", zap.String("messageID", messageID))
+					continue
+				}
+
 				if !contactFound {
 					c, err := buildContact(senderID, publicKey)
 					if err != nil {
@@ -3636,6 +3684,8 @@ func (m *Messenger) handleRetrievedMessages(chatWithMessages map[transport.Filte
 		}
 	}
 
+	m.saveQuarantinedSenders()
+
 	return m.saveDataAndPrepareResponse(messageState)
 }
 
@@ -3741,6 +3791,11 @@ func (m *Messenger) saveDataAndPrepareResponse(messageState *ReceivedMessageStat
 		return nil, err
 	}
 
+	messageState.MutedContacts, err = m.persistence.MutedContactGroupMembers()
+	if err != nil {
+		return nil, err
+	}
+
 	err = m.prepareMessages(messageState.Response.messages)
 	if err != nil {
 		return nil, err
@@ -5437,8 +5492,42 @@ func (m *Messenger) StartPushNotificationsServer() error {
 
 // StopPushNotificationServer stops the push notification server if running
 func (m *Messenger) StopPushNotificationsServer() error {
+	if m.pushNotificationServer == nil {
+		return nil
+	}
+	err := m.pushNotificationServer.Stop()
 	m.pushNotificationServer = nil
-	return nil
+	return err
+}
+
+// GetPushNotificationsServerStats returns the counters of the push notification server
+func (m *Messenger) GetPushNotificationsServerStats() (map[string]int64, error) {
+	if m.pushNotificationServer == nil {
+		return nil, errors.New("no push notification server")
+	}
+	return m.pushNotificationServer.Stats()
+}
+
+// BlockPushNotificationsServerSender drops any further registration of the public key, and the notifications to and from it
+func (m *Messenger) BlockPushNotificationsServerSender(publicKey *ecdsa.PublicKey) error {
+	if m.pushNotificationServer == nil {
+		return errors.New("no push notification server")
+	}
+	return m.pushNotificationServer.BlockPublicKey(publicKey)
+}
+
+func (m *Messenger) UnblockPushNotificationsServerSender(publicKey *ecdsa.PublicKey) error {
+	if m.pushNotificationServer == nil {
+		return errors.New("no push notification server")
+	}
+	return m.pushNotificationServer.UnblockPublicKey(publicKey)
+}
+
+func (m *Messenger) GetBlockedPushNotificationsServerSenders() ([]*ecdsa.PublicKey, error) {
+	if m.pushNotificationServer == nil {
+		return nil, errors.New("no push notification server")
+	}
+	return m.pushNotificationServer.BlockedPublicKeys()
 }
 
 func generateAliasAndIdenticon(pk string) (string, string, error) {
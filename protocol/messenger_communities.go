@@ -288,6 +288,21 @@ func (m *Messenger) handleCommunitiesHistoryArchivesSubscription(c chan *communi
 					m.config.messengerSignalsHandler.ImportingHistoryArchiveMessages(sub.ImportingHistoryArchiveMessagesSignal.CommunityID)
 				}
 
+				if sub.HistoryArchiveVerificationFailedSignal != nil {
+					m.config.messengerSignalsHandler.HistoryArchiveVerificationFailed(
+						sub.HistoryArchiveVerificationFailedSignal.CommunityID,
+						sub.HistoryArchiveVerificationFailedSignal.ArchiveID,
+						sub.HistoryArchiveVerificationFailedSignal.Error,
+					)
+				}
+
+				if sub.UnsignedHistoryArchiveImportedSignal != nil {
+					m.config.messengerSignalsHandler.UnsignedHistoryArchiveImported(
+						sub.UnsignedHistoryArchiveImportedSignal.CommunityID,
+						sub.UnsignedHistoryArchiveImportedSignal.ArchiveID,
+					)
+				}
+
 			case <-m.quit:
 				return
 			}
@@ -1430,6 +1445,11 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		return nil, communities.ErrAlreadyJoined
 	}
 
+	err = community.ValidateMembershipAnswers(request.MembershipAnswers)
+	if err != nil {
+		return nil, err
+	}
+
 	requestToJoin := m.communitiesManager.CreateRequestToJoin(request, m.account.GetCustomizationColor())
 
 	if len(request.AddressesToReveal) > 0 {
@@ -1467,6 +1487,7 @@ func (m *Messenger) RequestToJoinCommunity(request *requests.RequestToJoinCommun
 		CommunityId:        request.CommunityID,
 		RevealedAccounts:   requestToJoin.RevealedAccounts,
 		CustomizationColor: multiaccountscommon.ColorToIDFallbackToBlue(requestToJoin.CustomizationColor),
+		MembershipAnswers:  requestToJoin.MembershipAnswers,
 	}
 
 	community, _, err = m.communitiesManager.SaveRequestToJoinAndCommunity(requestToJoin, community)
@@ -1991,14 +2012,15 @@ func (m *Messenger) acceptRequestToJoinCommunity(requestToJoin *communities.Requ
 			Shard:                    community.Shard().Protobuffer(),
 		}
 
-		// The purpose of this torrent code is to get the 'magnetlink' to populate 'requestToJoinResponseProto.MagnetUri'
+		// The purpose of this torrent code is to get the archive locations to populate 'requestToJoinResponseProto.ArchiveLocations'
 		if m.archiveManager.IsReady() && m.archiveManager.TorrentFileExists(community.IDString()) {
-			magnetlink, err := m.archiveManager.GetHistoryArchiveMagnetlink(community.ID())
+			locations, err := m.archiveManager.GetHistoryArchiveLocations(community.ID())
 			if err != nil {
-				m.logger.Warn("couldn't get magnet link for community", zap.Error(err))
+				m.logger.Warn("couldn't get archive locations for community", zap.Error(err))
 				return nil, err
 			}
-			requestToJoinResponseProto.MagnetUri = magnetlink
+			requestToJoinResponseProto.MagnetUri = historyArchiveMagnetlink(locations)
+			requestToJoinResponseProto.ArchiveLocations = locations
 		}
 
 		payload, err := proto.Marshal(requestToJoinResponseProto)
@@ -2961,8 +2983,12 @@ func (m *Messenger) ImportCommunity(ctx context.Context, key *ecdsa.PrivateKey)
 		m.logger.Error("Can't request community info from mailserver")
 	}
 
+	return m.becomeImportedCommunityControlNode(ctx, community)
+}
+
+func (m *Messenger) becomeImportedCommunityControlNode(ctx context.Context, community *communities.Community) (*MessengerResponse, error) {
 	// We add ourselves
-	community, err = m.communitiesManager.AddMemberOwnerToCommunity(community.ID(), &m.identity.PublicKey)
+	community, err := m.communitiesManager.AddMemberOwnerToCommunity(community.ID(), &m.identity.PublicKey)
 	if err != nil {
 		return nil, err
 	}
@@ -2986,6 +3012,124 @@ func (m *Messenger) ImportCommunity(ctx context.Context, key *ecdsa.PrivateKey)
 	return response, nil
 }
 
+// ExportCommunityControlNodeBundle returns the control node state of the community,
+// signed with the community private key and encrypted with the given password
+func (m *Messenger) ExportCommunityControlNodeBundle(request *requests.ExportCommunityControlNodeBundle) (types.HexBytes, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	community, err := m.communitiesManager.GetByID(request.CommunityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !community.IsControlNode() {
+		return nil, communities.ErrNotControlNode
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	syncCommunity, err := m.buildSyncInstallationCommunity(community, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := m.communitiesManager.BuildControlNodeBundle(syncCommunity)
+	if err != nil {
+		return nil, err
+	}
+
+	return communities.EncryptControlNodeBundle(bundle, request.Password)
+}
+
+// ImportCommunityControlNodeBundle makes this device the control node of the community
+// exported with ExportCommunityControlNodeBundle. The encryption keys of the community are
+// imported as they are, members don't need to be sent new keys.
+func (m *Messenger) ImportCommunityControlNodeBundle(ctx context.Context, request *requests.ImportCommunityControlNodeBundle) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle, key, err := communities.DecryptControlNodeBundle(request.Bundle, request.Password)
+	if err != nil {
+		return nil, err
+	}
+	syncCommunity := bundle.Community
+
+	// Keys must be known before handling the description, as it might be encrypted
+	if len(syncCommunity.EncryptionKeysV1) != 0 {
+		_, err := m.encryptor.HandleHashRatchetKeysPayload(syncCommunity.Id, syncCommunity.EncryptionKeysV1, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(syncCommunity.EncryptionKeysV2) != 0 {
+		err := m.encryptor.HandleHashRatchetHeadersPayload(syncCommunity.EncryptionKeysV2)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var amm protobuf.ApplicationMetadataMessage
+	err = proto.Unmarshal(syncCommunity.Description, &amm)
+	if err != nil {
+		return nil, err
+	}
+
+	var cd protobuf.CommunityDescription
+	err = proto.Unmarshal(amm.Payload, &cd)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := utils.RecoverKey(&amm)
+	if err != nil {
+		return nil, err
+	}
+
+	state := m.buildMessageState()
+	err = m.handleCommunityDescription(state, signer, &cd, syncCommunity.Description, signer, nil)
+	if err != nil && err != communities.ErrInvalidCommunityDescriptionClockOutdated {
+		return nil, err
+	}
+
+	if syncCommunity.Settings != nil {
+		err = m.HandleSyncCommunitySettings(state, syncCommunity.Settings, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	clock, _ := m.getLastClockWithRelatedChat()
+	community, err := m.communitiesManager.ImportCommunity(key, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.communitiesManager.ImportControlNodeBundleState(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = m.transport.InitPublicFilters(m.DefaultFilters(community))
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := m.becomeImportedCommunityControlNode(ctx, community)
+	if err != nil {
+		return nil, err
+	}
+
+	err = response.Merge(state.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 func (m *Messenger) GetCommunityByID(communityID types.HexBytes) (*communities.Community, error) {
 	return m.communitiesManager.GetByID(communityID)
 }
@@ -4180,6 +4324,17 @@ importMessageArchivesLoop:
 	return nil
 }
 
+// historyArchiveMagnetlink returns the magnet link of the archive locations,
+// advertised separately for the clients which only support BitTorrent
+func historyArchiveMagnetlink(locations []string) string {
+	for _, location := range locations {
+		if strings.HasPrefix(location, "magnet:") {
+			return location
+		}
+	}
+	return ""
+}
+
 func (m *Messenger) dispatchMagnetlinkMessage(communityID string) error {
 
 	community, err := m.communitiesManager.GetByIDString(communityID)
@@ -4187,14 +4342,15 @@ func (m *Messenger) dispatchMagnetlinkMessage(communityID string) error {
 		return err
 	}
 
-	magnetlink, err := m.archiveManager.GetHistoryArchiveMagnetlink(community.ID())
+	locations, err := m.archiveManager.GetHistoryArchiveLocations(community.ID())
 	if err != nil {
 		return err
 	}
 
 	magnetLinkMessage := &protobuf.CommunityMessageArchiveMagnetlink{
-		Clock:     m.getTimesource().GetCurrentTime(),
-		MagnetUri: magnetlink,
+		Clock:            m.getTimesource().GetCurrentTime(),
+		MagnetUri:        historyArchiveMagnetlink(locations),
+		ArchiveLocations: locations,
 	}
 
 	encodedMessage, err := proto.Marshal(magnetLinkMessage)
@@ -4685,33 +4841,39 @@ func (m *Messenger) rekeyCommunities(logger *zap.Logger) {
 	}
 
 	for _, c := range controlledCommunities {
-		keyActions := &communities.EncryptionKeyActions{
-			CommunityKeyAction: communities.EncryptionKeyAction{},
-			ChannelKeysActions: map[string]communities.EncryptionKeyAction{},
+		err = m.rekeyCommunity(c, shouldRekey)
+		if err != nil {
+			logger.Error("failed to rekey community", zap.Error(err), zap.String("community ID", c.IDString()))
+			continue
 		}
+	}
+}
 
-		if c.Encrypted() && shouldRekey(c.ID()) {
-			keyActions.CommunityKeyAction = communities.EncryptionKeyAction{
-				ActionType: communities.EncryptionKeyRekey,
-				Members:    c.Members(),
-			}
-		}
+// rekeyCommunity distributes new keys for the community and its encrypted channels,
+// shouldRekey tells which hash ratchet groups need a new key
+func (m *Messenger) rekeyCommunity(c *communities.Community, shouldRekey func(hashRatchetGroupID []byte) bool) error {
+	keyActions := &communities.EncryptionKeyActions{
+		CommunityKeyAction: communities.EncryptionKeyAction{},
+		ChannelKeysActions: map[string]communities.EncryptionKeyAction{},
+	}
 
-		for channelID, channel := range c.Chats() {
-			if c.ChannelEncrypted(channelID) && shouldRekey([]byte(c.IDString()+channelID)) {
-				keyActions.ChannelKeysActions[channelID] = communities.EncryptionKeyAction{
-					ActionType: communities.EncryptionKeyRekey,
-					Members:    channel.Members,
-				}
-			}
+	if c.Encrypted() && shouldRekey(c.ID()) {
+		keyActions.CommunityKeyAction = communities.EncryptionKeyAction{
+			ActionType: communities.EncryptionKeyRekey,
+			Members:    c.Members(),
 		}
+	}
 
-		err = m.communitiesKeyDistributor.Distribute(c, keyActions)
-		if err != nil {
-			logger.Error("failed to rekey community", zap.Error(err), zap.String("community ID", c.IDString()))
-			continue
+	for channelID, channel := range c.Chats() {
+		if c.ChannelEncrypted(channelID) && shouldRekey([]byte(c.IDString()+channelID)) {
+			keyActions.ChannelKeysActions[channelID] = communities.EncryptionKeyAction{
+				ActionType: communities.EncryptionKeyRekey,
+				Members:    channel.Members,
+			}
 		}
 	}
+
+	return m.communitiesKeyDistributor.Distribute(c, keyActions)
 }
 
 func (m *Messenger) GetCommunityMembersForWalletAddresses(communityID types.HexBytes, chainID uint64) (map[string]*Contact, error) {
@@ -4930,6 +5092,25 @@ func (m *Messenger) leaveCommunityDueToKickOrBan(changes *communities.CommunityC
 	}
 }
 
+// CommunityMembersDirectory returns a page of the members of a community, filtered server-side
+func (m *Messenger) CommunityMembersDirectory(request *requests.CommunityMembersDirectory) (*communities.MembersDirectoryPage, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	return m.communitiesManager.MembersDirectory(&communities.MembersDirectoryQuery{
+		CommunityID:  request.CommunityID,
+		Cursor:       request.Cursor,
+		Limit:        request.Limit,
+		Search:       request.Search,
+		Roles:        request.Roles,
+		OnlineOnly:   request.OnlineOnly,
+		PermissionID: request.PermissionID,
+		JoinedAfter:  request.JoinedAfter,
+		JoinedBefore: request.JoinedBefore,
+	})
+}
+
 func (m *Messenger) GetCommunityMemberAllMessages(request *requests.CommunityMemberMessages) ([]*common.Message, error) {
 	if err := request.Validate(); err != nil {
 		return nil, err
@@ -53,6 +53,12 @@ type MessengerSignalsHandler interface {
 	HistoryArchiveDownloaded(communityID string, from int, to int)
 	DownloadingHistoryArchivesStarted(communityID string)
 	DownloadingHistoryArchivesFinished(communityID string)
+	HistoryArchiveVerificationFailed(communityID string, archiveID string, err string)
+	UnsignedHistoryArchiveImported(communityID string, archiveID string)
+	SessionOutOfSync(publicKey string, failures uint)
+	SessionReset(publicKey string, state string, resentMessages int)
+	SafetyNumberChanged(contactID string)
+	SenderQuarantined(senderID string)
 	ImportingHistoryArchiveMessages(communityID string)
 	StatusUpdatesTimedOut(statusUpdates *[]UserStatus)
 	DiscordCategoriesAndChannelsExtracted(categories []*discord.Category, channels []*discord.Channel, oldestMessageTimestamp int64, errors map[string]*discord.ImportError)
@@ -89,6 +95,8 @@ type config struct {
 	clusterConfig          params.ClusterConfig
 	browserDatabase        *browsers.Database
 	torrentConfig          *params.TorrentConfig
+	archiveTransports      []communities.ArchiveTransport
+	archiveDataDir         string
 	walletConfig           *params.WalletConfig
 	walletService          *wallet.Service
 	communityTokensService communities.CommunityTokensServiceInterface
@@ -121,6 +129,15 @@ type config struct {
 	messageResendMinDelay time.Duration
 	messageResendMaxCount int
 
+	// parallelHistoryStorenodes is the number of storenodes history requests are spread over,
+	// history is fetched from the active storenode only when lower than 2
+	parallelHistoryStorenodes   int
+	maxConcurrentHistoryQueries int
+
+	// maxIncomingMessagesPerSenderPerMinute limits the messages handled for a single sender, 0 disables it
+	maxIncomingMessagesPerSenderPerMinute int
+	spamQuarantineScore                   int
+
 	communityManagerOptions []communities.ManagerOption
 
 	accountsFeed *event.Feed
@@ -170,6 +187,26 @@ func WithResendParams(minDelay time.Duration, maxCount int) Option {
 	}
 }
 
+// WithParallelHistoryFetching spreads history requests over up to storenodes healthy storenodes,
+// with at most maxConcurrentQueries queries in flight
+func WithParallelHistoryFetching(storenodes, maxConcurrentQueries int) Option {
+	return func(c *config) error {
+		c.parallelHistoryStorenodes = storenodes
+		c.maxConcurrentHistoryQueries = maxConcurrentQueries
+		return nil
+	}
+}
+
+// WithIncomingMessagesRateLimit drops the messages of a sender over maxPerSenderPerMinute,
+// and quarantines the senders whose spam score reaches quarantineScore
+func WithIncomingMessagesRateLimit(maxPerSenderPerMinute, quarantineScore int) Option {
+	return func(c *config) error {
+		c.maxIncomingMessagesPerSenderPerMinute = maxPerSenderPerMinute
+		c.spamQuarantineScore = quarantineScore
+		return nil
+	}
+}
+
 func WithDatabase(db *sql.DB) Option {
 	return func(c *config) error {
 		c.appDb = db
@@ -349,6 +386,21 @@ func WithTorrentConfig(tc *params.TorrentConfig) Option {
 	}
 }
 
+func WithArchiveTransports(transports ...communities.ArchiveTransport) Option {
+	return func(c *config) error {
+		c.archiveTransports = transports
+		return nil
+	}
+}
+
+// WithArchiveDataDir sets the directory archives are downloaded to over the archive transports
+func WithArchiveDataDir(dataDir string) Option {
+	return func(c *config) error {
+		c.archiveDataDir = dataDir
+		return nil
+	}
+}
+
 func WithHTTPServer(s *server.MediaServer) Option {
 	return func(c *config) error {
 		c.httpServer = s
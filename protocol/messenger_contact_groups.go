@@ -0,0 +1,304 @@
+package protocol
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+// ContactGroup is a named list of contacts, only visible to the user and their paired devices
+type ContactGroup struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Color     string   `json:"color"`
+	MemberIDs []string `json:"memberIds"`
+	// Muted disables the notifications of messages sent by the members of the group
+	Muted   bool   `json:"muted"`
+	Deleted bool   `json:"deleted"`
+	Clock   uint64 `json:"clock"`
+}
+
+func (g *ContactGroup) HasMember(contactID string) bool {
+	for _, memberID := range g.MemberIDs {
+		if memberID == contactID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Messenger) CreateContactGroup(ctx context.Context, request *requests.CreateContactGroup) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := m.contactGroupMemberIDs(request.MemberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	group := &ContactGroup{
+		ID:        uuid.New().String(),
+		Name:      request.Name,
+		Color:     request.Color,
+		MemberIDs: memberIDs,
+	}
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+func (m *Messenger) EditContactGroup(ctx context.Context, request *requests.EditContactGroup) (*MessengerResponse, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	group, err := m.getContactGroup(request.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := m.contactGroupMemberIDs(request.MemberIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Name = request.Name
+	group.Color = request.Color
+	group.MemberIDs = memberIDs
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+func (m *Messenger) DeleteContactGroup(ctx context.Context, groupID string) (*MessengerResponse, error) {
+	group, err := m.getContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The group is kept as a tombstone, so that older updates from paired devices don't restore it
+	group.Deleted = true
+	group.MemberIDs = []string{}
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+func (m *Messenger) AddContactsToGroup(ctx context.Context, groupID string, contactIDs []string) (*MessengerResponse, error) {
+	group, err := m.getContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberIDs, err := m.contactGroupMemberIDs(contactIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, memberID := range memberIDs {
+		if !group.HasMember(memberID) {
+			group.MemberIDs = append(group.MemberIDs, memberID)
+		}
+	}
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+func (m *Messenger) RemoveContactsFromGroup(ctx context.Context, groupID string, contactIDs []string) (*MessengerResponse, error) {
+	group, err := m.getContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make(map[string]bool)
+	for _, contactID := range contactIDs {
+		removed[contactID] = true
+	}
+
+	memberIDs := []string{}
+	for _, memberID := range group.MemberIDs {
+		if !removed[memberID] {
+			memberIDs = append(memberIDs, memberID)
+		}
+	}
+	group.MemberIDs = memberIDs
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+// MuteContactGroup toggles the notifications of messages sent by the members of the group
+func (m *Messenger) MuteContactGroup(ctx context.Context, groupID string, muted bool) (*MessengerResponse, error) {
+	group, err := m.getContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	group.Muted = muted
+	return m.saveAndSyncContactGroup(ctx, group)
+}
+
+// ContactGroups returns the groups which haven't been deleted
+func (m *Messenger) ContactGroups() ([]*ContactGroup, error) {
+	groups, err := m.persistence.ContactGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*ContactGroup
+	for _, group := range groups {
+		if !group.Deleted {
+			result = append(result, group)
+		}
+	}
+	return result, nil
+}
+
+// ContactsByGroup returns the contacts of the group
+func (m *Messenger) ContactsByGroup(groupID string) ([]*Contact, error) {
+	group, err := m.getContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var contacts []*Contact
+	for _, memberID := range group.MemberIDs {
+		if contact, ok := m.allContacts.Load(memberID); ok {
+			contacts = append(contacts, contact)
+		}
+	}
+	return contacts, nil
+}
+
+// CreateGroupChatWithContactGroup creates a group chat with the mutual contacts of the group
+func (m *Messenger) CreateGroupChatWithContactGroup(ctx context.Context, name string, groupID string) (*MessengerResponse, error) {
+	contacts, err := m.ContactsByGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for _, contact := range contacts {
+		if contact.mutual() {
+			members = append(members, contact.ID)
+		}
+	}
+	if len(members) == 0 {
+		return nil, ErrGroupChatAddedContacts
+	}
+
+	return m.CreateGroupChatWithMembers(ctx, name, members)
+}
+
+func (m *Messenger) getContactGroup(groupID string) (*ContactGroup, error) {
+	group, err := m.persistence.ContactGroup(groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || group.Deleted {
+		return nil, ErrContactGroupNotFound
+	}
+	return group, nil
+}
+
+// contactGroupMemberIDs checks that the members are known contacts and removes duplicates
+func (m *Messenger) contactGroupMemberIDs(contactIDs []string) ([]string, error) {
+	memberIDs := []string{}
+	seen := make(map[string]bool)
+	for _, contactID := range contactIDs {
+		if seen[contactID] {
+			continue
+		}
+		seen[contactID] = true
+
+		if _, ok := m.allContacts.Load(contactID); !ok {
+			return nil, ErrContactNotFound
+		}
+		memberIDs = append(memberIDs, contactID)
+	}
+	return memberIDs, nil
+}
+
+func (m *Messenger) saveAndSyncContactGroup(ctx context.Context, group *ContactGroup) (*MessengerResponse, error) {
+	group.Clock, _ = m.getLastClockWithRelatedChat()
+
+	err := m.persistence.SaveContactGroup(group)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncContactGroup(ctx, group, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MessengerResponse{}
+	response.AddContactGroup(group)
+	return response, nil
+}
+
+func (m *Messenger) syncContactGroup(ctx context.Context, group *ContactGroup, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	syncMessage := &protobuf.SyncContactGroup{
+		Clock:     group.Clock,
+		Id:        group.ID,
+		Name:      group.Name,
+		Color:     group.Color,
+		MemberIds: group.MemberIDs,
+		Muted:     group.Muted,
+		Deleted:   group.Deleted,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_SYNC_CONTACT_GROUP,
+		ResendType:  common.ResendTypeDataSync,
+	}
+
+	_, err = rawMessageHandler(ctx, rawMessage)
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+func (m *Messenger) HandleSyncContactGroup(state *ReceivedMessageState, message *protobuf.SyncContactGroup, statusMessage *v1protocol.StatusMessage) error {
+	existing, err := m.persistence.ContactGroup(message.Id)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.Clock >= message.Clock {
+		return nil
+	}
+
+	group := &ContactGroup{
+		ID:        message.Id,
+		Name:      message.Name,
+		Color:     message.Color,
+		MemberIDs: message.MemberIds,
+		Muted:     message.Muted,
+		Deleted:   message.Deleted,
+		Clock:     message.Clock,
+	}
+	if group.MemberIDs == nil || group.Deleted {
+		group.MemberIDs = []string{}
+	}
+
+	err = m.persistence.SaveContactGroup(group)
+	if err != nil {
+		return err
+	}
+
+	state.Response.AddContactGroup(group)
+	return nil
+}
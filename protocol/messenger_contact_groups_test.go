@@ -0,0 +1,158 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func TestMessengerContactGroupsSuite(t *testing.T) {
+	suite.Run(t, new(MessengerContactGroupsSuite))
+}
+
+type MessengerContactGroupsSuite struct {
+	MessengerBaseTestSuite
+}
+
+// waitForContactGroup waits until the device receives the synced group
+func (s *MessengerContactGroupsSuite) waitForContactGroup(m *Messenger, condition func(*ContactGroup) bool) *ContactGroup {
+	var group *ContactGroup
+	_, err := WaitOnMessengerResponse(
+		m,
+		func(r *MessengerResponse) bool {
+			for _, g := range r.ContactGroups() {
+				if condition(g) {
+					group = g
+					return true
+				}
+			}
+			return false
+		},
+		"contact group not synced",
+	)
+	s.Require().NoError(err)
+	return group
+}
+
+func (s *MessengerContactGroupsSuite) TestContactGroups() {
+	alice := s.m
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	charlie := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, charlie)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+	charlieID := contactIDFromPublicKey(&charlie.identity.PublicKey)
+
+	_, err := alice.CreateContactGroup(context.Background(), &requests.CreateContactGroup{})
+	s.Require().ErrorIs(err, requests.ErrCreateContactGroupInvalidName)
+
+	// Only known contacts can be added
+	_, err = alice.CreateContactGroup(context.Background(), &requests.CreateContactGroup{Name: "Work", MemberIDs: []string{charlieID}})
+	s.Require().ErrorIs(err, ErrContactNotFound)
+
+	response, err := alice.CreateContactGroup(context.Background(), &requests.CreateContactGroup{Name: "Work", MemberIDs: []string{bobID, bobID}})
+	s.Require().NoError(err)
+	s.Require().Len(response.ContactGroups(), 1)
+	group := response.ContactGroups()[0]
+	s.Require().NotEmpty(group.ID)
+	s.Require().Equal([]string{bobID}, group.MemberIDs)
+
+	contacts, err := alice.ContactsByGroup(group.ID)
+	s.Require().NoError(err)
+	s.Require().Len(contacts, 1)
+	s.Require().Equal(bobID, contacts[0].ID)
+
+	s.Require().NoError(makeMutualContact(alice, &charlie.identity.PublicKey))
+	response, err = alice.AddContactsToGroup(context.Background(), group.ID, []string{charlieID, bobID})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{bobID, charlieID}, response.ContactGroups()[0].MemberIDs)
+
+	response, err = alice.RemoveContactsFromGroup(context.Background(), group.ID, []string{bobID})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{charlieID}, response.ContactGroups()[0].MemberIDs)
+
+	response, err = alice.MuteContactGroup(context.Background(), group.ID, true)
+	s.Require().NoError(err)
+	s.Require().True(response.ContactGroups()[0].Muted)
+
+	muted, err := alice.persistence.MutedContactGroupMembers()
+	s.Require().NoError(err)
+	s.Require().True(muted[charlieID])
+	s.Require().False(muted[bobID])
+
+	// The group members are the recipients of the group chat
+	response, err = alice.CreateGroupChatWithContactGroup(context.Background(), "work chat", group.ID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	s.Require().True(response.Chats()[0].HasMember(charlieID))
+	s.Require().False(response.Chats()[0].HasMember(bobID))
+
+	_, err = alice.DeleteContactGroup(context.Background(), group.ID)
+	s.Require().NoError(err)
+
+	groups, err := alice.ContactGroups()
+	s.Require().NoError(err)
+	s.Require().Empty(groups)
+
+	_, err = alice.ContactsByGroup(group.ID)
+	s.Require().ErrorIs(err, ErrContactGroupNotFound)
+}
+
+func (s *MessengerContactGroupsSuite) TestSyncContactGroups() {
+	alice1 := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+
+	s.Require().NoError(makeMutualContact(alice1, &bob.identity.PublicKey))
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+
+	response, err := alice1.CreateContactGroup(context.Background(), &requests.CreateContactGroup{Name: "Family", Color: "#ff0000", MemberIDs: []string{bobID}})
+	s.Require().NoError(err)
+	family := response.ContactGroups()[0]
+
+	// Existing groups are synced when pairing
+	alice2, err := newMessengerWithKey(s.shh, alice1.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, alice2)
+
+	prepareAliceMessengersForPairing(&s.Suite, alice1, alice2)
+	PairDevices(&s.Suite, alice2, alice1)
+	PairDevices(&s.Suite, alice1, alice2)
+
+	s.Require().NoError(alice1.SyncDevices(context.Background(), "ens-name", "profile-image", nil))
+
+	synced := s.waitForContactGroup(alice2, func(g *ContactGroup) bool { return g.ID == family.ID })
+	s.Require().Equal(family.Name, synced.Name)
+	s.Require().Equal(family.Color, synced.Color)
+	s.Require().Equal([]string{bobID}, synced.MemberIDs)
+
+	// Updates are synced
+	_, err = alice1.EditContactGroup(context.Background(), &requests.EditContactGroup{ID: family.ID, Name: "Relatives", MemberIDs: []string{}})
+	s.Require().NoError(err)
+
+	synced = s.waitForContactGroup(alice2, func(g *ContactGroup) bool { return g.Name == "Relatives" })
+	s.Require().Empty(synced.MemberIDs)
+
+	// Older updates are ignored
+	state := alice2.buildMessageState()
+	s.Require().NoError(alice2.HandleSyncContactGroup(state, &protobuf.SyncContactGroup{Id: family.ID, Name: family.Name, Clock: family.Clock}, nil))
+	s.Require().Empty(state.Response.ContactGroups())
+	group, err := alice2.persistence.ContactGroup(family.ID)
+	s.Require().NoError(err)
+	s.Require().Equal("Relatives", group.Name)
+
+	_, err = alice1.DeleteContactGroup(context.Background(), family.ID)
+	s.Require().NoError(err)
+
+	s.waitForContactGroup(alice2, func(g *ContactGroup) bool { return g.Deleted })
+	groups, err := alice2.ContactGroups()
+	s.Require().NoError(err)
+	s.Require().Empty(groups)
+}
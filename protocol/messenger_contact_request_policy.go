@@ -0,0 +1,342 @@
+package protocol
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/ens"
+)
+
+const (
+	// contactRequestStampDifficulty is the number of leading zero bits required for a
+	// proof of work stamp. It takes about a million hashes, a fraction of a second on a phone,
+	// which a user pays once per request as the stamp is cached, while a bulk sender pays it
+	// for each recipient
+	contactRequestStampDifficulty = 20
+	// maxContactRequestsPerSender is the number of contact requests a sender
+	// can send within contactRequestsWindow before being auto-declined,
+	// the auto-decline lapses along with the window
+	maxContactRequestsPerSender = 3
+	contactRequestsWindow       = 24 * time.Hour
+	// maxHeldContactRequests bounds the contact requests waiting for the ENS name of their sender to be verified
+	maxHeldContactRequests = 100
+)
+
+// ContactRequestsPolicy restricts who can send us a contact request.
+// A request is accepted if it fulfills any of the enabled requirements,
+// requests from anyone are accepted if none is enabled.
+type ContactRequestsPolicy struct {
+	// SharedCommunity accepts requests from members of a community we joined
+	SharedCommunity bool `json:"sharedCommunity"`
+	// VerifiedENS accepts requests from senders with a verified ENS name
+	VerifiedENS bool `json:"verifiedEns"`
+	// ProofOfWork accepts requests with a valid proof of work stamp
+	ProofOfWork bool `json:"proofOfWork"`
+}
+
+func (p ContactRequestsPolicy) restricted() bool {
+	return p.SharedCommunity || p.VerifiedENS || p.ProofOfWork
+}
+
+type contactRequestVerdict int
+
+const (
+	contactRequestAccepted contactRequestVerdict = iota
+	// contactRequestRejected requests don't fulfill the policy and are dropped
+	contactRequestRejected
+	// contactRequestDeclined requests come from a bulk sender and are dismissed
+	contactRequestDeclined
+	// contactRequestHeld requests wait for the ENS name of the sender to be verified
+	contactRequestHeld
+)
+
+func contactRequestStampPrefix(from, to *ecdsa.PublicKey, clock uint64) []byte {
+	prefix := append(crypto.CompressPubkey(from), crypto.CompressPubkey(to)...)
+	return binary.BigEndian.AppendUint64(prefix, clock)
+}
+
+func contactRequestStampValid(prefix []byte, nonce uint64) bool {
+	hash := sha256.Sum256(binary.BigEndian.AppendUint64(prefix, nonce))
+	zeros := 0
+	for _, b := range hash {
+		zeros += bits.LeadingZeros8(b)
+		if b != 0 {
+			break
+		}
+	}
+	return zeros >= contactRequestStampDifficulty
+}
+
+// computeContactRequestStamp returns a nonce which binds the contact request
+// sent at clock from a sender to a recipient with a proof of work
+func computeContactRequestStamp(from, to *ecdsa.PublicKey, clock uint64) uint64 {
+	prefix := contactRequestStampPrefix(from, to, clock)
+	// Stamps are compared to 0 when missing, so the search starts at 1
+	for nonce := uint64(1); ; nonce++ {
+		if contactRequestStampValid(prefix[:len(prefix):len(prefix)], nonce) {
+			return nonce
+		}
+	}
+}
+
+func verifyContactRequestStamp(from, to *ecdsa.PublicKey, clock uint64, stamp uint64) bool {
+	return stamp != 0 && contactRequestStampValid(contactRequestStampPrefix(from, to, clock), stamp)
+}
+
+// cachedContactRequestStamp is the stamp computed for the contact request sent at clock
+type cachedContactRequestStamp struct {
+	clock uint64
+	nonce uint64
+}
+
+// contactRequestStamp returns the proof of work stamp for the contact request we sent to the contact
+func (m *Messenger) contactRequestStamp(contact *Contact) (uint64, error) {
+	if !contact.added() || contact.mutual() {
+		return 0, nil
+	}
+
+	clock := contact.ContactRequestLocalClock
+	m.contactRequestsMutex.Lock()
+	cached, ok := m.contactRequestStamps[contact.ID]
+	m.contactRequestsMutex.Unlock()
+	if ok && cached.clock == clock {
+		return cached.nonce, nil
+	}
+
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return 0, err
+	}
+	nonce := computeContactRequestStamp(&m.identity.PublicKey, publicKey, clock)
+
+	m.contactRequestsMutex.Lock()
+	m.contactRequestStamps[contact.ID] = cachedContactRequestStamp{clock: clock, nonce: nonce}
+	m.contactRequestsMutex.Unlock()
+	return nonce, nil
+}
+
+// SetContactRequestsPolicy sets who can send us a contact request, the policy is synced with paired devices
+func (m *Messenger) SetContactRequestsPolicy(policy ContactRequestsPolicy) error {
+	return m.settings.SaveSettingField(settings.ContactRequestsPolicy, policy)
+}
+
+func (m *Messenger) GetContactRequestsPolicy() (ContactRequestsPolicy, error) {
+	var policy ContactRequestsPolicy
+	err := m.settings.GetContactRequestsPolicy(&policy)
+	return policy, err
+}
+
+// GetAutoDeclinedContactRequestSenders returns the senders whose contact requests
+// were declined for sending too many of them
+func (m *Messenger) GetAutoDeclinedContactRequestSenders() ([]string, error) {
+	return m.persistence.AutoDeclinedContactRequestSenders()
+}
+
+// ResetContactRequestSender lifts the auto-decline and resets the contact requests count of the sender
+func (m *Messenger) ResetContactRequestSender(contactID string) error {
+	return m.persistence.DeleteContactRequestSender(contactID)
+}
+
+// isNewIncomingContactRequest returns whether a contact request sent at clock would be a new request
+func isNewIncomingContactRequest(contact *Contact, clock uint64) bool {
+	return !contact.mutual() && !contact.dismissed() &&
+		contact.ContactRequestRemoteState == ContactRequestStateNone &&
+		clock > contact.ContactRequestRemoteClock
+}
+
+// resetContactRequestsWindow starts a new window once contactRequestsWindow elapsed,
+// the requests are counted again and the auto-decline lapses
+func resetContactRequestsWindow(sender *contactRequestSender, now uint64) bool {
+	if now-sender.WindowStart <= uint64(contactRequestsWindow.Milliseconds()) {
+		return false
+	}
+	sender.WindowStart = now
+	sender.Requests = 0
+	sender.AutoDeclined = false
+	return true
+}
+
+// liftLapsedContactRequestDecline undoes the dismissal of the contact requests of an auto-declined
+// sender once the decline lapsed, so that a new request of the sender is checked again
+func (m *Messenger) liftLapsedContactRequestDecline(state *ReceivedMessageState, contact *Contact) error {
+	if !contact.dismissed() || contact.added() {
+		return nil
+	}
+
+	sender, err := m.persistence.ContactRequestSender(contact.ID)
+	if err != nil {
+		return err
+	}
+	if !sender.AutoDeclined || !resetContactRequestsWindow(sender, m.GetCurrentTimeInMillis()) {
+		return nil
+	}
+
+	err = m.persistence.SaveContactRequestSender(sender)
+	if err != nil {
+		return err
+	}
+
+	m.logger.Info("auto-decline of contact requests lapsed", zap.String("contactID", contact.ID))
+	contact.ContactRequestLocalState = ContactRequestStateNone
+	contact.ContactRequestRemoteState = ContactRequestStateNone
+	state.ModifiedContacts.Store(contact.ID, true)
+	state.AllContacts.Store(contact.ID, contact)
+	return nil
+}
+
+// checkIncomingContactRequest rate limits the contact requests of the sender,
+// and checks the request sent at clock against the contact requests policy.
+// ensName is the name the request was sent with, the request is held while it's being verified
+func (m *Messenger) checkIncomingContactRequest(contact *Contact, clock uint64, stamp uint64, ensName string) (contactRequestVerdict, error) {
+	// Requests from contacts we sent a request to are accepting ours
+	if contact.added() {
+		return contactRequestAccepted, nil
+	}
+
+	sender, err := m.persistence.ContactRequestSender(contact.ID)
+	if err != nil {
+		return contactRequestAccepted, err
+	}
+	resetContactRequestsWindow(sender, m.GetCurrentTimeInMillis())
+	if sender.AutoDeclined {
+		return contactRequestDeclined, nil
+	}
+
+	if clock > sender.LastRequestClock {
+		sender.Requests++
+		sender.LastRequestClock = clock
+		sender.AutoDeclined = sender.Requests > maxContactRequestsPerSender
+
+		err = m.persistence.SaveContactRequestSender(sender)
+		if err != nil {
+			return contactRequestAccepted, err
+		}
+		if sender.AutoDeclined {
+			m.logger.Info("auto-declining contact requests from bulk sender", zap.String("contactID", contact.ID))
+			return contactRequestDeclined, nil
+		}
+	}
+
+	policy, err := m.GetContactRequestsPolicy()
+	if err != nil {
+		return contactRequestAccepted, err
+	}
+	if !policy.restricted() {
+		return contactRequestAccepted, nil
+	}
+
+	publicKey, err := contact.PublicKey()
+	if err != nil {
+		return contactRequestAccepted, err
+	}
+
+	if policy.ProofOfWork && verifyContactRequestStamp(publicKey, &m.identity.PublicKey, clock, stamp) {
+		return contactRequestAccepted, nil
+	}
+
+	ensPending := false
+	if policy.VerifiedENS {
+		verified := contact.ENSVerified && contact.EnsName != ""
+		if !verified {
+			record, err := m.ensVerifier.GetVerifiedRecord(contact.ID)
+			if err != nil {
+				return contactRequestAccepted, err
+			}
+			verified = record != nil
+		}
+		if verified {
+			return contactRequestAccepted, nil
+		}
+		ensPending = ensName != ""
+	}
+
+	if policy.SharedCommunity {
+		joined, err := m.communitiesManager.Joined()
+		if err != nil {
+			return contactRequestAccepted, err
+		}
+		for _, community := range joined {
+			if community.HasMember(publicKey) {
+				return contactRequestAccepted, nil
+			}
+		}
+	}
+
+	if ensPending {
+		return contactRequestHeld, nil
+	}
+
+	m.logger.Info("rejecting contact request not fulfilling the policy", zap.String("contactID", contact.ID))
+	return contactRequestRejected, nil
+}
+
+// holdContactRequest keeps the message carrying a contact request until the verify loop
+// resolved the ENS name of the sender, only the latest request of a sender is kept
+func (m *Messenger) holdContactRequest(message *CurrentMessageState) {
+	m.contactRequestsMutex.Lock()
+	defer m.contactRequestsMutex.Unlock()
+
+	if _, ok := m.heldContactRequests[message.Contact.ID]; !ok && len(m.heldContactRequests) >= maxHeldContactRequests {
+		m.logger.Info("dropping contact request, too many held", zap.String("contactID", message.Contact.ID))
+		return
+	}
+	m.heldContactRequests[message.Contact.ID] = message
+}
+
+// handleHeldContactRequests handles again the contact requests held for the ENS names
+// the verify loop resolved, the requests whose name turned out invalid are dropped
+func (m *Messenger) handleHeldContactRequests(records []*ens.VerificationRecord) {
+	var held []*CurrentMessageState
+	m.contactRequestsMutex.Lock()
+	for _, record := range records {
+		message, ok := m.heldContactRequests[record.PublicKey]
+		if !ok {
+			continue
+		}
+		delete(m.heldContactRequests, record.PublicKey)
+		if record.Verified {
+			held = append(held, message)
+		}
+	}
+	m.contactRequestsMutex.Unlock()
+
+	if len(held) == 0 {
+		return
+	}
+
+	m.handleMessagesMutex.Lock()
+	defer m.handleMessagesMutex.Unlock()
+
+	state := m.buildMessageState()
+	for _, message := range held {
+		if contact, ok := m.allContacts.Load(message.Contact.ID); ok {
+			message.Contact = contact
+		}
+		state.CurrentMessageState = message
+		if err := m.handleChatMessage(state, false); err != nil {
+			m.logger.Warn("failed to handle held contact request", zap.String("contactID", message.Contact.ID), zap.Error(err))
+		}
+	}
+
+	response, err := m.saveDataAndPrepareResponse(state)
+	if err != nil {
+		m.logger.Error("failed to save held contact requests", zap.Error(err))
+		return
+	}
+	m.PublishMessengerResponse(response)
+}
+
+// declineIncomingContactRequest records the contact request sent at clock as received and dismisses it
+func (m *Messenger) declineIncomingContactRequest(state *ReceivedMessageState, contact *Contact, clock uint64) {
+	contact.ContactRequestReceived(clock)
+	contact.DismissContactRequest(m.getTimesource().GetCurrentTime())
+	state.ModifiedContacts.Store(contact.ID, true)
+	state.AllContacts.Store(contact.ID, contact)
+}
@@ -0,0 +1,212 @@
+package protocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/ens"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+)
+
+func TestContactRequestStamp(t *testing.T) {
+	alice, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bob, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	stamp := computeContactRequestStamp(&alice.PublicKey, &bob.PublicKey, 10)
+	require.True(t, verifyContactRequestStamp(&alice.PublicKey, &bob.PublicKey, 10, stamp))
+
+	// The stamp is bound to the sender, the recipient and the request clock
+	require.False(t, verifyContactRequestStamp(&bob.PublicKey, &alice.PublicKey, 10, stamp))
+	require.False(t, verifyContactRequestStamp(&alice.PublicKey, &bob.PublicKey, 11, stamp))
+	require.False(t, verifyContactRequestStamp(&alice.PublicKey, &bob.PublicKey, 10, 0))
+}
+
+func TestMessengerContactRequestPolicySuite(t *testing.T) {
+	suite.Run(t, new(MessengerContactRequestPolicySuite))
+}
+
+type MessengerContactRequestPolicySuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerContactRequestPolicySuite) sendContactRequest(from *Messenger, to *Messenger, text string) {
+	_, err := from.SendContactRequest(context.Background(), &requests.SendContactRequest{
+		ID:      types.EncodeHex(crypto.FromECDSAPub(&to.identity.PublicKey)),
+		Message: text,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerContactRequestPolicySuite) receivedContactRequest(r *MessengerResponse, text string) bool {
+	for _, message := range r.Messages() {
+		if message.ContentType == protobuf.ChatMessage_CONTACT_REQUEST && message.Text == text {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *MessengerContactRequestPolicySuite) TestPolicy() {
+	bob := s.m
+	s.Require().NoError(bob.SetContactRequestsPolicy(ContactRequestsPolicy{VerifiedENS: true}))
+
+	policy, err := bob.GetContactRequestsPolicy()
+	s.Require().NoError(err)
+	s.Require().Equal(ContactRequestsPolicy{VerifiedENS: true}, policy)
+
+	// Alice has no ENS name, her request is dropped
+	alice := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, alice)
+	s.sendContactRequest(alice, bob, "hey")
+
+	_, err = WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool {
+			return s.receivedContactRequest(r, "hey")
+		},
+		"no contact request",
+	)
+	s.Require().Error(err)
+
+	// Requests come with a proof of work stamp
+	s.Require().NoError(bob.SetContactRequestsPolicy(ContactRequestsPolicy{VerifiedENS: true, ProofOfWork: true}))
+
+	charlie := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, charlie)
+	s.sendContactRequest(charlie, bob, "hello")
+
+	resp, err := WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool {
+			return s.receivedContactRequest(r, "hello")
+		},
+		"no contact request",
+	)
+	s.Require().NoError(err)
+	s.Require().Len(resp.ActivityCenterNotifications(), 1)
+	s.Require().Equal(ActivityCenterNotificationTypeContactRequest, resp.ActivityCenterNotifications()[0].Type)
+}
+
+func (s *MessengerContactRequestPolicySuite) TestHoldRequestUntilENSVerified() {
+	bob := s.m
+	s.Require().NoError(bob.SetContactRequestsPolicy(ContactRequestsPolicy{VerifiedENS: true}))
+
+	receiveContactRequest := func(ensName string) (*Contact, string) {
+		key, err := crypto.GenerateKey()
+		s.Require().NoError(err)
+		contact, err := buildContact(types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey)), &key.PublicKey)
+		s.Require().NoError(err)
+		bob.allContacts.Store(contact.ID, contact)
+
+		now := bob.GetCurrentTimeInMillis()
+		messageID := types.EncodeHex(crypto.Keccak256([]byte(contact.ID)))
+		state := bob.buildMessageState()
+		state.CurrentMessageState = &CurrentMessageState{
+			Message: &protobuf.ChatMessage{
+				Clock:       now,
+				Timestamp:   now,
+				Text:        "hi",
+				ChatId:      bob.myHexIdentity(),
+				EnsName:     ensName,
+				MessageType: protobuf.MessageType_ONE_TO_ONE,
+				ContentType: protobuf.ChatMessage_CONTACT_REQUEST,
+			},
+			MessageID:        messageID,
+			WhisperTimestamp: now,
+			Contact:          contact,
+			PublicKey:        &key.PublicKey,
+		}
+		s.Require().NoError(bob.handleChatMessage(state, false))
+		s.Require().Empty(state.Response.Messages())
+		return contact, messageID
+	}
+
+	// The request is held while the name is being verified
+	alice, aliceRequestID := receiveContactRequest("alice.stateofus.eth")
+	s.Require().Contains(bob.heldContactRequests, alice.ID)
+
+	// A name which turned out invalid drops the request
+	mallory, _ := receiveContactRequest("mallory.stateofus.eth")
+	bob.handleENSVerified([]*ens.VerificationRecord{{PublicKey: mallory.ID, Name: "mallory.stateofus.eth"}})
+	s.Require().NotContains(bob.heldContactRequests, mallory.ID)
+	s.Require().Equal(ContactRequestStateNone, mallory.ContactRequestRemoteState)
+
+	// Once verified, the request is accepted
+	bob.handleENSVerified([]*ens.VerificationRecord{{PublicKey: alice.ID, Name: "alice.stateofus.eth", Verified: true}})
+	s.Require().NotContains(bob.heldContactRequests, alice.ID)
+	s.Require().Equal(ContactRequestStateReceived, alice.ContactRequestRemoteState)
+
+	message, err := bob.persistence.MessageByID(aliceRequestID)
+	s.Require().NoError(err)
+	s.Require().Equal(protobuf.ChatMessage_CONTACT_REQUEST, message.ContentType)
+	s.Require().Equal(alice.ID, message.From)
+}
+
+func (s *MessengerContactRequestPolicySuite) TestAutoDeclineBulkSender() {
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	contact, err := buildContact(types.EncodeHex(crypto.FromECDSAPub(&key.PublicKey)), &key.PublicKey)
+	s.Require().NoError(err)
+
+	for clock := uint64(1); clock <= maxContactRequestsPerSender; clock++ {
+		verdict, err := s.m.checkIncomingContactRequest(contact, clock, 0, "")
+		s.Require().NoError(err)
+		s.Require().Equal(contactRequestAccepted, verdict)
+	}
+
+	// Requests handled twice are not counted
+	verdict, err := s.m.checkIncomingContactRequest(contact, maxContactRequestsPerSender, 0, "")
+	s.Require().NoError(err)
+	s.Require().Equal(contactRequestAccepted, verdict)
+
+	verdict, err = s.m.checkIncomingContactRequest(contact, maxContactRequestsPerSender+1, 0, "")
+	s.Require().NoError(err)
+	s.Require().Equal(contactRequestDeclined, verdict)
+
+	// Once declined, all the requests of the sender are declined
+	verdict, err = s.m.checkIncomingContactRequest(contact, 1, 0, "")
+	s.Require().NoError(err)
+	s.Require().Equal(contactRequestDeclined, verdict)
+
+	declined, err := s.m.GetAutoDeclinedContactRequestSenders()
+	s.Require().NoError(err)
+	s.Require().Equal([]string{contact.ID}, declined)
+
+	s.Require().NoError(s.m.ResetContactRequestSender(contact.ID))
+	verdict, err = s.m.checkIncomingContactRequest(contact, maxContactRequestsPerSender+2, 0, "")
+	s.Require().NoError(err)
+	s.Require().Equal(contactRequestAccepted, verdict)
+
+	// A declined request is dismissed
+	state := &ReceivedMessageState{
+		ModifiedContacts: new(stringBoolMap),
+		AllContacts:      s.m.allContacts,
+	}
+	s.m.declineIncomingContactRequest(state, contact, maxContactRequestsPerSender+3)
+	s.Require().True(contact.dismissed())
+	s.Require().Equal(ContactRequestStateReceived, contact.ContactRequestRemoteState)
+	s.Require().False(isNewIncomingContactRequest(contact, maxContactRequestsPerSender+4))
+
+	// The auto-decline lapses along with the window
+	sender, err := s.m.persistence.ContactRequestSender(contact.ID)
+	s.Require().NoError(err)
+	sender.AutoDeclined = true
+	sender.WindowStart = s.m.GetCurrentTimeInMillis() - uint64(contactRequestsWindow.Milliseconds()) - 1
+	s.Require().NoError(s.m.persistence.SaveContactRequestSender(sender))
+
+	s.Require().NoError(s.m.liftLapsedContactRequestDecline(state, contact))
+	s.Require().False(contact.dismissed())
+	s.Require().True(isNewIncomingContactRequest(contact, maxContactRequestsPerSender+4))
+
+	verdict, err = s.m.checkIncomingContactRequest(contact, maxContactRequestsPerSender+4, 0, "")
+	s.Require().NoError(err)
+	s.Require().Equal(contactRequestAccepted, verdict)
+}
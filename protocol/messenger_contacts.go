@@ -441,6 +441,12 @@ func (m *Messenger) addContact(ctx context.Context,
 		return nil, err
 	}
 
+	// We trust the contacts we add, their past contact requests don't count towards the rate limit
+	err = m.persistence.DeleteContactRequestSender(contact.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(samyoul) remove storing of an updated reference pointer?
 	m.allContacts.Store(contact.ID, contact)
 
@@ -1106,6 +1112,11 @@ func (m *Messenger) sendContactUpdate(ctx context.Context,
 		return nil, err
 	}
 
+	stamp, err := m.contactRequestStamp(contact)
+	if err != nil {
+		return nil, err
+	}
+
 	contactUpdate := &protobuf.ContactUpdate{
 		Clock:                         clock,
 		DisplayName:                   displayName,
@@ -1115,6 +1126,7 @@ func (m *Messenger) sendContactUpdate(ctx context.Context,
 		ContactRequestPropagatedState: contact.ContactRequestPropagatedState(),
 		PublicKey:                     contact.ID,
 		CustomizationColor:            multiaccountscommon.ColorToIDFallbackToBlue(customizationColor),
+		ContactRequestStamp:           stamp,
 	}
 
 	encodedMessage, err := proto.Marshal(contactUpdate)
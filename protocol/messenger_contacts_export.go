@@ -0,0 +1,297 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/verification"
+)
+
+const (
+	contactsExportVersion = 1
+
+	// Contact requests to imported contacts are sent in the background, at most one
+	// every importedContactRequestsRate after the first importedContactRequestsBurst
+	importedContactRequestsRate  = 10 * time.Second
+	importedContactRequestsBurst = 5
+
+	// importedContactImagesClock is lower than the clock of any identity update, so
+	// that the images sent by the contacts replace the imported ones
+	importedContactImagesClock = 1
+)
+
+var (
+	ErrInvalidContactsExport            = errors.New("invalid contacts export")
+	ErrUnsupportedContactsExportVersion = errors.New("unsupported contacts export version")
+)
+
+// ImportedContacts is the result of importing a contacts export
+type ImportedContacts struct {
+	// Signer is the public key of the identity which exported the contacts
+	Signer   string     `json:"signer"`
+	Contacts []*Contact `json:"contacts"`
+	// Skipped are the blocked, own or invalid public keys of the export
+	Skipped []string `json:"skipped"`
+}
+
+// ExportContacts returns the selected contacts in a file signed by the identity of the user
+func (m *Messenger) ExportContacts(request *requests.ExportContacts) (types.HexBytes, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle := &protobuf.ContactsExportBundle{
+		Timestamp: m.getTimesource().GetCurrentTime(),
+	}
+	for _, contactID := range request.ContactIDs {
+		contact, ok := m.allContacts.Load(contactID)
+		if !ok {
+			return nil, ErrContactNotFound
+		}
+
+		trustStatus, err := m.verificationDatabase.GetTrustStatus(contactID)
+		if err != nil {
+			return nil, err
+		}
+
+		exported := &protobuf.ExportedContact{
+			PublicKey:          contact.ID,
+			LocalNickname:      contact.LocalNickname,
+			DisplayName:        contact.DisplayName,
+			TrustStatus:        uint32(trustStatus),
+			VerificationStatus: uint32(contact.VerificationStatus),
+		}
+		if contact.ENSVerified {
+			exported.EnsName = contact.EnsName
+		}
+		for imageType, image := range contact.Images {
+			exported.Images = append(exported.Images, &protobuf.ExportedContactImage{
+				Type:    imageType,
+				Payload: image.Payload,
+			})
+		}
+		bundle.Contacts = append(bundle.Contacts, exported)
+	}
+
+	marshaledBundle, err := proto.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(crypto.Keccak256(marshaledBundle), m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(&protobuf.ContactsExport{
+		Version:   contactsExportVersion,
+		Bundle:    marshaledBundle,
+		Signature: signature,
+	})
+}
+
+// decodeContactsExport verifies the signature of the export and returns its signer
+func decodeContactsExport(data []byte) (*protobuf.ContactsExportBundle, string, error) {
+	export := &protobuf.ContactsExport{}
+	err := proto.Unmarshal(data, export)
+	if err != nil {
+		return nil, "", ErrInvalidContactsExport
+	}
+
+	if export.Version != contactsExportVersion {
+		return nil, "", ErrUnsupportedContactsExportVersion
+	}
+
+	signer, err := crypto.SigToPub(crypto.Keccak256(export.Bundle), export.Signature)
+	if err != nil {
+		return nil, "", ErrInvalidContactsExport
+	}
+
+	bundle := &protobuf.ContactsExportBundle{}
+	err = proto.Unmarshal(export.Bundle, bundle)
+	if err != nil {
+		return nil, "", ErrInvalidContactsExport
+	}
+
+	return bundle, common.PubkeyToHex(signer), nil
+}
+
+// ImportContacts creates the contacts of an export, local nicknames, ENS names and
+// profile images are only used when the existing contacts don't have any.
+// Trust and verification statuses are imported only from the exports of the user's
+// own identity, as they are the judgement of the signer.
+func (m *Messenger) ImportContacts(ctx context.Context, request *requests.ImportContacts) (*ImportedContacts, error) {
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	bundle, signer, err := decodeContactsExport(request.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	ownID := contactIDFromPublicKey(&m.identity.PublicKey)
+	ownExport := signer == ownID
+
+	result := &ImportedContacts{
+		Signer:   signer,
+		Contacts: []*Contact{},
+		Skipped:  []string{},
+	}
+	var contactRequestIDs []string
+
+	for _, exported := range bundle.Contacts {
+		existing, ok := m.allContacts.Load(exported.PublicKey)
+		if exported.PublicKey == ownID || (ok && existing.Blocked) {
+			result.Skipped = append(result.Skipped, exported.PublicKey)
+			continue
+		}
+
+		contact, err := m.importContact(ctx, exported, ownExport)
+		if errors.Is(err, errInvalidImportedContact) {
+			result.Skipped = append(result.Skipped, exported.PublicKey)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Contacts = append(result.Contacts, contact)
+
+		if request.SendContactRequests && !contact.mutual() && contact.ContactRequestLocalState != ContactRequestStateSent {
+			contactRequestIDs = append(contactRequestIDs, contact.ID)
+		}
+	}
+
+	if len(contactRequestIDs) > 0 {
+		m.sendImportedContactRequests(contactRequestIDs, request.Message)
+	}
+
+	return result, nil
+}
+
+var errInvalidImportedContact = errors.New("invalid imported contact")
+
+func (m *Messenger) importContact(ctx context.Context, exported *protobuf.ExportedContact, ownExport bool) (*Contact, error) {
+	contact, err := m.BuildContact(&requests.BuildContact{PublicKey: exported.PublicKey})
+	if err != nil {
+		return nil, errInvalidImportedContact
+	}
+
+	clock := m.getTimesource().GetCurrentTime()
+
+	if contact.LocalNickname == "" {
+		contact.LocalNickname = exported.LocalNickname
+	}
+	if contact.DisplayName == "" {
+		contact.DisplayName = exported.DisplayName
+	}
+	if exported.EnsName != "" && !contact.ENSVerified {
+		if ownExport {
+			// The user only exports names which were verified
+			err = m.ensVerifier.ENSVerified(contact.ID, exported.EnsName, clock)
+			if err != nil {
+				return nil, err
+			}
+			if err = m.addENSNameToContact(contact); err != nil {
+				return nil, err
+			}
+		} else {
+			// The name is shown once the verify loop confirmed it on-chain
+			_, err = m.ensVerifier.Add(contact.ID, exported.EnsName, clock)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if ownExport {
+		contact.VerificationStatus = VerificationStatus(exported.VerificationStatus)
+	}
+	contact.LastUpdatedLocally = clock
+
+	err = m.persistence.SaveContact(contact, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contact.Images) == 0 && len(exported.Images) > 0 {
+		err = m.importContactImages(contact, exported.Images)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.allContacts.Store(contact.ID, contact)
+
+	err = m.syncContact(ctx, contact, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownExport && exported.TrustStatus != uint32(verification.TrustStatusUNKNOWN) {
+		err = m.setTrustStatusForContact(ctx, contact.ID, verification.TrustStatus(exported.TrustStatus))
+		if err != nil {
+			return nil, err
+		}
+		contact.TrustStatus = verification.TrustStatus(exported.TrustStatus)
+	}
+
+	return contact, nil
+}
+
+func (m *Messenger) importContactImages(contact *Contact, exportedImages []*protobuf.ExportedContactImage) error {
+	chatIdentity := &protobuf.ChatIdentity{
+		Clock:  importedContactImagesClock,
+		Images: make(map[string]*protobuf.IdentityImage),
+	}
+	for _, image := range exportedImages {
+		chatIdentity.Images[image.Type] = &protobuf.IdentityImage{Payload: image.Payload}
+	}
+
+	_, imagesUpdated, err := m.persistence.UpdateContactChatIdentity(contact.ID, chatIdentity)
+	if err != nil {
+		// Images are optional, the contact is imported without them
+		m.logger.Warn("failed to import contact images", zap.String("contactID", contact.ID), zap.Error(err))
+		return nil
+	}
+	if !imagesUpdated {
+		return nil
+	}
+
+	contact.Images = make(map[string]images.IdentityImage)
+	for imageType, image := range chatIdentity.Images {
+		contact.Images[imageType] = images.IdentityImage{Name: imageType, Payload: image.Payload, Clock: chatIdentity.Clock}
+	}
+	return nil
+}
+
+// sendImportedContactRequests sends the contact requests in the background, rate limited,
+// so that importing a large export doesn't flood the network
+func (m *Messenger) sendImportedContactRequests(contactIDs []string, message string) {
+	m.shutdownWaitGroup.Add(1)
+	go func() {
+		defer m.shutdownWaitGroup.Done()
+
+		for _, contactID := range contactIDs {
+			if err := m.importedContactRequestsLimiter.Wait(m.ctx); err != nil {
+				return
+			}
+
+			response, err := m.SendContactRequest(m.ctx, &requests.SendContactRequest{ID: contactID, Message: message})
+			if err != nil {
+				m.logger.Error("failed to send contact request to imported contact", zap.String("contactID", contactID), zap.Error(err))
+				continue
+			}
+			m.PublishMessengerResponse(response)
+		}
+	}()
+}
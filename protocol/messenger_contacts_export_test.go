@@ -0,0 +1,186 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/images"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/protocol/verification"
+)
+
+func TestMessengerContactsExportSuite(t *testing.T) {
+	suite.Run(t, new(MessengerContactsExportSuite))
+}
+
+type MessengerContactsExportSuite struct {
+	MessengerBaseTestSuite
+}
+
+// export returns bob and charlie's contacts, exported by alice
+func (s *MessengerContactsExportSuite) export(alice *Messenger, bobID, charlieID string) []byte {
+	bob, ok := alice.allContacts.Load(bobID)
+	s.Require().True(ok)
+	bob.LocalNickname = "Bobby"
+	bob.DisplayName = "bob"
+	bob.EnsName = "bob.stateofus.eth"
+	bob.ENSVerified = true
+	bob.Images = make(map[string]images.IdentityImage)
+	for _, image := range images.SampleIdentityImages() {
+		bob.Images[image.Name] = image
+	}
+	s.Require().NoError(alice.MarkAsTrusted(context.Background(), bobID))
+
+	_, err := alice.ExportContacts(&requests.ExportContacts{})
+	s.Require().ErrorIs(err, requests.ErrExportContactsNoContacts)
+
+	_, err = alice.ExportContacts(&requests.ExportContacts{ContactIDs: []string{"0x01"}})
+	s.Require().ErrorIs(err, ErrContactNotFound)
+
+	data, err := alice.ExportContacts(&requests.ExportContacts{ContactIDs: []string{bobID, charlieID}})
+	s.Require().NoError(err)
+	return data
+}
+
+func (s *MessengerContactsExportSuite) TestImportOwnExport() {
+	alice := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	charlie := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, charlie)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(alice, &charlie.identity.PublicKey))
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+	charlieID := contactIDFromPublicKey(&charlie.identity.PublicKey)
+
+	data := s.export(alice, bobID, charlieID)
+
+	// Alice migrates to a new device
+	alice2, err := newMessengerWithKey(s.shh, alice.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, alice2)
+
+	result, err := alice2.ImportContacts(context.Background(), &requests.ImportContacts{Data: data})
+	s.Require().NoError(err)
+	s.Require().Equal(contactIDFromPublicKey(&alice.identity.PublicKey), result.Signer)
+	s.Require().Len(result.Contacts, 2)
+	s.Require().Empty(result.Skipped)
+
+	contact := alice2.GetContactByID(bobID)
+	s.Require().NotNil(contact)
+	s.Require().Equal("Bobby", contact.LocalNickname)
+	s.Require().Equal("bob", contact.DisplayName)
+	s.Require().Equal("bob.stateofus.eth", contact.EnsName)
+	s.Require().True(contact.ENSVerified)
+	s.Require().Len(contact.Images, len(images.SampleIdentityImages()))
+	s.Require().Equal(verification.TrustStatusTRUSTED, contact.TrustStatus)
+
+	trustStatus, err := alice2.GetTrustStatus(bobID)
+	s.Require().NoError(err)
+	s.Require().Equal(verification.TrustStatusTRUSTED, trustStatus)
+
+	// Images are persisted
+	contacts, err := alice2.persistence.Contacts()
+	s.Require().NoError(err)
+	for _, c := range contacts {
+		if c.ID == bobID {
+			s.Require().Len(c.Images, len(images.SampleIdentityImages()))
+		}
+	}
+}
+
+func (s *MessengerContactsExportSuite) TestImportOtherExport() {
+	alice := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	charlie := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, charlie)
+	dave := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, dave)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(alice, &charlie.identity.PublicKey))
+	bobID := contactIDFromPublicKey(&bob.identity.PublicKey)
+	charlieID := contactIDFromPublicKey(&charlie.identity.PublicKey)
+
+	data := s.export(alice, bobID, charlieID)
+
+	// Dave knows Bob with another nickname and blocked Charlie
+	_, err := dave.SetContactLocalNickname(&requests.SetContactLocalNickname{ID: crypto.FromECDSAPub(&bob.identity.PublicKey), Nickname: "B"})
+	s.Require().NoError(err)
+	_, err = dave.BlockContact(context.Background(), charlieID, false)
+	s.Require().NoError(err)
+
+	dave.importedContactRequestsLimiter = rate.NewLimiter(rate.Inf, 1)
+	result, err := dave.ImportContacts(context.Background(), &requests.ImportContacts{Data: data, SendContactRequests: true, Message: "hello"})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{charlieID}, result.Skipped)
+	s.Require().Len(result.Contacts, 1)
+
+	contact := result.Contacts[0]
+	s.Require().Equal(bobID, contact.ID)
+	s.Require().Equal("B", contact.LocalNickname)
+	// The trust status is the judgement of Alice
+	s.Require().Equal(verification.TrustStatusUNKNOWN, contact.TrustStatus)
+	// The ENS name isn't trusted until verified on-chain
+	s.Require().False(contact.ENSVerified)
+	s.Require().Empty(contact.EnsName)
+	ensRecord, err := dave.ensVerifier.GetVerifiedRecord(bobID)
+	s.Require().NoError(err)
+	s.Require().Nil(ensRecord)
+
+	err = tt.RetryWithBackOff(func() error {
+		contact := dave.GetContactByID(bobID)
+		if contact.ContactRequestLocalState != ContactRequestStateSent {
+			return errors.New("contact request not sent")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+}
+
+func (s *MessengerContactsExportSuite) TestImportInvalidExport() {
+	_, err := s.m.ImportContacts(context.Background(), &requests.ImportContacts{})
+	s.Require().ErrorIs(err, requests.ErrImportContactsInvalidData)
+
+	_, err = s.m.ImportContacts(context.Background(), &requests.ImportContacts{Data: []byte("data")})
+	s.Require().ErrorIs(err, ErrInvalidContactsExport)
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.Require().NoError(makeMutualContact(s.m, &key.PublicKey))
+
+	data, err := s.m.ExportContacts(&requests.ExportContacts{ContactIDs: []string{contactIDFromPublicKey(&key.PublicKey)}})
+	s.Require().NoError(err)
+
+	export := &protobuf.ContactsExport{}
+	s.Require().NoError(proto.Unmarshal(data, export))
+
+	// A tampered bundle isn't signed by the same identity anymore
+	bundle := &protobuf.ContactsExportBundle{}
+	s.Require().NoError(proto.Unmarshal(export.Bundle, bundle))
+	bundle.Contacts[0].LocalNickname = "tampered"
+	export.Bundle, err = proto.Marshal(bundle)
+	s.Require().NoError(err)
+	data, err = proto.Marshal(export)
+	s.Require().NoError(err)
+
+	result, err := s.m.ImportContacts(context.Background(), &requests.ImportContacts{Data: data})
+	s.Require().NoError(err)
+	s.Require().NotEqual(contactIDFromPublicKey(&s.m.identity.PublicKey), result.Signer)
+
+	export.Version = contactsExportVersion + 1
+	data, err = proto.Marshal(export)
+	s.Require().NoError(err)
+	_, err = s.m.ImportContacts(context.Background(), &requests.ImportContacts{Data: data})
+	s.Require().ErrorIs(err, ErrUnsupportedContactsExportVersion)
+}
@@ -0,0 +1,72 @@
+package protocol
+
+import (
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/transport"
+)
+
+// deliveryTimelineIntervalMs is how long we keep the delivery timeline of the messages we send, in ms
+var deliveryTimelineIntervalMs uint64 = 1000 * 60 * 60 * 24 * 7
+
+// MessageDeliveryDiagnostics describes what happened to a message we sent
+type MessageDeliveryDiagnostics struct {
+	MessageID string `json:"messageId"`
+	// OutgoingStatus is the status shown to the user, empty for messages which are not displayed
+	OutgoingStatus string `json:"outgoingStatus,omitempty"`
+	// Sent is whether the message is considered sent by the transport
+	Sent bool `json:"sent"`
+	// SendCount is the number of times the message has been sent, including resends
+	SendCount int    `json:"sendCount"`
+	LastSent  uint64 `json:"lastSent"`
+	// Timeline is the list of delivery events, oldest first
+	Timeline []*transport.DeliveryEvent `json:"timeline"`
+}
+
+// GetMessageDeliveryDiagnostics returns the delivery timeline of a message we sent,
+// along with its current sending state
+func (m *Messenger) GetMessageDeliveryDiagnostics(messageID string) (*MessageDeliveryDiagnostics, error) {
+	timeline, err := m.transport.DeliveryTimeline(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	diagnostics := &MessageDeliveryDiagnostics{
+		MessageID: messageID,
+		Timeline:  timeline,
+	}
+
+	rawMessage, err := m.persistence.RawMessageByID(messageID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrapf(err, "can't get raw message with id %v", messageID)
+	}
+	if rawMessage != nil {
+		diagnostics.Sent = rawMessage.Sent
+		diagnostics.SendCount = rawMessage.SendCount
+		diagnostics.LastSent = rawMessage.LastSent
+	}
+
+	message, err := m.persistence.MessageByID(messageID)
+	if err != nil && err != common.ErrRecordNotFound {
+		return nil, err
+	}
+	if message != nil {
+		diagnostics.OutgoingStatus = message.OutgoingStatus
+	}
+
+	if rawMessage == nil && message == nil && len(timeline) == 0 {
+		return nil, ErrMessageNotFound
+	}
+
+	return diagnostics, nil
+}
+
+func (m *Messenger) cleanDeliveryTimeline() {
+	if err := m.transport.CleanDeliveryTimeline(m.getTimesource().GetCurrentTime() - deliveryTimelineIntervalMs); err != nil {
+		m.logger.Error("failed to clean delivery timeline", zap.Error(err))
+	}
+}
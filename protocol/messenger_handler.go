@@ -1230,6 +1230,36 @@ func (m *Messenger) HandleContactUpdate(state *ReceivedMessageState, message *pr
 
 	logger.Debug("Handling contact update")
 
+	// The contact request is carried by the propagated state, or by the request clock for older clients
+	var requestClock uint64
+	if message.ContactRequestPropagatedState != nil {
+		if ContactRequestState(message.ContactRequestPropagatedState.LocalState) == ContactRequestStateSent {
+			requestClock = message.ContactRequestPropagatedState.LocalClock
+		}
+	} else if contact.LastUpdated < message.Clock {
+		requestClock = message.ContactRequestClock
+	}
+
+	if requestClock != 0 {
+		if err := m.liftLapsedContactRequestDecline(state, contact); err != nil {
+			return err
+		}
+	}
+	if requestClock != 0 && isNewIncomingContactRequest(contact, requestClock) {
+		verdict, err := m.checkIncomingContactRequest(contact, requestClock, message.ContactRequestStamp, "")
+		if err != nil {
+			return err
+		}
+		switch verdict {
+		case contactRequestRejected:
+			logger.Debug("dropping contact update with a rejected contact request")
+			return nil
+		case contactRequestDeclined:
+			m.declineIncomingContactRequest(state, contact, requestClock)
+			return nil
+		}
+	}
+
 	if message.ContactRequestPropagatedState != nil {
 		logger.Debug("handling contact request propagated state", zap.Any("state before update", contact.ContactRequestPropagatedState()))
 		result := contact.ContactRequestPropagatedStateReceived(message.ContactRequestPropagatedState)
@@ -1324,9 +1354,13 @@ func (m *Messenger) HandleSyncPairInstallation(state *ReceivedMessageState, mess
 	return nil
 }
 
-func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, magnetlink string, clock uint64) error {
+func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessageState, communityPubKey *ecdsa.PublicKey, locations []string, clock uint64) error {
 	id := types.HexBytes(crypto.CompressPubkey(communityPubKey))
 
+	if len(locations) == 0 {
+		return nil
+	}
+
 	community, err := m.communitiesManager.GetByID(id)
 	if err != nil && err != communities.ErrOrgNotFound {
 		m.logger.Debug("Couldn't get community for community with id: ", zap.Any("id", id))
@@ -1358,7 +1392,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 		// if it originates from a community that the current account is
 		// part of and doesn't own the private key at the same time
 		if !community.IsControlNode() && community.Joined() && clock >= lastClock {
-			if lastSeenMagnetlink == magnetlink {
+			if lastSeenMagnetlink == locations[0] {
 				m.logger.Debug("already processed this magnetlink")
 				return nil
 			}
@@ -1390,7 +1424,7 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 				// this wait groups tracks all ongoing tasks across communities
 				m.shutdownWaitGroup.Add(1)
 				defer m.shutdownWaitGroup.Done()
-				m.downloadAndImportHistoryArchives(communityID, magnetlink, task.CancelChan)
+				m.downloadAndImportHistoryArchives(communityID, locations, task.CancelChan)
 			}(currentTask, id)
 
 			return m.communitiesManager.UpdateMagnetlinkMessageClock(id, clock)
@@ -1399,13 +1433,13 @@ func (m *Messenger) HandleHistoryArchiveMagnetlinkMessage(state *ReceivedMessage
 	return nil
 }
 
-func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetlink string, cancel chan struct{}) {
-	downloadTaskInfo, err := m.archiveManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
+func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, locations []string, cancel chan struct{}) {
+	downloadTaskInfo, err := m.archiveManager.DownloadHistoryArchives(id, locations, cancel)
 	if err != nil {
 		logMsg := "failed to download history archive data"
-		if err == communities.ErrTorrentTimedout {
+		if errors.Is(err, communities.ErrTorrentTimedout) {
 			m.logger.Debug("torrent has timed out, trying once more...")
-			downloadTaskInfo, err = m.archiveManager.DownloadHistoryArchivesByMagnetlink(id, magnetlink, cancel)
+			downloadTaskInfo, err = m.archiveManager.DownloadHistoryArchives(id, locations, cancel)
 			if err != nil {
 				m.logger.Error(logMsg, zap.Error(err))
 				return
@@ -1423,7 +1457,7 @@ func (m *Messenger) downloadAndImportHistoryArchives(id types.HexBytes, magnetli
 		return
 	}
 
-	err = m.communitiesManager.UpdateLastSeenMagnetlink(id, magnetlink)
+	err = m.communitiesManager.UpdateLastSeenMagnetlink(id, locations[0])
 	if err != nil {
 		m.logger.Error("couldn't update last seen magnetlink", zap.Error(err))
 	}
@@ -1737,8 +1771,8 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 			}
 		}
 
-		magnetlink := requestToJoinResponseProto.MagnetUri
-		if m.archiveManager.IsReady() && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled && magnetlink != "" {
+		locations := communities.HistoryArchiveLocations(requestToJoinResponseProto.MagnetUri, requestToJoinResponseProto.ArchiveLocations)
+		if m.archiveManager.IsReady() && communitySettings != nil && communitySettings.HistoryArchiveSupportEnabled && len(locations) > 0 {
 
 			currentTask := m.archiveManager.GetHistoryArchiveDownloadTask(community.IDString())
 			go func(currentTask *communities.HistoryArchiveDownloadTask) {
@@ -1762,7 +1796,7 @@ func (m *Messenger) HandleCommunityRequestToJoinResponse(state *ReceivedMessageS
 				m.shutdownWaitGroup.Add(1)
 				defer m.shutdownWaitGroup.Done()
 
-				m.downloadAndImportHistoryArchives(community.ID(), magnetlink, task.CancelChan)
+				m.downloadAndImportHistoryArchives(community.ID(), locations, task.CancelChan)
 			}(currentTask)
 
 			clock := requestToJoinResponseProto.Community.ArchiveMagnetlinkClock
@@ -2186,6 +2220,57 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 		return ErrMessageNotAllowed
 	}
 
+	// The name is queued for verification before the contact requests policy is checked,
+	// so that a request held for it is handled once the name is verified
+	if !isSyncMessage && receivedMessage.EnsName != "" {
+		contact := state.CurrentMessageState.Contact
+		oldRecord, err := m.ensVerifier.Add(contact.ID, receivedMessage.EnsName, receivedMessage.Clock)
+		if err != nil {
+			m.logger.Warn("failed to verify ENS name", zap.Error(err))
+		} else if oldRecord == nil {
+			// If oldRecord is nil, a new verification process will take place
+			// so we reset the record
+			contact.ENSVerified = false
+			state.ModifiedContacts.Store(contact.ID, true)
+			state.AllContacts.Store(contact.ID, contact)
+		}
+	}
+
+	if !isSyncMessage && chat.OneToOne() {
+		var requestClock uint64
+		propagatedState := receivedMessage.ContactRequestPropagatedState
+		if propagatedState != nil && ContactRequestState(propagatedState.LocalState) == ContactRequestStateSent {
+			requestClock = propagatedState.LocalClock
+		} else if receivedMessage.ContentType == protobuf.ChatMessage_CONTACT_REQUEST {
+			requestClock = receivedMessage.Clock
+		}
+
+		contact := state.CurrentMessageState.Contact
+		if requestClock != 0 {
+			if err := m.liftLapsedContactRequestDecline(state, contact); err != nil {
+				return err
+			}
+		}
+		if requestClock != 0 && isNewIncomingContactRequest(contact, requestClock) {
+			verdict, err := m.checkIncomingContactRequest(contact, requestClock, receivedMessage.ContactRequestStamp, receivedMessage.EnsName)
+			if err != nil {
+				return err
+			}
+			switch verdict {
+			case contactRequestRejected:
+				logger.Debug("dropping message with a rejected contact request", zap.String("messageID", receivedMessage.ID))
+				return nil
+			case contactRequestHeld:
+				logger.Debug("holding message until the ENS name of the sender is verified", zap.String("messageID", receivedMessage.ID))
+				m.holdContactRequest(state.CurrentMessageState)
+				return nil
+			case contactRequestDeclined:
+				m.declineIncomingContactRequest(state, contact, requestClock)
+				return nil
+			}
+		}
+	}
+
 	if chat.ChatType == ChatTypeCommunityChat {
 		communityID, err := types.DecodeHex(chat.CommunityID)
 		if err != nil {
@@ -2325,19 +2410,6 @@ func (m *Messenger) handleChatMessage(state *ReceivedMessageState, forceSeen boo
 	// TODO(samyoul) remove storing of an updated reference pointer?
 	m.allChats.Store(chat.ID, chat)
 
-	if !isSyncMessage && receivedMessage.EnsName != "" {
-		oldRecord, err := m.ensVerifier.Add(contact.ID, receivedMessage.EnsName, receivedMessage.Clock)
-		if err != nil {
-			m.logger.Warn("failed to verify ENS name", zap.Error(err))
-		} else if oldRecord == nil {
-			// If oldRecord is nil, a new verification process will take place
-			// so we reset the record
-			contact.ENSVerified = false
-			state.ModifiedContacts.Store(contact.ID, true)
-			state.AllContacts.Store(contact.ID, contact)
-		}
-	}
-
 	if !isSyncMessage && contact.DisplayName != receivedMessage.DisplayName && len(receivedMessage.DisplayName) != 0 {
 		contact.DisplayName = receivedMessage.DisplayName
 		state.ModifiedContacts.Store(contact.ID, true)
@@ -3978,7 +4050,8 @@ func (m *Messenger) HandleSyncTrustedUser(state *ReceivedMessageState, message *
 	return nil
 }
 func (m *Messenger) HandleCommunityMessageArchiveMagnetlink(state *ReceivedMessageState, message *protobuf.CommunityMessageArchiveMagnetlink, statusMessage *v1protocol.StatusMessage) error {
-	return m.HandleHistoryArchiveMagnetlinkMessage(state, state.CurrentMessageState.PublicKey, message.MagnetUri, message.Clock)
+	locations := communities.HistoryArchiveLocations(message.MagnetUri, message.ArchiveLocations)
+	return m.HandleHistoryArchiveMagnetlinkMessage(state, state.CurrentMessageState.PublicKey, locations, message.Clock)
 }
 
 func (m *Messenger) addNewKeypairAddedOnPairedDeviceACNotification(keyUID string, response *MessengerResponse) error {
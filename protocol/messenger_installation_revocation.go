@@ -0,0 +1,145 @@
+package protocol
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/transport"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+var (
+	ErrCannotRevokeCurrentInstallation        = errors.New("can't revoke the current installation")
+	ErrInvalidInstallationRevocationSignature = errors.New("invalid installation revocation signature")
+)
+
+func installationRevocationDigest(clock uint64, installationID string) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("installation-revocation:%d:%s", clock, installationID)))
+}
+
+// RevokeInstallation permanently revokes one of our installations, e.g. a lost device.
+// The signed revocation is broadcast on our contact code topic, so that our contacts and
+// paired devices stop encrypting to the installation and drop it from future bundles.
+// Private group chats are encrypted for each installation, hash ratchet keys of the
+// communities we control are rotated as the revoked installation knows them.
+func (m *Messenger) RevokeInstallation(ctx context.Context, installationID string) (*MessengerResponse, error) {
+	if installationID == m.installationID {
+		return nil, ErrCannotRevokeCurrentInstallation
+	}
+
+	clock := m.getTimesource().GetCurrentTime()
+	signature, err := crypto.Sign(installationRevocationDigest(clock, installationID), m.identity)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.InstallationRevocation{
+		Clock:          clock,
+		InstallationId: installationID,
+		Signature:      signature,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.revokeInstallation(&m.identity.PublicKey, installationID)
+	if err != nil {
+		return nil, err
+	}
+
+	contactCodeTopic := transport.ContactCodeTopic(&m.identity.PublicKey)
+	_, err = m.sender.SendPublic(ctx, contactCodeTopic, common.RawMessage{
+		LocalChatID: contactCodeTopic,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_INSTALLATION_REVOCATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	m.rekeyControlledCommunities()
+
+	response := &MessengerResponse{}
+	if installation, ok := m.allInstallations.Load(installationID); ok {
+		response.AddInstallation(installation)
+	}
+	return response, nil
+}
+
+func (m *Messenger) revokeInstallation(identity *ecdsa.PublicKey, installationID string) error {
+	err := m.encryptor.RevokeInstallation(identity, installationID, time.Now().UnixNano())
+	if err != nil {
+		return err
+	}
+
+	if !common.IsPubKeyEqual(identity, &m.identity.PublicKey) {
+		return nil
+	}
+
+	installation, ok := m.allInstallations.Load(installationID)
+	if ok {
+		installation.Enabled = false
+		installation.Revoked = true
+		m.allInstallations.Store(installationID, installation)
+		m.modifiedInstallations.Store(installationID, true)
+	}
+	return nil
+}
+
+// rekeyControlledCommunities distributes new keys for all the communities we control
+func (m *Messenger) rekeyControlledCommunities() {
+	controlledCommunities, err := m.ControlledCommunities()
+	if err != nil {
+		m.logger.Error("failed to get controlled communities", zap.Error(err))
+		return
+	}
+
+	for _, c := range controlledCommunities {
+		err = m.rekeyCommunity(c, func([]byte) bool { return true })
+		if err != nil {
+			m.logger.Error("failed to rekey community", zap.Error(err), zap.String("community ID", c.IDString()))
+		}
+	}
+}
+
+func (m *Messenger) HandleInstallationRevocation(state *ReceivedMessageState, message *protobuf.InstallationRevocation, statusMessage *v1protocol.StatusMessage) error {
+	identity := state.CurrentMessageState.PublicKey
+
+	signer, err := crypto.SigToPub(installationRevocationDigest(message.Clock, message.InstallationId), message.Signature)
+	if err != nil || !common.IsPubKeyEqual(signer, identity) {
+		return ErrInvalidInstallationRevocationSignature
+	}
+
+	isOwnIdentity := common.IsPubKeyEqual(identity, &m.identity.PublicKey)
+	if isOwnIdentity && message.InstallationId == m.installationID {
+		m.logger.Warn("this installation has been revoked")
+		return nil
+	}
+
+	if isOwnIdentity {
+		// We receive the revocations we send
+		if installation, ok := m.allInstallations.Load(message.InstallationId); ok && installation.Revoked {
+			return nil
+		}
+	}
+
+	err = m.revokeInstallation(identity, message.InstallationId)
+	if err != nil {
+		return err
+	}
+
+	if isOwnIdentity {
+		m.rekeyControlledCommunities()
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestMessengerInstallationRevocationSuite(t *testing.T) {
+	suite.Run(t, new(MessengerInstallationRevocationSuite))
+}
+
+type MessengerInstallationRevocationSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerInstallationRevocationSuite) sendAndReceiveMessage(from *Messenger, to *Messenger, text string) {
+	chat := CreateOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&to.identity.PublicKey)), &to.identity.PublicKey, from.transport)
+	s.Require().NoError(from.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = text
+	_, err := from.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		to,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == text {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+func installationIDs(installations []*multidevice.Installation) []string {
+	ids := make([]string, 0, len(installations))
+	for _, installation := range installations {
+		ids = append(ids, installation.ID)
+	}
+	return ids
+}
+
+func (s *MessengerInstallationRevocationSuite) activeInstallationIDs(m *Messenger, identity *Messenger) []string {
+	installations, err := m.encryptor.GetMultiDevice().GetActiveInstallations(&identity.identity.PublicKey)
+	s.Require().NoError(err)
+	return installationIDs(installations)
+}
+
+func (s *MessengerInstallationRevocationSuite) TestRevokeInstallation() {
+	alice := s.m
+
+	alice2, err := newMessengerWithKey(s.shh, alice.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, alice2)
+
+	prepareAliceMessengersForPairing(&s.Suite, alice, alice2)
+	PairDevices(&s.Suite, alice2, alice)
+	PairDevices(&s.Suite, alice, alice2)
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+
+	// Alice is one of Bob's contacts, he listens to her contact code topic
+	_, err = bob.transport.JoinPrivate(&alice.identity.PublicKey)
+	s.Require().NoError(err)
+
+	s.sendAndReceiveMessage(alice2, bob, "hello from alice's second device")
+	s.Require().Contains(s.activeInstallationIDs(bob, alice), alice2.installationID)
+
+	_, err = alice.RevokeInstallation(context.Background(), alice.installationID)
+	s.Require().ErrorIs(err, ErrCannotRevokeCurrentInstallation)
+
+	response, err := alice.RevokeInstallation(context.Background(), alice2.installationID)
+	s.Require().NoError(err)
+	s.Require().Len(response.Installations(), 1)
+	s.Require().True(response.Installations()[0].Revoked)
+	s.Require().False(response.Installations()[0].Enabled)
+
+	ourInstallations, err := alice.encryptor.GetOurActiveInstallations(&alice.identity.PublicKey)
+	s.Require().NoError(err)
+	s.Require().NotContains(installationIDs(ourInstallations), alice2.installationID)
+
+	// Bob stops encrypting to the revoked installation
+	err = tt.RetryWithBackOff(func() error {
+		_, err := bob.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, id := range s.activeInstallationIDs(bob, alice) {
+			if id == alice2.installationID {
+				return errors.New("installation not revoked")
+			}
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// The revoked installation is not added back from its bundle
+	s.sendAndReceiveMessage(alice2, bob, "still here")
+	s.Require().NotContains(s.activeInstallationIDs(bob, alice), alice2.installationID)
+}
+
+func (s *MessengerInstallationRevocationSuite) TestInvalidRevocationSignature() {
+	alice := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+
+	// Signed by Bob on behalf of Alice
+	clock := uint64(1)
+	signature, err := crypto.Sign(installationRevocationDigest(clock, "alice-installation"), bob.identity)
+	s.Require().NoError(err)
+
+	state := &ReceivedMessageState{
+		CurrentMessageState: &CurrentMessageState{PublicKey: &alice.identity.PublicKey},
+	}
+	err = bob.HandleInstallationRevocation(state, &protobuf.InstallationRevocation{
+		Clock:          clock,
+		InstallationId: "alice-installation",
+		Signature:      signature,
+	}, nil)
+	s.Require().ErrorIs(err, ErrInvalidInstallationRevocationSignature)
+}
@@ -117,6 +117,20 @@ func parseValidURL(rawURL string) (*neturl.URL, error) {
 }
 
 func (m *Messenger) GetTextURLsToUnfurl(text string) *URLsUnfurlPlan {
+	return m.getTextURLsToUnfurl(text, nil)
+}
+
+// GetChatTextURLsToUnfurl is like GetTextURLsToUnfurl, but also forbids
+// unfurling if it's disabled for the type of the chat
+func (m *Messenger) GetChatTextURLsToUnfurl(chatID string, text string) (*URLsUnfurlPlan, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return nil, ErrChatNotFound
+	}
+	return m.getTextURLsToUnfurl(text, chat), nil
+}
+
+func (m *Messenger) getTextURLsToUnfurl(text string, chat *Chat) *URLsUnfurlPlan {
 	s, err := m.getSettings()
 	if err != nil {
 		// log the error and keep parsing the text
@@ -124,6 +138,12 @@ func (m *Messenger) GetTextURLsToUnfurl(text string) *URLsUnfurlPlan {
 		s.URLUnfurlingMode = settings.URLUnfurlingDisableAll
 	}
 
+	privacy, err := m.GetURLUnfurlingPrivacy()
+	if err != nil {
+		m.logger.Error("GetTextURLsToUnfurl: failed to get unfurling privacy settings", zap.Error(err))
+		s.URLUnfurlingMode = settings.URLUnfurlingDisableAll
+	}
+
 	indexedUrls := map[string]struct{}{}
 	result := &URLsUnfurlPlan{
 		// The usage of `UnfurledLinksPerMessageLimit` is quite random here. I wanted to allocate
@@ -160,6 +180,8 @@ func (m *Messenger) GetTextURLsToUnfurl(text string) *URLsUnfurlPlan {
 			metadata.Permission = URLUnfurlingNotSupported
 		} else if metadata.IsStatusSharedURL {
 			metadata.Permission = URLUnfurlingAllowed
+		} else if privacy.chatTypeDisabled(chat) || !privacy.domainAllowed(parsedURL.Hostname()) {
+			metadata.Permission = URLUnfurlingForbiddenBySettings
 		} else {
 			switch s.URLUnfurlingMode {
 			case settings.URLUnfurlingAlwaysAsk:
@@ -203,16 +225,39 @@ func NewDefaultHTTPClient() *http.Client {
 // UnfurlURLs assumes clients pass URLs verbatim that were validated and
 // processed by GetURLs.
 func (m *Messenger) UnfurlURLs(httpClient *http.Client, urls []string) (UnfurlURLsResponse, error) {
+	return m.unfurlURLs(httpClient, urls, nil)
+}
+
+// UnfurlChatURLs is like UnfurlURLs, but only unfurls Status links
+// if unfurling is disabled for the type of the chat
+func (m *Messenger) UnfurlChatURLs(httpClient *http.Client, chatID string, urls []string) (UnfurlURLsResponse, error) {
+	chat, ok := m.allChats.Load(chatID)
+	if !ok {
+		return UnfurlURLsResponse{}, ErrChatNotFound
+	}
+	return m.unfurlURLs(httpClient, urls, chat)
+}
+
+func (m *Messenger) unfurlURLs(httpClient *http.Client, urls []string, chat *Chat) (UnfurlURLsResponse, error) {
 	response := UnfurlURLsResponse{}
 
+	privacy, err := m.GetURLUnfurlingPrivacy()
+	if err != nil {
+		return response, err
+	}
+
 	// Unfurl in a loop
 
 	response.LinkPreviews = make([]*common.LinkPreview, 0, len(urls))
 	response.StatusLinkPreviews = make([]*common.StatusLinkPreview, 0, len(urls))
 
 	if httpClient == nil {
-		httpClient = NewDefaultHTTPClient()
+		httpClient, err = privacy.newHTTPClient()
+		if err != nil {
+			return response, err
+		}
 	}
+	httpClient = privacy.restrictHTTPClient(httpClient)
 
 	for _, url := range urls {
 		m.logger.Debug("unfurling", zap.String("url", url))
@@ -228,7 +273,23 @@ func (m *Messenger) UnfurlURLs(httpClient *http.Client, urls []string) (UnfurlUR
 			continue
 		}
 
-		p, err := m.unfurlURL(httpClient, url)
+		if privacy.chatTypeDisabled(chat) {
+			m.logger.Debug("unfurling disabled for chat type", zap.String("url", url))
+			continue
+		}
+
+		parsedURL, err := neturl.Parse(url)
+		if err != nil {
+			m.logger.Warn("failed to unfurl", zap.String("url", url), zap.Error(err))
+			continue
+		}
+
+		if !privacy.domainAllowed(parsedURL.Hostname()) {
+			m.logger.Debug("unfurling forbidden for domain", zap.String("url", url))
+			continue
+		}
+
+		p, err := m.unfurlURLCached(httpClient, parsedURL, privacy)
 		if err != nil {
 			m.logger.Warn("failed to unfurl", zap.String("url", url), zap.Error(err))
 			continue
@@ -0,0 +1,254 @@
+package protocol
+
+import (
+	"errors"
+	"net/http"
+	neturl "net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+const (
+	defaultLinkPreviewCacheTTL     = 24 * time.Hour
+	defaultLinkPreviewCacheMaxSize = 20 * 1024 * 1024
+)
+
+var (
+	ErrInvalidUnfurlingProxy  = errors.New("invalid unfurling proxy, expected a http, https or socks5 URL")
+	ErrInvalidUnfurlingDomain = errors.New("invalid unfurling domain")
+	ErrInvalidChatType        = errors.New("invalid chat type")
+	ErrUnfurlingDomainDenied  = errors.New("unfurling forbidden for domain")
+)
+
+// URLUnfurlingPrivacy limits which websites are contacted to unfurl links, and how
+type URLUnfurlingPrivacy struct {
+	// AllowedDomains restricts unfurling to these domains and their subdomains when not empty
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
+	// DeniedDomains and their subdomains are never unfurled
+	DeniedDomains []string `json:"deniedDomains,omitempty"`
+	// Proxy is the URL of a HTTP or SOCKS5 proxy all unfurling requests go through
+	Proxy string `json:"proxy,omitempty"`
+	// DisabledChatTypes are the types of chats in which links are not unfurled
+	DisabledChatTypes []ChatType `json:"disabledChatTypes,omitempty"`
+	// CacheDisabled turns off the cache of unfurled links
+	CacheDisabled bool `json:"cacheDisabled,omitempty"`
+	// CacheTTL is the number of seconds unfurled links are cached for,
+	// defaults to defaultLinkPreviewCacheTTL
+	CacheTTL uint64 `json:"cacheTtl,omitempty"`
+	// CacheMaxSize is the maximum size of the cache in bytes,
+	// defaults to defaultLinkPreviewCacheMaxSize
+	CacheMaxSize uint64 `json:"cacheMaxSize,omitempty"`
+}
+
+func normalizeUnfurlingDomain(domain string) string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "*.")
+	return strings.Trim(domain, ".")
+}
+
+func (p *URLUnfurlingPrivacy) Validate() error {
+	if p.Proxy != "" {
+		proxy, err := neturl.Parse(p.Proxy)
+		if err != nil || proxy.Host == "" {
+			return ErrInvalidUnfurlingProxy
+		}
+		switch proxy.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return ErrInvalidUnfurlingProxy
+		}
+	}
+
+	for _, domains := range [][]string{p.AllowedDomains, p.DeniedDomains} {
+		for i, domain := range domains {
+			domains[i] = normalizeUnfurlingDomain(domain)
+			if domains[i] == "" || strings.ContainsAny(domains[i], "/:?# ") {
+				return ErrInvalidUnfurlingDomain
+			}
+		}
+	}
+
+	for _, chatType := range p.DisabledChatTypes {
+		if chatType < ChatTypeOneToOne || chatType > ChatTypeCommunityChat {
+			return ErrInvalidChatType
+		}
+	}
+
+	return nil
+}
+
+func (p URLUnfurlingPrivacy) cacheTTL() time.Duration {
+	if p.CacheTTL == 0 {
+		return defaultLinkPreviewCacheTTL
+	}
+	return time.Duration(p.CacheTTL) * time.Second
+}
+
+func (p URLUnfurlingPrivacy) cacheMaxSize() uint64 {
+	if p.CacheMaxSize == 0 {
+		return defaultLinkPreviewCacheMaxSize
+	}
+	return p.CacheMaxSize
+}
+
+func matchesUnfurlingDomain(hostname string, domains []string) bool {
+	for _, domain := range domains {
+		if hostname == domain || strings.HasSuffix(hostname, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// domainAllowed returns whether links to hostname can be unfurled,
+// the denylist takes precedence over the allowlist
+func (p URLUnfurlingPrivacy) domainAllowed(hostname string) bool {
+	hostname = strings.Trim(strings.ToLower(hostname), ".")
+	if matchesUnfurlingDomain(hostname, p.DeniedDomains) {
+		return false
+	}
+	return len(p.AllowedDomains) == 0 || matchesUnfurlingDomain(hostname, p.AllowedDomains)
+}
+
+func (p URLUnfurlingPrivacy) chatTypeDisabled(chat *Chat) bool {
+	if chat == nil {
+		return false
+	}
+	for _, chatType := range p.DisabledChatTypes {
+		if chat.ChatType == chatType {
+			return true
+		}
+	}
+	return false
+}
+
+func (p URLUnfurlingPrivacy) newHTTPClient() (*http.Client, error) {
+	httpClient := NewDefaultHTTPClient()
+	if p.Proxy == "" {
+		return httpClient, nil
+	}
+
+	proxy, err := neturl.Parse(p.Proxy)
+	if err != nil {
+		return nil, err
+	}
+	httpClient.Transport = &http.Transport{Proxy: http.ProxyURL(proxy)}
+	return httpClient, nil
+}
+
+// restrictHTTPClient returns a copy of httpClient which only contacts the allowed domains,
+// so that redirects and the secondary fetches (thumbnails, favicons, oEmbed) are restricted too
+func (p URLUnfurlingPrivacy) restrictHTTPClient(httpClient *http.Client) *http.Client {
+	restricted := *httpClient
+	restricted.Transport = &unfurlingDomainsTransport{privacy: p, base: httpClient.Transport}
+	checkRedirect := httpClient.CheckRedirect
+	restricted.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if !p.domainAllowed(req.URL.Hostname()) {
+			return ErrUnfurlingDomainDenied
+		}
+		if checkRedirect != nil {
+			return checkRedirect(req, via)
+		}
+		// Default policy of http.Client
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
+		}
+		return nil
+	}
+	return &restricted
+}
+
+// unfurlingDomainsTransport fails the requests to the domains links are not unfurled from
+type unfurlingDomainsTransport struct {
+	privacy URLUnfurlingPrivacy
+	base    http.RoundTripper
+}
+
+func (t *unfurlingDomainsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.privacy.domainAllowed(req.URL.Hostname()) {
+		return nil, ErrUnfurlingDomainDenied
+	}
+	base := t.base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return base.RoundTrip(req)
+}
+
+// normalizeLinkPreviewCacheURL returns the key under which the preview of u is cached,
+// so that URLs pointing to the same page share a cache entry
+func normalizeLinkPreviewCacheURL(u *neturl.URL) string {
+	normalized := *u
+	normalized.Scheme = strings.ToLower(u.Scheme)
+	normalized.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (normalized.Scheme == "http" && port == "80") || (normalized.Scheme == "https" && port == "443") {
+		normalized.Host = strings.ToLower(u.Hostname())
+	}
+	normalized.Path = strings.TrimRight(u.Path, "/")
+	normalized.RawPath = ""
+	// Encode sorts the query by key
+	normalized.RawQuery = u.Query().Encode()
+	normalized.Fragment = ""
+	normalized.RawFragment = ""
+	return normalized.String()
+}
+
+// SetURLUnfurlingPrivacy sets how links are unfurled, the settings are synced with paired devices
+func (m *Messenger) SetURLUnfurlingPrivacy(privacy URLUnfurlingPrivacy) error {
+	if err := privacy.Validate(); err != nil {
+		return err
+	}
+	return m.settings.SaveSettingField(settings.URLUnfurlingPrivacy, privacy)
+}
+
+func (m *Messenger) GetURLUnfurlingPrivacy() (URLUnfurlingPrivacy, error) {
+	var privacy URLUnfurlingPrivacy
+	err := m.settings.GetURLUnfurlingPrivacy(&privacy)
+	return privacy, err
+}
+
+func (m *Messenger) ClearLinkPreviewCache() error {
+	return m.persistence.ClearLinkPreviewCache()
+}
+
+// unfurlURLCached returns the cached preview of url if any, otherwise it unfurls and caches it
+func (m *Messenger) unfurlURLCached(httpClient *http.Client, url *neturl.URL, privacy URLUnfurlingPrivacy) (*common.LinkPreview, error) {
+	if privacy.CacheDisabled {
+		return m.unfurlURL(httpClient, url.String())
+	}
+
+	key := normalizeLinkPreviewCacheURL(url)
+	now := m.GetCurrentTimeInMillis()
+	fetchedAfter := now - uint64(privacy.cacheTTL().Milliseconds())
+
+	preview, err := m.persistence.CachedLinkPreview(key, fetchedAfter)
+	if err != nil {
+		m.logger.Warn("failed to get cached link preview", zap.Error(err))
+	} else if preview != nil {
+		preview.URL = url.String()
+		preview.Hostname = strings.ToLower(url.Hostname())
+		return preview, nil
+	}
+
+	preview, err = m.unfurlURL(httpClient, url.String())
+	if err != nil {
+		return preview, err
+	}
+
+	// The cache is best-effort, failing to update it doesn't fail unfurling
+	err = m.persistence.SaveCachedLinkPreview(key, preview, now)
+	if err != nil {
+		m.logger.Warn("failed to cache link preview", zap.Error(err))
+		return preview, nil
+	}
+	err = m.persistence.PruneLinkPreviewCache(fetchedAfter, privacy.cacheMaxSize())
+	if err != nil {
+		m.logger.Warn("failed to prune link preview cache", zap.Error(err))
+	}
+	return preview, nil
+}
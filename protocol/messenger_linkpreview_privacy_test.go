@@ -0,0 +1,264 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	neturl "net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/common"
+)
+
+const linkPreviewPrivacyTestPage = `
+	<html>
+		<head>
+			<meta property="og:title" content="Title">
+			<meta property="og:description" content="Description">
+		</head>
+	</html>
+`
+
+func TestNormalizeLinkPreviewCacheURL(t *testing.T) {
+	testCases := []struct {
+		url      string
+		expected string
+	}{
+		{"https://Status.app/", "https://status.app"},
+		{"https://status.app:443/blog/#top", "https://status.app/blog"},
+		{"http://status.app:80/blog?b=2&a=1", "http://status.app/blog?a=1&b=2"},
+		{"http://status.app:8080/Blog", "http://status.app:8080/Blog"},
+	}
+
+	for _, tc := range testCases {
+		u, err := neturl.Parse(tc.url)
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, normalizeLinkPreviewCacheURL(u), tc.url)
+	}
+}
+
+func TestURLUnfurlingPrivacy(t *testing.T) {
+	privacy := URLUnfurlingPrivacy{
+		AllowedDomains: []string{"*.Status.app", "github.com"},
+		DeniedDomains:  []string{"gist.github.com."},
+	}
+	require.NoError(t, privacy.Validate())
+	require.Equal(t, []string{"status.app", "github.com"}, privacy.AllowedDomains)
+
+	require.True(t, privacy.domainAllowed("status.app"))
+	require.True(t, privacy.domainAllowed("blog.status.app"))
+	require.True(t, privacy.domainAllowed("GitHub.com"))
+	require.False(t, privacy.domainAllowed("gist.github.com"))
+	require.False(t, privacy.domainAllowed("notstatus.app"))
+	require.True(t, URLUnfurlingPrivacy{}.domainAllowed("notstatus.app"))
+
+	require.ErrorIs(t, (&URLUnfurlingPrivacy{Proxy: "ftp://127.0.0.1:21"}).Validate(), ErrInvalidUnfurlingProxy)
+	require.ErrorIs(t, (&URLUnfurlingPrivacy{Proxy: "socks5://"}).Validate(), ErrInvalidUnfurlingProxy)
+	require.NoError(t, (&URLUnfurlingPrivacy{Proxy: "socks5://127.0.0.1:9050"}).Validate())
+	require.ErrorIs(t, (&URLUnfurlingPrivacy{DeniedDomains: []string{"status.app/blog"}}).Validate(), ErrInvalidUnfurlingDomain)
+	require.ErrorIs(t, (&URLUnfurlingPrivacy{DisabledChatTypes: []ChatType{0}}).Validate(), ErrInvalidChatType)
+}
+
+func (s *MessengerLinkPreviewsTestSuite) countingTransport(u string) (*StubTransport, *int) {
+	requests := 0
+	transport := &StubTransport{}
+	transport.AddURLMatcherRoundTrip(u, func(r *http.Request) *http.Response {
+		requests++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(linkPreviewPrivacyTestPage)),
+		}
+	})
+	return transport, &requests
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_UnfurlURLs_Cache() {
+	u := "https://github.com/status-im/status-go"
+	transport, requests := s.countingTransport(u)
+	stubbedClient := http.Client{Transport: transport}
+
+	response, err := s.m.UnfurlURLs(&stubbedClient, []string{u})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal(1, *requests)
+
+	// The same page is served from the cache
+	response, err = s.m.UnfurlURLs(&stubbedClient, []string{u + "/#readme"})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal(u+"/#readme", response.LinkPreviews[0].URL)
+	s.Require().Equal("Title", response.LinkPreviews[0].Title)
+	s.Require().Equal(1, *requests)
+
+	s.Require().NoError(s.m.ClearLinkPreviewCache())
+	_, err = s.m.UnfurlURLs(&stubbedClient, []string{u})
+	s.Require().NoError(err)
+	s.Require().Equal(2, *requests)
+
+	s.Require().NoError(s.m.SetURLUnfurlingPrivacy(URLUnfurlingPrivacy{CacheDisabled: true}))
+	_, err = s.m.UnfurlURLs(&stubbedClient, []string{u})
+	s.Require().NoError(err)
+	s.Require().Equal(3, *requests)
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_LinkPreviewCacheLimits() {
+	preview := &common.LinkPreview{URL: "https://status.app", Title: "Status"}
+	for i, url := range []string{"https://a.app", "https://b.app", "https://c.app"} {
+		s.Require().NoError(s.m.persistence.SaveCachedLinkPreview(url, preview, uint64(i+1)*1000))
+	}
+
+	// Expired previews are not returned
+	cached, err := s.m.persistence.CachedLinkPreview("https://a.app", 1000)
+	s.Require().NoError(err)
+	s.Require().Nil(cached)
+	cached, err = s.m.persistence.CachedLinkPreview("https://b.app", 1000)
+	s.Require().NoError(err)
+	s.Require().NotNil(cached)
+	s.Require().Equal(preview.Title, cached.Title)
+
+	// Only the most recent preview fits
+	data, err := json.Marshal(preview)
+	s.Require().NoError(err)
+	s.Require().NoError(s.m.persistence.PruneLinkPreviewCache(0, uint64(len(data)+1)))
+	for url, exists := range map[string]bool{"https://a.app": false, "https://b.app": false, "https://c.app": true} {
+		cached, err = s.m.persistence.CachedLinkPreview(url, 0)
+		s.Require().NoError(err)
+		s.Require().Equal(exists, cached != nil, url)
+	}
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_UnfurlURLs_Domains() {
+	const allowedLink = "https://github.com/status-im/status-go"
+	const deniedLink = "https://gist.github.com/status-im"
+	const otherLink = "https://status.app/blog"
+
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.URLUnfurlingMode, settings.URLUnfurlingEnableAll))
+	s.Require().NoError(s.m.SetURLUnfurlingPrivacy(URLUnfurlingPrivacy{
+		AllowedDomains: []string{"github.com"},
+		DeniedDomains:  []string{"gist.github.com"},
+	}))
+
+	plan := s.m.GetTextURLsToUnfurl(allowedLink + " " + deniedLink + " " + otherLink)
+	s.Require().Len(plan.URLs, 3)
+	s.Require().Equal(URLUnfurlingAllowed, plan.URLs[0].Permission)
+	s.Require().Equal(URLUnfurlingForbiddenBySettings, plan.URLs[1].Permission)
+	s.Require().Equal(URLUnfurlingForbiddenBySettings, plan.URLs[2].Permission)
+
+	transport := StubTransport{}
+	transport.AddURLMatcher("https://", []byte(linkPreviewPrivacyTestPage), nil)
+	stubbedClient := http.Client{Transport: &transport}
+
+	response, err := s.m.UnfurlURLs(&stubbedClient, []string{allowedLink, deniedLink, otherLink})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal(allowedLink, response.LinkPreviews[0].URL)
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_UnfurlURLs_DeniedDomainsNotContacted() {
+	const redirectLink = "https://github.com/redirect"
+	const pageLink = "https://github.com/page"
+	const page = `
+		<html>
+			<head>
+				<meta property="og:title" content="Title">
+				<meta property="og:image" content="https://gist.github.com/image.png">
+			</head>
+		</html>
+	`
+
+	s.Require().NoError(s.m.SetURLUnfurlingPrivacy(URLUnfurlingPrivacy{DeniedDomains: []string{"gist.github.com"}}))
+
+	deniedRequests := 0
+	transport := StubTransport{}
+	transport.AddURLMatcherRoundTrip(redirectLink, func(r *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusFound,
+			Header:     http.Header{"Location": []string{"https://gist.github.com/status-im"}},
+			Body:       ioutil.NopCloser(bytes.NewBuffer(nil)),
+		}
+	})
+	transport.AddURLMatcher(pageLink, []byte(page), nil)
+	transport.AddURLMatcherRoundTrip("https://gist.github.com", func(r *http.Request) *http.Response {
+		deniedRequests++
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewBufferString(linkPreviewPrivacyTestPage)),
+		}
+	})
+	stubbedClient := http.Client{Transport: &transport}
+
+	// Neither redirects nor thumbnails reach denied domains
+	response, err := s.m.UnfurlURLs(&stubbedClient, []string{redirectLink, pageLink})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal(pageLink, response.LinkPreviews[0].URL)
+	s.Require().Empty(response.LinkPreviews[0].Thumbnail.DataURI)
+	s.Require().Equal(0, deniedRequests)
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_UnfurlURLs_DisabledChatType() {
+	const link = "https://github.com/status-im/status-go"
+	const statusLink = "https://status.app/c#zQ3shYSHp7GoiXaauJMnDcjwU2yNjdzpXLosAWapPS4CFxc11"
+
+	s.Require().NoError(s.m.settings.SaveSettingField(settings.URLUnfurlingMode, settings.URLUnfurlingEnableAll))
+	s.Require().NoError(s.m.SetURLUnfurlingPrivacy(URLUnfurlingPrivacy{DisabledChatTypes: []ChatType{ChatTypeOneToOne}}))
+
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	oneToOneChat := CreateOneToOneChat("contact", &key.PublicKey, s.m.getTimesource())
+	s.Require().NoError(s.m.SaveChat(oneToOneChat))
+	groupChat := CreateGroupChat(s.m.getTimesource())
+	groupChat.ID = "group-chat"
+	s.Require().NoError(s.m.SaveChat(&groupChat))
+
+	plan, err := s.m.GetChatTextURLsToUnfurl(oneToOneChat.ID, link+" "+statusLink)
+	s.Require().NoError(err)
+	s.Require().Len(plan.URLs, 2)
+	s.Require().Equal(URLUnfurlingForbiddenBySettings, plan.URLs[0].Permission)
+	s.Require().Equal(URLUnfurlingAllowed, plan.URLs[1].Permission)
+
+	plan, err = s.m.GetChatTextURLsToUnfurl(groupChat.ID, link)
+	s.Require().NoError(err)
+	s.Require().Equal(URLUnfurlingAllowed, plan.URLs[0].Permission)
+
+	_, err = s.m.GetChatTextURLsToUnfurl("unknown", link)
+	s.Require().ErrorIs(err, ErrChatNotFound)
+
+	transport, requests := s.countingTransport(link)
+	stubbedClient := http.Client{Transport: transport}
+
+	response, err := s.m.UnfurlChatURLs(&stubbedClient, oneToOneChat.ID, []string{link})
+	s.Require().NoError(err)
+	s.Require().Empty(response.LinkPreviews)
+	s.Require().Equal(0, *requests)
+
+	response, err = s.m.UnfurlChatURLs(&stubbedClient, groupChat.ID, []string{link})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal(1, *requests)
+}
+
+func (s *MessengerLinkPreviewsTestSuite) Test_UnfurlURLs_Proxy() {
+	const link = "http://github.com/status-im/status-go"
+
+	var proxied []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		_, _ = w.Write([]byte(linkPreviewPrivacyTestPage))
+	}))
+	defer proxy.Close()
+
+	s.Require().NoError(s.m.SetURLUnfurlingPrivacy(URLUnfurlingPrivacy{Proxy: proxy.URL}))
+
+	response, err := s.m.UnfurlURLs(nil, []string{link})
+	s.Require().NoError(err)
+	s.Require().Len(response.LinkPreviews, 1)
+	s.Require().Equal("Title", response.LinkPreviews[0].Title)
+	s.Require().Equal([]string{link}, proxied)
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -612,12 +613,31 @@ func (m *Messenger) syncFiltersFrom(ms mailservers.Mailserver, filters []*transp
 		}
 	}
 
-	for _, batch := range batches24h {
-		err := m.processMailserverBatch(ms, batch)
+	if storenodes := m.historyStorenodes(ms); len(storenodes) > 1 {
+		result, err := m.fetchHistoryInParallel(storenodes, batches24h)
 		if err != nil {
 			m.logger.Error("error syncing topics", zap.Error(err))
+
+			// Keep the progress of the topics which have been fully synced
+			var fullySyncedTopics []mailservers.MailserverTopic
+			for _, topic := range syncedTopics {
+				if result.Synced(topic.PubsubTopic, types.StringToTopic(topic.ContentTopic)) {
+					fullySyncedTopics = append(fullySyncedTopics, topic)
+				}
+			}
+			if err := m.mailserversDatabase.AddTopics(fullySyncedTopics); err != nil {
+				m.logger.Error("failed to save synced topics", zap.Error(err))
+			}
 			return nil, err
 		}
+	} else {
+		for _, batch := range batches24h {
+			err := m.processMailserverBatch(ms, batch)
+			if err != nil {
+				m.logger.Error("error syncing topics", zap.Error(err))
+				return nil, err
+			}
+		}
 	}
 
 	m.logger.Debug("topics synced")
@@ -909,37 +929,52 @@ func (m *Messenger) DisableStoreNodes() {
 }
 
 func (m *Messenger) processMailserverBatch(ms mailservers.Mailserver, batch MailserverBatch) error {
+	_, err := m.queryStorenode(m.ctx, ms, batch, defaultStoreNodeRequestPageSize, nil, false)
+	return err
+}
+
+func (m *Messenger) processMailserverBatchWithOptions(ms mailservers.Mailserver, batch MailserverBatch, pageLimit uint32, shouldProcessNextPage func(int) (bool, uint32), processEnvelopes bool) error {
+	_, err := m.queryStorenode(m.ctx, ms, batch, pageLimit, shouldProcessNextPage, processEnvelopes)
+	return err
+}
+
+// queryStorenode processes the batch, recording the health of the storenode,
+// and returns the number of envelopes received
+func (m *Messenger) queryStorenode(ctx context.Context, ms mailservers.Mailserver, batch MailserverBatch, pageLimit uint32, shouldProcessNextPage func(int) (bool, uint32), processEnvelopes bool) (int, error) {
 	canSync, err := m.canSyncWithStoreNodes()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	if !canSync {
-		return nil
+		return 0, nil
 	}
 
 	mailserverID, err := ms.IDBytes()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	logger := m.logger.With(zap.String("mailserverID", ms.ID))
-	return processMailserverBatch(m.ctx, m.transport, batch, mailserverID, logger, defaultStoreNodeRequestPageSize, nil, false)
-}
 
-func (m *Messenger) processMailserverBatchWithOptions(ms mailservers.Mailserver, batch MailserverBatch, pageLimit uint32, shouldProcessNextPage func(int) (bool, uint32), processEnvelopes bool) error {
-	canSync, err := m.canSyncWithStoreNodes()
-	if err != nil {
-		return err
-	}
-	if !canSync {
-		return nil
+	if m.mailserversDatabase == nil {
+		return 0, processMailserverBatch(ctx, m.transport, batch, mailserverID, logger, pageLimit, shouldProcessNextPage, processEnvelopes)
 	}
 
-	mailserverID, err := ms.IDBytes()
+	recorder := &storenodeHealthRecorder{
+		messageRequester: m.transport,
+		storenodeID:      ms.ID,
+		database:         m.mailserversDatabase,
+		logger:           logger,
+	}
+	err = processMailserverBatch(ctx, recorder, batch, mailserverID, logger, pageLimit, shouldProcessNextPage, processEnvelopes)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	logger := m.logger.With(zap.String("mailserverID", ms.ID))
-	return processMailserverBatch(m.ctx, m.transport, batch, mailserverID, logger, pageLimit, shouldProcessNextPage, processEnvelopes)
+
+	// Only a complete history can be used to find gaps in the responses of other storenodes
+	if shouldProcessNextPage == nil {
+		m.recordStorenodeCoverage(ms, batch)
+	}
+	return int(atomic.LoadInt64(&recorder.envelopes)), nil
 }
 
 type MailserverBatch struct {
@@ -1059,11 +1094,35 @@ func (m *Messenger) FillGaps(chatID string, messageIDs []string) error {
 	}
 
 	ms := m.getActiveMailserver(chat.CommunityID)
-	err = m.processMailserverBatch(*ms, batch)
-	if err != nil {
-		return err
+	if ms == nil {
+		return errors.New("mailserver not available")
 	}
 
+	foundBy := make(map[string]bool)
+	storenodes := m.historyStorenodes(*ms)
+	if len(storenodes) > 1 {
+		result, err := m.fetchHistoryInParallel(storenodes, splitBatchByTime(batch, len(storenodes)))
+		if err != nil {
+			return err
+		}
+		for id, envelopesCount := range result.EnvelopesByStorenode {
+			if envelopesCount > 0 {
+				foundBy[id] = true
+			}
+		}
+	} else {
+		envelopesCount, err := m.queryStorenode(m.ctx, *ms, batch, defaultStoreNodeRequestPageSize, nil, false)
+		if err != nil {
+			return err
+		}
+		if envelopesCount > 0 {
+			foundBy[ms.ID] = true
+		}
+	}
+
+	// Messages found in the gap should have been returned by the storenodes which had already synced it
+	m.recordStorenodeGaps(foundBy, batch)
+
 	if m.config.messengerSignalsHandler != nil {
 		m.config.messengerSignalsHandler.HistoryRequestCompleted()
 	}
@@ -8,7 +8,6 @@ import (
 	"math/big"
 	"net"
 	"runtime"
-	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -38,25 +37,6 @@ func (m *Messenger) mailserversByFleet(fleet string) []mailservers.Mailserver {
 	return mailservers.DefaultMailserversByFleet(fleet)
 }
 
-type byRTTMsAndCanConnectBefore []SortedMailserver
-
-func (s byRTTMsAndCanConnectBefore) Len() int {
-	return len(s)
-}
-
-func (s byRTTMsAndCanConnectBefore) Swap(i, j int) {
-	s[i], s[j] = s[j], s[i]
-}
-
-func (s byRTTMsAndCanConnectBefore) Less(i, j int) bool {
-	// Slightly inaccurate as time sensitive sorting, but it does not matter so much
-	now := time.Now()
-	if s[i].CanConnectAfter.Before(now) && s[j].CanConnectAfter.Before(now) {
-		return s[i].RTTMs < s[j].RTTMs
-	}
-	return s[i].CanConnectAfter.Before(s[j].CanConnectAfter)
-}
-
 func (m *Messenger) StartMailserverCycle(mailservers []mailservers.Mailserver) error {
 	m.mailserverCycle.allMailservers = mailservers
 
@@ -235,12 +215,6 @@ func (m *Messenger) allMailservers() ([]mailservers.Mailserver, error) {
 	return matchingMailservers, nil
 }
 
-type SortedMailserver struct {
-	Address         string
-	RTTMs           int
-	CanConnectAfter time.Time
-}
-
 func (m *Messenger) findNewMailserver() error {
 
 	// we have to override DNS manually because of https://github.com/status-im/status-mobile/issues/19581
@@ -311,52 +285,27 @@ func (m *Messenger) findNewMailserver() error {
 			return nil
 		}
 
-		mailserversByAddress := make(map[string]mailservers.Mailserver)
-		for idx := range allMailservers {
-			mailserversByAddress[allMailservers[idx].Address] = allMailservers[idx]
-		}
-		var sortedMailservers []SortedMailserver
-		for _, ping := range availableMailservers {
-			address := ping.Address
-			ms := mailserversByAddress[address]
-			sortedMailserver := SortedMailserver{
-				Address: address,
-				RTTMs:   *ping.RTTMs,
-			}
-			m.mailPeersMutex.Lock()
-			pInfo, ok := m.mailserverCycle.peers[ms.ID]
-			m.mailPeersMutex.Unlock()
-			if ok {
-				if time.Now().Before(pInfo.canConnectAfter) {
-					continue // We can't connect to this node yet
+		rttMs := make(map[string]int)
+		for _, ms := range allMailservers {
+			for _, ping := range availableMailservers {
+				if ping.Address == ms.Address {
+					rttMs[ms.ID] = *ping.RTTMs
 				}
 			}
-
-			sortedMailservers = append(sortedMailservers, sortedMailserver)
-
 		}
-		sort.Sort(byRTTMsAndCanConnectBefore(sortedMailservers))
 
-		// Picks a random mailserver amongs the ones with the lowest latency
-		// The pool size is 1/4 of the mailservers were pinged successfully
-		pSize := poolSize(len(sortedMailservers) - 1)
-		if pSize <= 0 {
-			pSize = len(sortedMailservers)
-			if pSize <= 0 {
-				m.logger.Warn("No mailservers available") // Do nothing...
-				return nil
-			}
-		}
-
-		r, err := rand.Int(rand.Reader, big.NewInt(int64(pSize)))
+		// Picks a random mailserver, weighted by their latency and query history
+		ms, err := m.selectStorenode(allMailservers, rttMs)
 		if err != nil {
 			return err
 		}
+		if ms == nil {
+			m.logger.Warn("No mailservers available") // Do nothing...
+			return nil
+		}
 
-		msPing := sortedMailservers[r.Int64()]
-		ms := mailserversByAddress[msPing.Address]
 		m.logger.Info("connecting to mailserver", zap.String("address", ms.Address))
-		return m.connectToMailserver(ms)
+		return m.connectToMailserver(*ms)
 	}
 
 	mailserversByAddress := make(map[string]mailservers.Mailserver)
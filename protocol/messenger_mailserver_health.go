@@ -0,0 +1,309 @@
+package protocol
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+// A storenode which failed recently is less likely to be picked, even once it's out of the graylist
+const recentStorenodeFailureWindow = 30 * time.Minute
+const recentStorenodeFailurePenalty = 0.25
+
+// Storenodes faster than this are considered as fast as this, so that a fast node isn't picked every time
+const minStorenodeLatencyMs = 10
+
+// StorenodeScore explains how a storenode was ranked during the selection
+type StorenodeScore struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+	// RTTMs is the round trip time measured by the last ping, 0 if the node didn't respond
+	RTTMs             int     `json:"rttMs"`
+	Queries           uint64  `json:"queries"`
+	Failures          uint64  `json:"failures"`
+	Gaps              uint64  `json:"gaps"`
+	LastFailure       int64   `json:"lastFailure"`
+	SuccessRate       float64 `json:"successRate"`
+	Completeness      float64 `json:"completeness"`
+	ResponseTimeP50Ms int64   `json:"responseTimeP50Ms"`
+	ResponseTimeP90Ms int64   `json:"responseTimeP90Ms"`
+	// Score is proportional to the chance of the storenode to be picked
+	Score float64 `json:"score"`
+	// Probability is the chance the storenode had to be picked during the last selection
+	Probability float64 `json:"probability"`
+	// Available is false if the node didn't respond to the ping or was graylisted after failing
+	Available bool `json:"available"`
+	Active    bool `json:"active"`
+}
+
+type storenodeSelection struct {
+	sync.Mutex
+	// rttMs are the round trip times measured by the last ping, by storenode ID
+	rttMs map[string]int
+	// scores are the scores computed during the last selection
+	scores []StorenodeScore
+}
+
+func newStorenodeScore(ms mailservers.Mailserver, health *mailservers.MailserverHealth, rttMs int, now time.Time) StorenodeScore {
+	if health == nil {
+		health = &mailservers.MailserverHealth{ID: ms.ID}
+	}
+	score := StorenodeScore{
+		ID:                ms.ID,
+		Address:           ms.Address,
+		RTTMs:             rttMs,
+		Queries:           health.Queries,
+		Failures:          health.Failures,
+		Gaps:              health.Gaps,
+		LastFailure:       health.LastFailure,
+		SuccessRate:       health.SuccessRate(),
+		Completeness:      health.Completeness(),
+		ResponseTimeP50Ms: health.ResponseTimePercentile(0.5),
+		ResponseTimeP90Ms: health.ResponseTimePercentile(0.9),
+	}
+	score.Score = storenodeScore(score, now)
+	return score
+}
+
+// storenodeScore weights the reliability of the storenode by its latency,
+// the latency is the average of the ping and of the usual response time, when known
+func storenodeScore(s StorenodeScore, now time.Time) float64 {
+	latency := float64(s.RTTMs)
+	if s.ResponseTimeP50Ms > 0 {
+		if latency > 0 {
+			latency = (latency + float64(s.ResponseTimeP50Ms)) / 2
+		} else {
+			latency = float64(s.ResponseTimeP50Ms)
+		}
+	}
+	if latency < minStorenodeLatencyMs {
+		latency = minStorenodeLatencyMs
+	}
+
+	score := 1000 * s.SuccessRate * s.Completeness / latency
+	if s.LastFailure != 0 && now.Sub(time.Unix(s.LastFailure, 0)) < recentStorenodeFailureWindow {
+		score *= recentStorenodeFailurePenalty
+	}
+	return score
+}
+
+// pickStorenode picks a storenode at random, weighted by score, among the available ones
+func pickStorenode(scores []StorenodeScore) (int, error) {
+	var total float64
+	for _, s := range scores {
+		if s.Available {
+			total += s.Score
+		}
+	}
+	if total <= 0 {
+		return -1, nil
+	}
+
+	// Use a fixed precision so that the random number can be drawn from crypto/rand
+	const precision = 1_000_000
+	r, err := rand.Int(rand.Reader, big.NewInt(precision))
+	if err != nil {
+		return -1, err
+	}
+	target := float64(r.Int64()) / precision * total
+
+	last := -1
+	for i := range scores {
+		if !scores[i].Available {
+			continue
+		}
+		last = i
+		target -= scores[i].Score
+		if target < 0 {
+			return i, nil
+		}
+	}
+	return last, nil
+}
+
+// storenodeBackedOff returns whether the storenode is graylisted after failing
+func (m *Messenger) storenodeBackedOff(id string, now time.Time) bool {
+	m.mailPeersMutex.RLock()
+	defer m.mailPeersMutex.RUnlock()
+	pInfo, ok := m.mailserverCycle.peers[id]
+	return ok && now.Before(pInfo.canConnectAfter)
+}
+
+// scoreStorenodes computes the score of each storenode, based on the last ping and the query history
+func (m *Messenger) scoreStorenodes(storenodes []mailservers.Mailserver, rttMs map[string]int, now time.Time) ([]StorenodeScore, error) {
+	var healthByID map[string]*mailservers.MailserverHealth
+	if m.mailserversDatabase != nil {
+		var err error
+		healthByID, err = m.mailserversDatabase.AllMailserverHealth()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scores := make([]StorenodeScore, 0, len(storenodes))
+	for _, ms := range storenodes {
+		score := newStorenodeScore(ms, healthByID[ms.ID], rttMs[ms.ID], now)
+
+		_, responded := rttMs[ms.ID]
+		score.Available = responded && !m.storenodeBackedOff(ms.ID, now)
+
+		scores = append(scores, score)
+	}
+
+	var total float64
+	for _, s := range scores {
+		if s.Available {
+			total += s.Score
+		}
+	}
+	for i := range scores {
+		if scores[i].Available && total > 0 {
+			scores[i].Probability = scores[i].Score / total
+		}
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool { return scores[i].Score > scores[j].Score })
+	return scores, nil
+}
+
+// selectStorenode scores the storenodes and picks one, the scores are kept to explain the choice
+func (m *Messenger) selectStorenode(storenodes []mailservers.Mailserver, rttMs map[string]int) (*mailservers.Mailserver, error) {
+	scores, err := m.scoreStorenodes(storenodes, rttMs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := pickStorenode(scores)
+	if err != nil {
+		return nil, err
+	}
+
+	m.storenodeSelection.Lock()
+	m.storenodeSelection.rttMs = rttMs
+	m.storenodeSelection.scores = scores
+	m.storenodeSelection.Unlock()
+
+	if index < 0 {
+		return nil, nil
+	}
+
+	m.logger.Info("picked storenode",
+		zap.String("id", scores[index].ID),
+		zap.Float64("score", scores[index].Score),
+		zap.Float64("probability", scores[index].Probability))
+
+	for _, ms := range storenodes {
+		if ms.ID == scores[index].ID {
+			ms := ms
+			return &ms, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetStorenodesHealth returns the score of each storenode, based on the last ping and the persisted query history,
+// so that clients can show why a storenode was picked
+func (m *Messenger) GetStorenodesHealth() ([]StorenodeScore, error) {
+	m.storenodeSelection.Lock()
+	rttMs := m.storenodeSelection.rttMs
+	m.storenodeSelection.Unlock()
+
+	scores, err := m.scoreStorenodes(m.mailserverCycle.allMailservers, rttMs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	activeID := m.getActiveMailserverID()
+	for i := range scores {
+		scores[i].Active = scores[i].ID == activeID
+	}
+	return scores, nil
+}
+
+// storenodeHealthRecorder records the outcome and the response time of each query sent to a storenode
+type storenodeHealthRecorder struct {
+	messageRequester
+	storenodeID string
+	database    *mailservers.Database
+	logger      *zap.Logger
+	envelopes   int64
+}
+
+func (r *storenodeHealthRecorder) SendMessagesRequestForTopics(
+	ctx context.Context,
+	peerID []byte,
+	from, to uint32,
+	previousCursor []byte,
+	previousStoreCursor types.StoreRequestCursor,
+	pubsubTopic string,
+	contentTopics []types.TopicType,
+	limit uint32,
+	waitForResponse bool,
+	processEnvelopes bool,
+) (cursor []byte, storeCursor types.StoreRequestCursor, envelopesCount int, err error) {
+	start := time.Now()
+	cursor, storeCursor, envelopesCount, err = r.messageRequester.SendMessagesRequestForTopics(ctx, peerID, from, to, previousCursor, previousStoreCursor, pubsubTopic, contentTopics, limit, waitForResponse, processEnvelopes)
+
+	// Queries cancelled because another one failed are not the storenode's fault
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+	atomic.AddInt64(&r.envelopes, int64(envelopesCount))
+
+	if recordErr := r.database.RecordMailserverQuery(r.storenodeID, err == nil, time.Since(start), time.Now()); recordErr != nil {
+		r.logger.Warn("failed to record storenode query", zap.Error(recordErr))
+	}
+	return
+}
+
+// recordStorenodeCoverage records the range of the topics the storenode returned
+func (m *Messenger) recordStorenodeCoverage(ms mailservers.Mailserver, batch MailserverBatch) {
+	if m.mailserversDatabase == nil {
+		return
+	}
+	var topics []string
+	for _, t := range batch.Topics {
+		topics = append(topics, t.String())
+	}
+	err := m.mailserversDatabase.UpdateMailserverTopicCoverage(ms.ID, batch.PubsubTopic, topics, batch.From, batch.To)
+	if err != nil {
+		m.logger.Warn("failed to record storenode coverage", zap.Error(err))
+	}
+}
+
+// recordStorenodeGaps penalizes the storenodes which had returned the range of the batch,
+// after messages were found in it by the storenodes in foundBy
+func (m *Messenger) recordStorenodeGaps(foundBy map[string]bool, batch MailserverBatch) {
+	if m.mailserversDatabase == nil || len(foundBy) == 0 {
+		return
+	}
+
+	var topics []string
+	for _, t := range batch.Topics {
+		topics = append(topics, t.String())
+	}
+	ids, err := m.mailserversDatabase.MailserversCoveringRange(batch.PubsubTopic, topics, batch.From, batch.To)
+	if err != nil {
+		m.logger.Warn("failed to get storenodes covering range", zap.Error(err))
+		return
+	}
+	for _, id := range ids {
+		if foundBy[id] {
+			continue
+		}
+		m.logger.Info("storenode missed messages", zap.String("storenode", id))
+		if err := m.mailserversDatabase.AddMailserverGap(id); err != nil {
+			m.logger.Warn("failed to record storenode gap", zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+func TestStorenodeScore(t *testing.T) {
+	now := time.Now()
+	ms := mailservers.Mailserver{ID: "storenode"}
+
+	fast := newStorenodeScore(ms, nil, 50, now)
+	slow := newStorenodeScore(ms, nil, 500, now)
+	require.Greater(t, fast.Score, slow.Score)
+
+	unreliable := newStorenodeScore(ms, &mailservers.MailserverHealth{Queries: 10, Failures: 8}, 50, now)
+	require.Greater(t, fast.Score, unreliable.Score)
+
+	incomplete := newStorenodeScore(ms, &mailservers.MailserverHealth{Queries: 10, Gaps: 10}, 50, now)
+	complete := newStorenodeScore(ms, &mailservers.MailserverHealth{Queries: 10}, 50, now)
+	require.Greater(t, complete.Score, incomplete.Score)
+
+	recentFailure := &mailservers.MailserverHealth{Queries: 10, Failures: 1, LastFailure: now.Add(-time.Minute).Unix()}
+	oldFailure := &mailservers.MailserverHealth{Queries: 10, Failures: 1, LastFailure: now.Add(-2 * recentStorenodeFailureWindow).Unix()}
+	require.InDelta(t,
+		newStorenodeScore(ms, oldFailure, 50, now).Score*recentStorenodeFailurePenalty,
+		newStorenodeScore(ms, recentFailure, 50, now).Score,
+		0.0001)
+
+	// The usual response time is taken into account along with the ping
+	slowResponses := &mailservers.MailserverHealth{Queries: 2, ResponseTimes: []int64{2000, 2000}}
+	require.Equal(t, int64(2000), newStorenodeScore(ms, slowResponses, 50, now).ResponseTimeP50Ms)
+	require.Greater(t, newStorenodeScore(ms, &mailservers.MailserverHealth{Queries: 2}, 50, now).Score, newStorenodeScore(ms, slowResponses, 50, now).Score)
+}
+
+func TestPickStorenode(t *testing.T) {
+	index, err := pickStorenode(nil)
+	require.NoError(t, err)
+	require.Equal(t, -1, index)
+
+	index, err = pickStorenode([]StorenodeScore{{ID: "a", Score: 10}})
+	require.NoError(t, err)
+	require.Equal(t, -1, index)
+
+	scores := []StorenodeScore{
+		{ID: "a", Score: 100, Available: false},
+		{ID: "b", Score: 1, Available: true},
+		{ID: "c", Score: 3, Available: true},
+	}
+	picked := make(map[string]int)
+	for i := 0; i < 1000; i++ {
+		index, err := pickStorenode(scores)
+		require.NoError(t, err)
+		picked[scores[index].ID]++
+	}
+	require.Zero(t, picked["a"])
+	require.Greater(t, picked["c"], picked["b"])
+}
@@ -0,0 +1,273 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+const defaultMaxConcurrentHistoryQueries = 6
+
+// historyQuery is a time slice of a batch, for at most maxTopicsPerRequest topics
+type historyQuery struct {
+	batch MailserverBatch
+	// failedOn are the storenodes which failed to answer the query
+	failedOn map[string]bool
+}
+
+type historyQueryResult struct {
+	query     *historyQuery
+	storenode string
+	envelopes int
+	err       error
+}
+
+// historyFetchResult reconciles the outcome of the queries per topic
+type historyFetchResult struct {
+	Envelopes int
+	// EnvelopesByStorenode is the number of envelopes returned by each storenode
+	EnvelopesByStorenode map[string]int
+	// failedTopics are the topics, by pubsub topic, for which a part of the history could not be fetched
+	failedTopics map[string]map[types.TopicType]bool
+}
+
+func newHistoryFetchResult() *historyFetchResult {
+	return &historyFetchResult{
+		EnvelopesByStorenode: make(map[string]int),
+		failedTopics:         make(map[string]map[types.TopicType]bool),
+	}
+}
+
+func (r *historyFetchResult) fail(batch MailserverBatch) {
+	if _, ok := r.failedTopics[batch.PubsubTopic]; !ok {
+		r.failedTopics[batch.PubsubTopic] = make(map[types.TopicType]bool)
+	}
+	for _, topic := range batch.Topics {
+		r.failedTopics[batch.PubsubTopic][topic] = true
+	}
+}
+
+// Synced returns whether the whole requested history of the topic has been fetched
+func (r *historyFetchResult) Synced(pubsubTopic string, topic types.TopicType) bool {
+	return !r.failedTopics[pubsubTopic][topic]
+}
+
+// splitBatchByTime splits the batch in slices of the same duration
+func splitBatchByTime(batch MailserverBatch, slices int) []MailserverBatch {
+	if slices < 2 || batch.To <= batch.From || batch.To-batch.From < uint32(slices) {
+		return []MailserverBatch{batch}
+	}
+
+	duration := (batch.To - batch.From) / uint32(slices)
+	result := make([]MailserverBatch, 0, slices)
+	for i := 0; i < slices; i++ {
+		slice := batch
+		slice.From = batch.From + uint32(i)*duration
+		if i < slices-1 {
+			slice.To = slice.From + duration
+		}
+		result = append(result, slice)
+	}
+	return result
+}
+
+// splitBatchByTopics splits the batch in batches of at most maxTopicsPerRequest topics
+func splitBatchByTopics(batch MailserverBatch) []MailserverBatch {
+	var result []MailserverBatch
+	for i := 0; i < len(batch.Topics); i += maxTopicsPerRequest {
+		j := i + maxTopicsPerRequest
+		if j > len(batch.Topics) {
+			j = len(batch.Topics)
+		}
+		chunk := batch
+		chunk.Topics = batch.Topics[i:j]
+		result = append(result, chunk)
+	}
+	return result
+}
+
+// pickHistoryStorenode returns the least busy storenode which didn't fail the query yet,
+// the storenodes are expected to be sorted by preference
+func pickHistoryStorenode(storenodes []mailservers.Mailserver, inFlight map[string]int, failedOn map[string]bool) (mailservers.Mailserver, bool) {
+	var picked mailservers.Mailserver
+	found := false
+	for _, ms := range storenodes {
+		if failedOn[ms.ID] {
+			continue
+		}
+		if !found || inFlight[ms.ID] < inFlight[picked.ID] {
+			picked = ms
+			found = true
+		}
+	}
+	return picked, found
+}
+
+// fetchHistory queries the batches concurrently on the storenodes. Each topic chunk of each batch is queried
+// on a single storenode and moved to another one when it fails; store cursors being specific to a storenode,
+// it is queried again from the start. Envelopes received more than once are dropped by the transport.
+// The result tells which topics have been fully synced, along with an error when some couldn't be
+func fetchHistory(
+	ctx context.Context,
+	storenodes []mailservers.Mailserver,
+	batches []MailserverBatch,
+	maxConcurrentQueries int,
+	query func(context.Context, mailservers.Mailserver, MailserverBatch) (int, error),
+	logger *zap.Logger,
+) (*historyFetchResult, error) {
+	if maxConcurrentQueries < 1 {
+		maxConcurrentQueries = defaultMaxConcurrentHistoryQueries
+	}
+
+	var pending []*historyQuery
+	for _, batch := range batches {
+		for _, chunk := range splitBatchByTopics(batch) {
+			pending = append(pending, &historyQuery{batch: chunk, failedOn: make(map[string]bool)})
+		}
+	}
+
+	result := newHistoryFetchResult()
+	results := make(chan historyQueryResult)
+	inFlight := make(map[string]int)
+	running := 0
+	var lastErr error
+
+	for len(pending) > 0 || running > 0 {
+		for running < maxConcurrentQueries && len(pending) > 0 && ctx.Err() == nil {
+			q := pending[0]
+			pending = pending[1:]
+
+			ms, ok := pickHistoryStorenode(storenodes, inFlight, q.failedOn)
+			if !ok {
+				result.fail(q.batch)
+				continue
+			}
+
+			inFlight[ms.ID]++
+			running++
+			go func(q *historyQuery, ms mailservers.Mailserver) {
+				envelopes, err := query(ctx, ms, q.batch)
+				results <- historyQueryResult{query: q, storenode: ms.ID, envelopes: envelopes, err: err}
+			}(q, ms)
+		}
+
+		if running == 0 {
+			// Cancelled, or no storenode left for the remaining queries
+			break
+		}
+
+		r := <-results
+		running--
+		inFlight[r.storenode]--
+
+		if r.err != nil {
+			lastErr = r.err
+			if ctx.Err() != nil {
+				result.fail(r.query.batch)
+				continue
+			}
+			logger.Warn("history query failed, trying another storenode",
+				zap.String("storenode", r.storenode),
+				zap.Uint32("from", r.query.batch.From),
+				zap.Uint32("to", r.query.batch.To),
+				zap.Error(r.err))
+			r.query.failedOn[r.storenode] = true
+			pending = append(pending, r.query)
+			continue
+		}
+
+		result.Envelopes += r.envelopes
+		result.EnvelopesByStorenode[r.storenode] += r.envelopes
+	}
+
+	for _, q := range pending {
+		result.fail(q.batch)
+		if lastErr == nil {
+			lastErr = ctx.Err()
+		}
+	}
+
+	if len(result.failedTopics) > 0 {
+		if lastErr == nil {
+			lastErr = errors.New("failed to fetch history")
+		}
+		return result, lastErr
+	}
+	return result, nil
+}
+
+// historyStorenodes returns the storenodes to fetch history from: the given one first,
+// then the best scored available storenodes, up to the configured number.
+// Only the global storenode is fanned out, community storenodes are always queried alone
+func (m *Messenger) historyStorenodes(ms mailservers.Mailserver) []mailservers.Mailserver {
+	storenodes := []mailservers.Mailserver{ms}
+	if m.config.parallelHistoryStorenodes < 2 || m.transport.WakuVersion() != 2 || ms.ID != m.getActiveMailserverID() {
+		return storenodes
+	}
+
+	m.storenodeSelection.Lock()
+	scores := m.storenodeSelection.scores
+	m.storenodeSelection.Unlock()
+
+	now := time.Now()
+	for _, score := range scores {
+		if len(storenodes) >= m.config.parallelHistoryStorenodes {
+			break
+		}
+		if !score.Available || score.ID == ms.ID || m.storenodeBackedOff(score.ID, now) {
+			continue
+		}
+		for _, candidate := range m.mailserverCycle.allMailservers {
+			if candidate.ID == score.ID {
+				storenodes = append(storenodes, candidate)
+				break
+			}
+		}
+	}
+	return storenodes
+}
+
+// fetchHistoryInParallel fetches the batches from the storenodes, see fetchHistory
+func (m *Messenger) fetchHistoryInParallel(storenodes []mailservers.Mailserver, batches []MailserverBatch) (*historyFetchResult, error) {
+	m.logger.Info("fetching history in parallel", zap.Int("storenodes", len(storenodes)), zap.Int("batches", len(batches)))
+	return fetchHistory(m.ctx, storenodes, batches, m.config.maxConcurrentHistoryQueries, func(ctx context.Context, ms mailservers.Mailserver, batch MailserverBatch) (int, error) {
+		return m.queryStorenode(ctx, ms, batch, defaultStoreNodeRequestPageSize, nil, false)
+	}, m.logger)
+}
+
+// raceStorenodes runs fn on each storenode concurrently, with a context cancelled as soon as
+// stop is called. It returns nil if fn succeeded on any storenode
+func raceStorenodes(ctx context.Context, storenodes []mailservers.Mailserver, fn func(ctx context.Context, ms mailservers.Mailserver, stop func()) error) error {
+	raceCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(storenodes))
+	for i, ms := range storenodes {
+		wg.Add(1)
+		go func(i int, ms mailservers.Mailserver) {
+			defer wg.Done()
+			errs[i] = fn(raceCtx, ms, cancel)
+		}(i, ms)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	var lastErr error
+	for _, err := range errs {
+		if err == nil || errors.Is(err, context.Canceled) {
+			return nil
+		}
+		lastErr = err
+	}
+	return lastErr
+}
@@ -0,0 +1,134 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/services/mailservers"
+)
+
+func testHistoryTopics(count int) []types.TopicType {
+	var topics []types.TopicType
+	for i := 0; i < count; i++ {
+		topics = append(topics, types.BytesToTopic([]byte{0, 0, 0, byte(i)}))
+	}
+	return topics
+}
+
+func TestSplitBatchByTime(t *testing.T) {
+	batch := MailserverBatch{From: 100, To: 200, Topics: testHistoryTopics(1)}
+
+	slices := splitBatchByTime(batch, 3)
+	require.Len(t, slices, 3)
+	require.Equal(t, uint32(100), slices[0].From)
+	require.Equal(t, slices[0].To, slices[1].From)
+	require.Equal(t, slices[1].To, slices[2].From)
+	require.Equal(t, uint32(200), slices[2].To)
+
+	require.Equal(t, []MailserverBatch{batch}, splitBatchByTime(batch, 1))
+	require.Equal(t, []MailserverBatch{{From: 100, To: 101}}, splitBatchByTime(MailserverBatch{From: 100, To: 101}, 3))
+}
+
+func TestFetchHistoryFailover(t *testing.T) {
+	storenodes := []mailservers.Mailserver{{ID: "a"}, {ID: "b"}, {ID: "c"}}
+	batches := []MailserverBatch{
+		{From: 0, To: 100, PubsubTopic: "pubsub", Topics: testHistoryTopics(25)},
+		{From: 100, To: 200, PubsubTopic: "pubsub", Topics: testHistoryTopics(25)},
+	}
+
+	var lock sync.Mutex
+	queried := make(map[string]int)
+	result, err := fetchHistory(context.Background(), storenodes, batches, 4, func(ctx context.Context, ms mailservers.Mailserver, batch MailserverBatch) (int, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		require.LessOrEqual(t, len(batch.Topics), maxTopicsPerRequest)
+		queried[ms.ID]++
+		if ms.ID == "a" {
+			return 0, errors.New("storenode unavailable")
+		}
+		return 1, nil
+	}, tt.MustCreateTestLogger())
+	require.NoError(t, err)
+
+	// 3 topic chunks per batch, all eventually answered by b or c
+	require.Equal(t, 6, result.Envelopes)
+	require.Equal(t, 6, result.EnvelopesByStorenode["b"]+result.EnvelopesByStorenode["c"])
+	require.Greater(t, queried["a"], 0)
+	require.Greater(t, queried["b"], 0)
+	require.Greater(t, queried["c"], 0)
+	for _, topic := range testHistoryTopics(25) {
+		require.True(t, result.Synced("pubsub", topic))
+	}
+}
+
+func TestFetchHistoryReconcilesTopics(t *testing.T) {
+	storenodes := []mailservers.Mailserver{{ID: "a"}, {ID: "b"}}
+	topics := testHistoryTopics(15)
+	batches := []MailserverBatch{{From: 0, To: 100, PubsubTopic: "pubsub", Topics: topics}}
+
+	result, err := fetchHistory(context.Background(), storenodes, batches, 2, func(ctx context.Context, ms mailservers.Mailserver, batch MailserverBatch) (int, error) {
+		// No storenode has the history of the second topic chunk
+		if batch.Topics[0] == topics[maxTopicsPerRequest] {
+			return 0, errors.New("query failed")
+		}
+		return 1, nil
+	}, tt.MustCreateTestLogger())
+	require.Error(t, err)
+	require.Equal(t, 1, result.Envelopes)
+
+	for i, topic := range topics {
+		require.Equal(t, i < maxTopicsPerRequest, result.Synced("pubsub", topic))
+	}
+}
+
+func TestFetchHistoryConcurrencyLimit(t *testing.T) {
+	storenodes := []mailservers.Mailserver{{ID: "a"}, {ID: "b"}}
+	var batches []MailserverBatch
+	for i := 0; i < 10; i++ {
+		batches = append(batches, MailserverBatch{From: uint32(i * 100), To: uint32((i + 1) * 100), Topics: testHistoryTopics(1)})
+	}
+
+	var inFlight, maxInFlight int32
+	_, err := fetchHistory(context.Background(), storenodes, batches, 3, func(ctx context.Context, ms mailservers.Mailserver, batch MailserverBatch) (int, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			previous := atomic.LoadInt32(&maxInFlight)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return 0, nil
+	}, tt.MustCreateTestLogger())
+	require.NoError(t, err)
+	require.LessOrEqual(t, maxInFlight, int32(3))
+}
+
+func TestRaceStorenodes(t *testing.T) {
+	storenodes := []mailservers.Mailserver{{ID: "a"}, {ID: "b"}}
+
+	// The first storenode returning the data stops the other one
+	err := raceStorenodes(context.Background(), storenodes, func(ctx context.Context, ms mailservers.Mailserver, stop func()) error {
+		if ms.ID == "a" {
+			stop()
+			return nil
+		}
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	require.NoError(t, err)
+
+	err = raceStorenodes(context.Background(), storenodes, func(ctx context.Context, ms mailservers.Mailserver, stop func()) error {
+		return errors.New("query failed")
+	})
+	require.Error(t, err)
+}
@@ -465,7 +465,8 @@ func (m *Messenger) addContactRequestPropagatedState(message *common.Message) er
 	}
 
 	message.ContactRequestPropagatedState = contact.ContactRequestPropagatedState()
-	return nil
+	message.ContactRequestStamp, err = m.contactRequestStamp(contact)
+	return err
 }
 
 func (m *Messenger) SendOneToOneMessage(request *requests.SendOneToOneMessage) (*MessengerResponse, error) {
@@ -186,3 +186,39 @@ func (s *MessengerMessagesTrackingSuite) TestMessageMarkedAsSent() {
 func (s *MessengerMessagesTrackingSuite) TestSegmentedMessageMarkedAsSent() {
 	s.testMessageMarkedAsSent(4 * 1024 * 1024) // 4MB - ensure message is segmented
 }
+
+func (s *MessengerMessagesTrackingSuite) TestMessageDeliveryDiagnostics() {
+	chat := CreatePublicChat("test-chat", s.bob.getTimesource())
+	err := s.bob.SaveChat(chat)
+	s.Require().NoError(err)
+	inputMessage := buildTestMessage(*chat)
+
+	_, err = s.bob.SendChatMessage(context.Background(), inputMessage)
+	s.Require().NoError(err)
+
+	// The envelope of the message should be published eventually
+	var diagnostics *MessageDeliveryDiagnostics
+	err = tt.RetryWithBackOff(func() error {
+		diagnostics, err = s.bob.GetMessageDeliveryDiagnostics(inputMessage.ID)
+		if err != nil {
+			return err
+		}
+		for _, event := range diagnostics.Timeline {
+			if event.Event == transport.DeliveryEventEnvelopePublished {
+				return nil
+			}
+		}
+		return errors.New("envelope not published yet")
+	})
+	s.Require().NoError(err)
+
+	s.Require().Equal(inputMessage.ID, diagnostics.MessageID)
+	s.Require().Len(diagnostics.Timeline, 3)
+	s.Require().Equal(transport.DeliveryEventSegmentsCreated, diagnostics.Timeline[0].Event)
+	s.Require().Equal(transport.DeliveryEventEnvelopePosted, diagnostics.Timeline[1].Event)
+	s.Require().Equal(diagnostics.Timeline[1].EnvelopeHash, diagnostics.Timeline[2].EnvelopeHash)
+	s.Require().Equal("Relay", diagnostics.Timeline[2].Details)
+
+	_, err = s.bob.GetMessageDeliveryDiagnostics("0x01")
+	s.Require().ErrorIs(err, ErrMessageNotFound)
+}
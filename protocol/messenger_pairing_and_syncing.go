@@ -188,6 +188,26 @@ func (m *Messenger) SyncDevices(ctx context.Context, ensName, photoPath string,
 		}
 	}
 
+	safetyNumberVerifications, err := m.verificationDatabase.GetSafetyNumberVerifications()
+	if err != nil {
+		return err
+	}
+	for _, v := range safetyNumberVerifications {
+		if err = m.syncSafetyNumberVerification(ctx, v, rawMessageHandler); err != nil {
+			return err
+		}
+	}
+
+	contactGroups, err := m.persistence.ContactGroups()
+	if err != nil {
+		return err
+	}
+	for _, g := range contactGroups {
+		if err = m.syncContactGroup(ctx, g, rawMessageHandler); err != nil {
+			return err
+		}
+	}
+
 	err = m.syncSettings(rawMessageHandler)
 	if err != nil {
 		return err
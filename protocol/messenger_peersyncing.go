@@ -19,12 +19,18 @@ import (
 	datasyncpeer "github.com/status-im/status-go/protocol/datasync/peer"
 	"github.com/status-im/status-go/protocol/encryption/sharedsecret"
 	"github.com/status-im/status-go/protocol/peersyncing"
+	"github.com/status-im/status-go/protocol/protobuf"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 )
 
 var peerSyncingLoopInterval time.Duration = 60 * time.Second
 var maxAdvertiseMessages = 40
 
+// maxHandledOfferMessages and maxHandledRequestMessages bound the number of message ids
+// handled from a single offer or request, so that peers can't make us process unbounded lists
+var maxHandledOfferMessages = 4 * maxAdvertiseMessages
+var maxHandledRequestMessages = 4 * maxAdvertiseMessages
+
 func (m *Messenger) markDeliveredMessages(acks [][]byte) {
 	for _, ack := range acks {
 		//get message ID from database by datasync ID, with at-least-one
@@ -137,6 +143,11 @@ func (m *Messenger) sendDatasyncOffers() error {
 		return err
 	}
 
+	err = m.sendDatasyncOffersForPrivateGroupChats()
+	if err != nil {
+		return err
+	}
+
 	// Check all the group ids that need to be on offer
 	// Get all the messages that need to be offered
 	// Prepare datasync messages
@@ -190,6 +201,9 @@ func (m *Messenger) sendDatasyncOffersForCommunities() error {
 
 func (m *Messenger) sendDatasyncOffersForChats() error {
 	for _, chat := range m.Chats() {
+		if !chat.OneToOne() {
+			continue
+		}
 		chatIDBytes := []byte(chat.ID)
 		availableMessagesMap, err := m.peersyncing.AvailableMessagesMapByChatIDs([][]byte{chatIDBytes}, maxAdvertiseMessages)
 		if err != nil {
@@ -224,6 +238,103 @@ func (m *Messenger) sendDatasyncOffersForChats() error {
 	return nil
 }
 
+// sendDatasyncOffersForPrivateGroupChats offers the messages of the group chats to each member privately,
+// so that the membership isn't disclosed to anyone else
+func (m *Messenger) sendDatasyncOffersForPrivateGroupChats() error {
+	for _, chat := range m.Chats() {
+		if !chat.PrivateGroupChat() || !chat.Active || !chat.HasMember(m.myHexIdentity()) {
+			continue
+		}
+
+		offers, err := m.privateGroupChatOffers(chat)
+		if err != nil {
+			return err
+		}
+
+		for memberID, messageIDs := range offers {
+			datasyncMessage := &datasyncproto.Payload{
+				GroupOffers: []*datasyncproto.Offer{{GroupId: []byte(chat.ID), MessageIds: messageIDs}},
+			}
+			payload, err := proto.Marshal(datasyncMessage)
+			if err != nil {
+				return err
+			}
+
+			publicKey, err := common.HexToPubkey(memberID)
+			if err != nil {
+				return err
+			}
+			rawMessage := common.RawMessage{
+				LocalChatID:         memberID,
+				Payload:             payload,
+				Ephemeral:           true,
+				SkipApplicationWrap: true,
+			}
+			_, err = m.sender.SendPrivate(context.Background(), publicKey, &rawMessage)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// privateGroupChatOffers returns the ids of the messages to offer to each member of the group chat,
+// members are only offered the messages sent after they joined
+func (m *Messenger) privateGroupChatOffers(chat *Chat) (map[string][][]byte, error) {
+	messages, err := m.peersyncing.AvailableMessagesByChatID([]byte(chat.ID), maxAdvertiseMessages)
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make(map[string][][]byte)
+	if len(messages) == 0 {
+		return offers, nil
+	}
+
+	for _, member := range chat.Members {
+		if member.ID == m.myHexIdentity() {
+			continue
+		}
+		joinedAt, ok := privateGroupChatJoinedAt(chat, member.ID)
+		if !ok {
+			continue
+		}
+		for _, message := range messages {
+			if message.Timestamp >= joinedAt {
+				offers[member.ID] = append(offers[member.ID], message.ID)
+			}
+		}
+	}
+	return offers, nil
+}
+
+// privateGroupChatJoinedAt returns the timestamp in seconds at which the member was last added to the group chat
+func privateGroupChatJoinedAt(chat *Chat, memberID string) (uint64, bool) {
+	var joinedAt uint64
+	found := false
+	for _, event := range chat.MembershipUpdates {
+		switch event.Type {
+		case protobuf.MembershipUpdateEvent_CHAT_CREATED:
+			if event.From != memberID {
+				continue
+			}
+		case protobuf.MembershipUpdateEvent_MEMBERS_ADDED:
+			if !stringSliceContains(event.Members, memberID) {
+				continue
+			}
+		default:
+			continue
+		}
+		if !found || event.ClockValue > joinedAt {
+			joinedAt = event.ClockValue
+			found = true
+		}
+	}
+	// Membership clocks are in milliseconds
+	return joinedAt / 1000, found
+}
+
 func (m *Messenger) OnDatasyncOffer(response *common.HandleMessageResponse) error {
 	sender := response.DatasyncSender
 	offers := response.DatasyncOffers
@@ -236,9 +347,16 @@ func (m *Messenger) OnDatasyncOffer(response *common.HandleMessageResponse) erro
 		return nil
 	}
 
+	if len(offers) > maxHandledOfferMessages {
+		offers = offers[:maxHandledOfferMessages]
+	}
+
 	var offeredMessages []peersyncing.SyncMessage
 
 	for _, o := range offers {
+		if !m.canAcceptDatasyncOfferFrom(o.GroupID, sender) {
+			continue
+		}
 		offeredMessages = append(offeredMessages, peersyncing.SyncMessage{ChatID: o.GroupID, ID: o.MessageID})
 	}
 
@@ -282,6 +400,16 @@ func (m *Messenger) OnDatasyncOffer(response *common.HandleMessageResponse) erro
 	return nil
 }
 
+// canAcceptDatasyncOfferFrom checks that offers of group chat messages come from members,
+// so that non-members can't find out whether we belong to a group chat
+func (m *Messenger) canAcceptDatasyncOfferFrom(chatID []byte, sender *ecdsa.PublicKey) bool {
+	chat, ok := m.allChats.Load(string(chatID))
+	if !ok || !chat.PrivateGroupChat() {
+		return true
+	}
+	return chat.Active && chat.HasMember(m.myHexIdentity()) && chat.HasMember(common.PubkeyToHex(sender))
+}
+
 // canSyncMessageWith checks the permission of a message
 func (m *Messenger) canSyncMessageWith(message peersyncing.SyncMessage, peer *ecdsa.PublicKey) (bool, error) {
 	switch message.Type {
@@ -302,6 +430,12 @@ func (m *Messenger) canSyncMessageWith(message peersyncing.SyncMessage, peer *ec
 			return false, nil
 		}
 		return m.canSyncOneToOneMessageWith(chat, peer)
+	case peersyncing.SyncMessagePrivateGroup:
+		chat, ok := m.allChats.Load(string(message.ChatID))
+		if !ok {
+			return false, nil
+		}
+		return m.canSyncPrivateGroupMessageWith(chat, message, peer)
 	default:
 		return false, nil
 	}
@@ -318,11 +452,26 @@ func (m *Messenger) canSyncOneToOneMessageWith(chat *Chat, peer *ecdsa.PublicKey
 	return chat.HasMember(common.PubkeyToHex(peer)), nil
 }
 
+// canSyncPrivateGroupMessageWith checks that the peer is a member of the group chat,
+// and that they were already a member when the message was sent
+func (m *Messenger) canSyncPrivateGroupMessageWith(chat *Chat, message peersyncing.SyncMessage, peer *ecdsa.PublicKey) (bool, error) {
+	peerID := common.PubkeyToHex(peer)
+	if !chat.PrivateGroupChat() || !chat.HasMember(peerID) {
+		return false, nil
+	}
+	joinedAt, ok := privateGroupChatJoinedAt(chat, peerID)
+	return ok && message.Timestamp >= joinedAt, nil
+}
+
 func (m *Messenger) OnDatasyncRequests(requester *ecdsa.PublicKey, messageIDs [][]byte) error {
 	if len(messageIDs) == 0 {
 		return nil
 	}
 
+	if len(messageIDs) > maxHandledRequestMessages {
+		messageIDs = messageIDs[:maxHandledRequestMessages]
+	}
+
 	messages, err := m.peersyncing.MessagesByIDs(messageIDs)
 	if err != nil {
 		return err
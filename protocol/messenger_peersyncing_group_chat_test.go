@@ -0,0 +1,128 @@
+package protocol
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/peersyncing"
+)
+
+func TestMessengerPeersyncingGroupChatSuite(t *testing.T) {
+	suite.Run(t, new(MessengerPeersyncingGroupChatSuite))
+}
+
+type MessengerPeersyncingGroupChatSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerPeersyncingGroupChatSuite) addSyncMessage(m *Messenger, chat *Chat, id string, timestamp uint64) peersyncing.SyncMessage {
+	syncMessage := peersyncing.SyncMessage{
+		ID:        []byte(id),
+		ChatID:    []byte(chat.ID),
+		Type:      peersyncing.SyncMessagePrivateGroup,
+		Payload:   []byte("some-payload"),
+		Timestamp: timestamp,
+	}
+	s.Require().NoError(m.peersyncing.Add(syncMessage))
+	return syncMessage
+}
+
+func (s *MessengerPeersyncingGroupChatSuite) TestMemberJoinedMidHistory() {
+	alice := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	charlie := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, charlie)
+	dave, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(alice, &charlie.identity.PublicKey))
+	bobID := common.PubkeyToHex(&bob.identity.PublicKey)
+	charlieID := common.PubkeyToHex(&charlie.identity.PublicKey)
+
+	response, err := alice.CreateGroupChatWithMembers(context.Background(), "group", []string{bobID})
+	s.Require().NoError(err)
+	s.Require().Len(response.Chats(), 1)
+	chat := response.Chats()[0]
+
+	bobJoinedAt, ok := privateGroupChatJoinedAt(chat, bobID)
+	s.Require().True(ok)
+	before := s.addSyncMessage(alice, chat, "before", bobJoinedAt)
+
+	// Charlie is added a minute later
+	chat.LastClockValue = (bobJoinedAt + 60) * 1000
+	response, err = alice.AddMembersToGroupChat(context.Background(), chat.ID, []string{charlieID})
+	s.Require().NoError(err)
+	chat = response.Chats()[0]
+
+	charlieJoinedAt, ok := privateGroupChatJoinedAt(chat, charlieID)
+	s.Require().True(ok)
+	s.Require().Greater(charlieJoinedAt, bobJoinedAt)
+	after := s.addSyncMessage(alice, chat, "after", charlieJoinedAt+1)
+
+	offers, err := alice.privateGroupChatOffers(chat)
+	s.Require().NoError(err)
+	s.Require().Len(offers, 2)
+	s.Require().ElementsMatch([][]byte{before.ID, after.ID}, offers[bobID])
+	s.Require().Equal([][]byte{after.ID}, offers[charlieID])
+
+	canSync, err := alice.canSyncMessageWith(before, &bob.identity.PublicKey)
+	s.Require().NoError(err)
+	s.Require().True(canSync)
+
+	canSync, err = alice.canSyncMessageWith(before, &charlie.identity.PublicKey)
+	s.Require().NoError(err)
+	s.Require().False(canSync)
+
+	canSync, err = alice.canSyncMessageWith(after, &charlie.identity.PublicKey)
+	s.Require().NoError(err)
+	s.Require().True(canSync)
+
+	canSync, err = alice.canSyncMessageWith(after, &dave.PublicKey)
+	s.Require().NoError(err)
+	s.Require().False(canSync)
+}
+
+func (s *MessengerPeersyncingGroupChatSuite) TestOffersFromNonMembers() {
+	alice := s.m
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	dave := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, dave)
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	bobID := common.PubkeyToHex(&bob.identity.PublicKey)
+
+	response, err := alice.CreateGroupChatWithMembers(context.Background(), "group", []string{bobID})
+	s.Require().NoError(err)
+	chat := response.Chats()[0]
+
+	s.Require().True(alice.canAcceptDatasyncOfferFrom([]byte(chat.ID), &bob.identity.PublicKey))
+	s.Require().False(alice.canAcceptDatasyncOfferFrom([]byte(chat.ID), &dave.identity.PublicKey))
+
+	offer := func(sender *Messenger, count int) {
+		var offers []common.DatasyncOffer
+		for i := 0; i < count; i++ {
+			offers = append(offers, common.DatasyncOffer{GroupID: []byte(chat.ID), MessageID: []byte(fmt.Sprintf("%s-%d", sender.myHexIdentity(), i))})
+		}
+		err := alice.OnDatasyncOffer(&common.HandleMessageResponse{
+			DatasyncSender: &sender.identity.PublicKey,
+			DatasyncOffers: offers,
+		})
+		s.Require().NoError(err)
+	}
+
+	// Offers of non-members are ignored
+	offer(dave, 1)
+	s.Require().Empty(alice.peersyncingOffers)
+
+	// Offers are bounded
+	offer(bob, maxHandledOfferMessages+10)
+	s.Require().Len(alice.peersyncingOffers, maxHandledOfferMessages)
+}
@@ -90,6 +90,7 @@ type MessengerResponse struct {
 	trustStatus                      map[string]verification.TrustStatus
 	emojiReactions                   map[string]*EmojiReaction
 	savedAddresses                   map[string]*wallet.SavedAddress
+	contactGroups                    map[string]*ContactGroup
 	ensUsernameDetails               []*ensservice.UsernameDetail
 	updatedProfileShowcaseContactIDs map[string]bool
 	seenAndUnseenMessages            map[string]*SeenUnseenMessages
@@ -138,6 +139,7 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		DiscordMessages                  []*protobuf.DiscordMessage              `json:"discordMessages,omitempty"`
 		DiscordMessageAttachments        []*protobuf.DiscordMessageAttachment    `json:"discordMessageAtachments,omitempty"`
 		SavedAddresses                   []*wallet.SavedAddress                  `json:"savedAddresses,omitempty"`
+		ContactGroups                    []*ContactGroup                         `json:"contactGroups,omitempty"`
 		EnsUsernameDetails               []*ensservice.UsernameDetail            `json:"ensUsernameDetails,omitempty"`
 		UpdatedProfileShowcaseContactIDs []string                                `json:"updatedProfileShowcaseContactIDs,omitempty"`
 		SeenAndUnseenMessages            []*SeenUnseenMessages                   `json:"seenAndUnseenMessages,omitempty"`
@@ -163,6 +165,7 @@ func (r *MessengerResponse) MarshalJSON() ([]byte, error) {
 		Messages:                         r.Messages(),
 		VerificationRequests:             r.VerificationRequests(),
 		SavedAddresses:                   r.SavedAddresses(),
+		ContactGroups:                    r.ContactGroups(),
 		Notifications:                    r.Notifications(),
 		Chats:                            r.Chats(),
 		Communities:                      r.Communities(),
@@ -332,6 +335,7 @@ func (r *MessengerResponse) IsEmpty() bool {
 		len(r.verificationRequests)+
 		len(r.requestsToJoinCommunity)+
 		len(r.savedAddresses)+
+		len(r.contactGroups)+
 		len(r.updatedProfileShowcaseContactIDs)+
 		len(r.seenAndUnseenMessages)+
 		len(r.ensUsernameDetails) == 0 &&
@@ -368,6 +372,7 @@ func (r *MessengerResponse) Merge(response *MessengerResponse) error {
 	r.AddEmojiReactions(response.EmojiReactions())
 	r.AddInstallations(response.Installations())
 	r.AddSavedAddresses(response.SavedAddresses())
+	r.AddContactGroups(response.ContactGroups())
 	r.AddEnsUsernameDetails(response.EnsUsernameDetails())
 	r.AddRequestsToJoinCommunity(response.RequestsToJoinCommunity())
 	r.AddBookmarks(response.GetBookmarks())
@@ -539,6 +544,24 @@ func (r *MessengerResponse) SavedAddresses() []*wallet.SavedAddress {
 	return maps.Values(r.savedAddresses)
 }
 
+func (r *MessengerResponse) AddContactGroups(groups []*ContactGroup) {
+	for _, g := range groups {
+		r.AddContactGroup(g)
+	}
+}
+
+func (r *MessengerResponse) AddContactGroup(group *ContactGroup) {
+	if r.contactGroups == nil {
+		r.contactGroups = make(map[string]*ContactGroup)
+	}
+
+	r.contactGroups[group.ID] = group
+}
+
+func (r *MessengerResponse) ContactGroups() []*ContactGroup {
+	return maps.Values(r.contactGroups)
+}
+
 func (r *MessengerResponse) AddEnsUsernameDetail(detail *ensservice.UsernameDetail) {
 	r.ensUsernameDetails = append(r.ensUsernameDetails, detail)
 }
@@ -0,0 +1,327 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption"
+	"github.com/status-im/status-go/protocol/encryption/multidevice"
+	"github.com/status-im/status-go/protocol/protobuf"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/protocol/verification"
+)
+
+var ErrCannotVerifyOwnSafetyNumber = errors.New("can't verify our own safety number")
+
+// bundleInstallationKeys returns the signed pre keys of the installations of the bundle,
+// the keys used to establish the encryption sessions with each installation
+func bundleInstallationKeys(bundle *encryption.Bundle) [][]byte {
+	if bundle == nil {
+		return nil
+	}
+
+	keys := make([][]byte, 0, len(bundle.SignedPreKeys))
+	for _, signedPreKey := range bundle.SignedPreKeys {
+		keys = append(keys, signedPreKey.SignedPreKey)
+	}
+	return keys
+}
+
+// safetyNumberFingerprints returns our fingerprint and the one of the contact,
+// derived from the identity keys and the keys of the active installations,
+// along with the installation keys of the contact
+func (m *Messenger) safetyNumberFingerprints(contactPublicKey *ecdsa.PublicKey) ([]byte, []byte, [][]byte, error) {
+	ourBundle, err := m.encryptor.GetBundle(m.identity)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	theirBundle, err := m.encryptor.GetPublicBundle(contactPublicKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	theirKeys := bundleInstallationKeys(theirBundle)
+	ourFingerprint := verification.Fingerprint(crypto.CompressPubkey(&m.identity.PublicKey), bundleInstallationKeys(ourBundle))
+	theirFingerprint := verification.Fingerprint(crypto.CompressPubkey(contactPublicKey), theirKeys)
+
+	return ourFingerprint, theirFingerprint, theirKeys, nil
+}
+
+func (m *Messenger) contactSafetyNumberPublicKey(contactID string) (*ecdsa.PublicKey, error) {
+	contactPublicKey, err := common.HexToPubkey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsPubKeyEqual(contactPublicKey, &m.identity.PublicKey) {
+		return nil, ErrCannotVerifyOwnSafetyNumber
+	}
+
+	return contactPublicKey, nil
+}
+
+// GetSafetyNumber returns the safety number shared with the contact
+func (m *Messenger) GetSafetyNumber(contactID string) (*verification.SafetyNumber, error) {
+	contactPublicKey, err := m.contactSafetyNumberPublicKey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	ourFingerprint, theirFingerprint, _, err := m.safetyNumberFingerprints(contactPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyNumber := verification.NewSafetyNumber(ourFingerprint, theirFingerprint)
+
+	v, err := m.verificationDatabase.GetSafetyNumberVerification(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if v != nil && v.Verified {
+		sameFingerprint := bytes.Equal(v.Fingerprint, theirFingerprint)
+		safetyNumber.Verified = sameFingerprint
+		safetyNumber.Changed = v.Changed || !sameFingerprint
+	}
+
+	return safetyNumber, nil
+}
+
+// VerifySafetyNumber marks the safety number as verified, after the user compared
+// the numeric form with the one displayed by the contact
+func (m *Messenger) VerifySafetyNumber(ctx context.Context, contactID string, numeric string) (*verification.SafetyNumber, error) {
+	contactPublicKey, err := m.contactSafetyNumberPublicKey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	ourFingerprint, theirFingerprint, theirKeys, err := m.safetyNumberFingerprints(contactPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyNumber := verification.NewSafetyNumber(ourFingerprint, theirFingerprint)
+	err = safetyNumber.MatchNumeric(numeric)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.setSafetyNumberVerified(ctx, contactID, safetyNumber, theirFingerprint, theirKeys, true)
+}
+
+// VerifySafetyNumberQRCode marks the safety number as verified, after the user scanned
+// the QR code displayed by the contact
+func (m *Messenger) VerifySafetyNumberQRCode(ctx context.Context, contactID string, qrCode string) (*verification.SafetyNumber, error) {
+	contactPublicKey, err := m.contactSafetyNumberPublicKey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	ourFingerprint, theirFingerprint, theirKeys, err := m.safetyNumberFingerprints(contactPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verification.VerifySafetyNumberQRCode(ourFingerprint, theirFingerprint, qrCode)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyNumber := verification.NewSafetyNumber(ourFingerprint, theirFingerprint)
+	return m.setSafetyNumberVerified(ctx, contactID, safetyNumber, theirFingerprint, theirKeys, true)
+}
+
+// UnverifySafetyNumber removes the verification of the safety number
+func (m *Messenger) UnverifySafetyNumber(ctx context.Context, contactID string) (*verification.SafetyNumber, error) {
+	contactPublicKey, err := m.contactSafetyNumberPublicKey(contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	ourFingerprint, theirFingerprint, theirKeys, err := m.safetyNumberFingerprints(contactPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyNumber := verification.NewSafetyNumber(ourFingerprint, theirFingerprint)
+	return m.setSafetyNumberVerified(ctx, contactID, safetyNumber, theirFingerprint, theirKeys, false)
+}
+
+func (m *Messenger) setSafetyNumberVerified(ctx context.Context, contactID string, safetyNumber *verification.SafetyNumber, fingerprint []byte, installationKeys [][]byte, verified bool) (*verification.SafetyNumber, error) {
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	v := &verification.SafetyNumberVerification{
+		ContactID:        contactID,
+		Fingerprint:      fingerprint,
+		InstallationKeys: installationKeys,
+		Verified:         verified,
+		Clock:            clock,
+	}
+
+	_, err := m.verificationDatabase.UpsertSafetyNumberVerification(v)
+	if err != nil {
+		return nil, err
+	}
+
+	chat.LastClockValue = clock
+	err = m.saveChat(chat)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.syncSafetyNumberVerification(ctx, v, m.dispatchMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	safetyNumber.Verified = verified
+	return safetyNumber, nil
+}
+
+func (m *Messenger) syncSafetyNumberVerification(ctx context.Context, v *verification.SafetyNumberVerification, rawMessageHandler RawMessageHandler) error {
+	if !m.hasPairedDevices() {
+		return nil
+	}
+
+	clock, chat := m.getLastClockWithRelatedChat()
+
+	syncMessage := &protobuf.SyncSafetyNumberVerification{
+		Clock:            v.Clock,
+		ContactId:        v.ContactID,
+		Fingerprint:      v.Fingerprint,
+		Verified:         v.Verified,
+		InstallationKeys: v.InstallationKeys,
+	}
+	encodedMessage, err := proto.Marshal(syncMessage)
+	if err != nil {
+		return err
+	}
+
+	rawMessage := common.RawMessage{
+		LocalChatID: chat.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_SYNC_SAFETY_NUMBER_VERIFICATION,
+		ResendType:  common.ResendTypeDataSync,
+	}
+
+	_, err = rawMessageHandler(ctx, rawMessage)
+	if err != nil {
+		return err
+	}
+
+	chat.LastClockValue = clock
+	return m.saveChat(chat)
+}
+
+// HandleSyncSafetyNumberVerification saves the verification made on a paired device.
+// The fingerprint is derived from the synced installation keys so that all our devices
+// compare the contact's keys against the same fingerprint
+func (m *Messenger) HandleSyncSafetyNumberVerification(state *ReceivedMessageState, message *protobuf.SyncSafetyNumberVerification, statusMessage *v1protocol.StatusMessage) error {
+	fingerprint := message.Fingerprint
+	if len(message.InstallationKeys) > 0 {
+		contactPublicKey, err := common.HexToPubkey(message.ContactId)
+		if err != nil {
+			return err
+		}
+		fingerprint = verification.Fingerprint(crypto.CompressPubkey(contactPublicKey), message.InstallationKeys)
+	}
+
+	_, err := m.verificationDatabase.UpsertSafetyNumberVerification(&verification.SafetyNumberVerification{
+		ContactID:        message.ContactId,
+		Fingerprint:      fingerprint,
+		InstallationKeys: message.InstallationKeys,
+		Verified:         message.Verified,
+		Clock:            message.Clock,
+	})
+	return err
+}
+
+// checkSafetyNumbersChanged warns the user when new installations of contacts,
+// whose safety number was verified, are discovered
+func (m *Messenger) checkSafetyNumbersChanged(response *MessengerResponse, installations []*multidevice.Installation) error {
+	ownID := contactIDFromPublicKey(&m.identity.PublicKey)
+	checked := make(map[string]bool)
+
+	for _, installation := range installations {
+		contactID := installation.Identity
+		if contactID == ownID || checked[contactID] {
+			continue
+		}
+		checked[contactID] = true
+
+		err := m.checkSafetyNumberChanged(response, contactID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Messenger) checkSafetyNumberChanged(response *MessengerResponse, contactID string) error {
+	v, err := m.verificationDatabase.GetSafetyNumberVerification(contactID)
+	if err != nil {
+		return err
+	}
+
+	if v == nil || !v.Verified || v.Changed {
+		return nil
+	}
+
+	contactPublicKey, err := common.HexToPubkey(contactID)
+	if err != nil {
+		return err
+	}
+
+	_, theirFingerprint, _, err := m.safetyNumberFingerprints(contactPublicKey)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(v.Fingerprint, theirFingerprint) {
+		return nil
+	}
+
+	changed, err := m.verificationDatabase.SetSafetyNumberChanged(contactID)
+	if err != nil || !changed {
+		return err
+	}
+
+	m.logger.Info("safety number changed", zap.String("contactID", contactID))
+
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.SafetyNumberChanged(contactID)
+	}
+
+	contact, ok := m.allContacts.Load(contactID)
+	if !ok {
+		contact, err = buildContactFromPkString(contactID)
+		if err != nil {
+			return err
+		}
+	}
+
+	notification := &ActivityCenterNotification{
+		ID:        types.FromHex(uuid.New().String()),
+		Type:      ActivityCenterNotificationTypeSafetyNumberChanged,
+		Name:      contact.PrimaryName(),
+		Author:    contact.ID,
+		Timestamp: m.getTimesource().GetCurrentTime(),
+		ChatID:    contact.ID,
+		Read:      false,
+		UpdatedAt: m.GetCurrentTimeInMillis(),
+	}
+
+	return m.addActivityCenterNotification(response, notification, nil)
+}
@@ -0,0 +1,194 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/protocol/verification"
+)
+
+func TestMessengerSafetyNumbersSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSafetyNumbersSuite))
+}
+
+type MessengerSafetyNumbersSuite struct {
+	MessengerBaseTestSuite
+}
+
+func (s *MessengerSafetyNumbersSuite) sendAndReceiveMessage(from *Messenger, to *Messenger, text string) {
+	chat := CreateOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&to.identity.PublicKey)), &to.identity.PublicKey, from.transport)
+	s.Require().NoError(from.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = text
+	_, err := from.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+
+	_, err = WaitOnMessengerResponse(
+		to,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == text {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSafetyNumbersSuite) TestVerifySafetyNumber() {
+	alice := s.m
+	aliceID := types.EncodeHex(crypto.FromECDSAPub(&alice.identity.PublicKey))
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	bobID := types.EncodeHex(crypto.FromECDSAPub(&bob.identity.PublicKey))
+
+	s.sendAndReceiveMessage(alice, bob, "hello bob")
+	s.sendAndReceiveMessage(bob, alice, "hello alice")
+
+	_, err := alice.GetSafetyNumber(aliceID)
+	s.Require().ErrorIs(err, ErrCannotVerifyOwnSafetyNumber)
+
+	aliceSafetyNumber, err := alice.GetSafetyNumber(bobID)
+	s.Require().NoError(err)
+	s.Require().False(aliceSafetyNumber.Verified)
+	s.Require().False(aliceSafetyNumber.Changed)
+
+	bobSafetyNumber, err := bob.GetSafetyNumber(aliceID)
+	s.Require().NoError(err)
+	s.Require().Equal(aliceSafetyNumber.Numeric, bobSafetyNumber.Numeric)
+
+	// Alice compares the numbers
+	_, err = alice.VerifySafetyNumber(context.Background(), bobID, "123")
+	s.Require().ErrorIs(err, verification.ErrSafetyNumberMismatch)
+
+	aliceSafetyNumber, err = alice.VerifySafetyNumber(context.Background(), bobID, bobSafetyNumber.Numeric)
+	s.Require().NoError(err)
+	s.Require().True(aliceSafetyNumber.Verified)
+
+	// Bob scans Alice's QR code
+	bobSafetyNumber, err = bob.VerifySafetyNumberQRCode(context.Background(), aliceID, aliceSafetyNumber.QRCode)
+	s.Require().NoError(err)
+	s.Require().True(bobSafetyNumber.Verified)
+
+	bobSafetyNumber, err = bob.UnverifySafetyNumber(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Require().False(bobSafetyNumber.Verified)
+
+	// Bob uses a new device, Alice is warned
+	bob2, err := newMessengerWithKey(s.shh, bob.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, bob2)
+
+	prepareAliceMessengersForPairing(&s.Suite, bob, bob2)
+	PairDevices(&s.Suite, bob2, bob)
+	PairDevices(&s.Suite, bob, bob2)
+
+	s.sendAndReceiveMessage(bob2, alice, "new device")
+
+	err = tt.RetryWithBackOff(func() error {
+		response, err := alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, notification := range response.ActivityCenterNotifications() {
+			if notification.Type == ActivityCenterNotificationTypeSafetyNumberChanged && notification.Author == bobID {
+				return nil
+			}
+		}
+		safetyNumber, err := alice.GetSafetyNumber(bobID)
+		if err != nil {
+			return err
+		}
+		if !safetyNumber.Changed {
+			return errors.New("safety number not changed")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	notifications, err := alice.ActivityCenterNotifications(ActivityCenterNotificationsRequest{
+		Limit:         10,
+		ActivityTypes: []ActivityCenterType{ActivityCenterNotificationTypeSafetyNumberChanged},
+		ReadType:      ActivityCenterQueryParamsReadUnread,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(notifications.Notifications, 1)
+
+	aliceSafetyNumber, err = alice.GetSafetyNumber(bobID)
+	s.Require().NoError(err)
+	s.Require().False(aliceSafetyNumber.Verified)
+	s.Require().True(aliceSafetyNumber.Changed)
+
+	// Verifying the new safety number clears the warning
+	// Bob's new device learns about Alice's installations
+	s.sendAndReceiveMessage(alice, bob2, "welcome")
+
+	bobSafetyNumber, err = bob2.GetSafetyNumber(aliceID)
+	s.Require().NoError(err)
+	aliceSafetyNumber, err = alice.VerifySafetyNumber(context.Background(), bobID, bobSafetyNumber.Numeric)
+	s.Require().NoError(err)
+	s.Require().True(aliceSafetyNumber.Verified)
+	s.Require().False(aliceSafetyNumber.Changed)
+}
+
+func (s *MessengerSafetyNumbersSuite) TestSyncSafetyNumberVerification() {
+	alice := s.m
+
+	alice2, err := newMessengerWithKey(s.shh, alice.identity, s.logger, nil)
+	s.Require().NoError(err)
+	defer TearDownMessenger(&s.Suite, alice2)
+
+	prepareAliceMessengersForPairing(&s.Suite, alice, alice2)
+	PairDevices(&s.Suite, alice2, alice)
+	PairDevices(&s.Suite, alice, alice2)
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	bobID := types.EncodeHex(crypto.FromECDSAPub(&bob.identity.PublicKey))
+
+	s.sendAndReceiveMessage(bob, alice, "hello alice")
+
+	safetyNumber, err := alice.GetSafetyNumber(bobID)
+	s.Require().NoError(err)
+
+	_, err = alice.VerifySafetyNumber(context.Background(), bobID, safetyNumber.Numeric)
+	s.Require().NoError(err)
+
+	verified, err := alice.verificationDatabase.GetSafetyNumberVerification(bobID)
+	s.Require().NoError(err)
+	s.Require().Len(verified.InstallationKeys, 1)
+
+	err = tt.RetryWithBackOff(func() error {
+		_, err := alice2.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		v, err := alice2.verificationDatabase.GetSafetyNumberVerification(bobID)
+		if err != nil {
+			return err
+		}
+		if v == nil || !v.Verified {
+			return errors.New("safety number verification not synced")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// The fingerprint is derived from the synced keys, not from alice2's view of bob's installations
+	synced, err := alice2.verificationDatabase.GetSafetyNumberVerification(bobID)
+	s.Require().NoError(err)
+	s.Require().Equal(verified.InstallationKeys, synced.InstallationKeys)
+	s.Require().Equal(verification.Fingerprint(crypto.CompressPubkey(&bob.identity.PublicKey), verified.InstallationKeys), synced.Fingerprint)
+	s.Require().Equal(verified.Fingerprint, synced.Fingerprint)
+}
@@ -0,0 +1,246 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/encryption"
+	"github.com/status-im/status-go/protocol/protobuf"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+	"github.com/status-im/status-go/signal"
+)
+
+const (
+	// sessionOutOfSyncThreshold is the number of messages from a contact which could not be
+	// decrypted after which the session with them is considered out of sync
+	sessionOutOfSyncThreshold = 3
+	// sessionResetResendMargin is subtracted from the time of the first decryption failure
+	// when asking the contact to resend their messages, to account for delayed messages
+	sessionResetResendMargin = 10 * time.Minute
+	// sessionResetDefaultResendPeriod is used when the session is reset without any decryption failure
+	sessionResetDefaultResendPeriod = 24 * time.Hour
+	// sessionResetMaxResendPeriod caps how far back messages are resent when a contact asks for it
+	sessionResetMaxResendPeriod = 7 * 24 * time.Hour
+	// sessionResetMinInterval is the minimum time between two session resets handled for the same
+	// contact, as each of them resends up to sessionResetMaxResendPeriod of messages
+	sessionResetMinInterval = 30 * time.Minute
+)
+
+var ErrCannotResetOwnSession = errors.New("can't reset the session with ourselves")
+
+// sessionDecryptionFailures keeps track of the messages from a contact which could not be decrypted
+type sessionDecryptionFailures struct {
+	count uint
+	// firstFailure is the time, in milliseconds, of the first failure
+	firstFailure uint64
+}
+
+// handleDecryptionFailure is called every time a message from a contact using the double ratchet
+// could not be decrypted, once the threshold is reached the session is signaled as out of sync
+func (m *Messenger) handleDecryptionFailure(failure *encryption.DecryptionFailure) {
+	if common.IsPubKeyEqual(failure.PublicKey, &m.identity.PublicKey) {
+		return
+	}
+
+	publicKey := types.EncodeHex(crypto.FromECDSAPub(failure.PublicKey))
+	m.logger.Debug("failed to decrypt message",
+		zap.String("publicKey", publicKey),
+		zap.String("installationID", failure.InstallationID),
+		zap.Error(failure.Error))
+
+	m.sessionDecryptionFailuresMutex.Lock()
+	failures, ok := m.sessionDecryptionFailures[publicKey]
+	if !ok {
+		failures = &sessionDecryptionFailures{firstFailure: m.getTimesource().GetCurrentTime()}
+		m.sessionDecryptionFailures[publicKey] = failures
+	}
+	failures.count++
+	count := failures.count
+	m.sessionDecryptionFailuresMutex.Unlock()
+
+	if count == sessionOutOfSyncThreshold && m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.SessionOutOfSync(publicKey, count)
+	}
+}
+
+// sessionResetResendSince returns the time since which the contact is asked to resend their messages
+func (m *Messenger) sessionResetResendSince(publicKey string) uint64 {
+	m.sessionDecryptionFailuresMutex.Lock()
+	defer m.sessionDecryptionFailuresMutex.Unlock()
+
+	now := m.getTimesource().GetCurrentTime()
+	since := now - uint64(sessionResetDefaultResendPeriod.Milliseconds())
+	if failures, ok := m.sessionDecryptionFailures[publicKey]; ok {
+		since = failures.firstFailure - uint64(sessionResetResendMargin.Milliseconds())
+	}
+	return since
+}
+
+// acceptSessionReset tells whether a session reset asked by the contact is handled,
+// only mutual contacts can ask for it and at most once per sessionResetMinInterval
+func (m *Messenger) acceptSessionReset(contact *Contact) bool {
+	if contact == nil || !contact.mutual() {
+		return false
+	}
+
+	m.sessionDecryptionFailuresMutex.Lock()
+	defer m.sessionDecryptionFailuresMutex.Unlock()
+
+	now := m.getTimesource().GetCurrentTime()
+	if last, ok := m.sessionResetsHandled[contact.ID]; ok && now < last+uint64(sessionResetMinInterval.Milliseconds()) {
+		return false
+	}
+	m.sessionResetsHandled[contact.ID] = now
+	return true
+}
+
+func (m *Messenger) clearDecryptionFailures(publicKey string) {
+	m.sessionDecryptionFailuresMutex.Lock()
+	defer m.sessionDecryptionFailuresMutex.Unlock()
+
+	delete(m.sessionDecryptionFailures, publicKey)
+}
+
+// ResetContactSession resets the encryption session with the contact, a new one is established
+// using their latest bundle. The contact is asked to reset the session as well and to resend
+// the messages we could not decrypt.
+func (m *Messenger) ResetContactSession(publicKey string) error {
+	contactPublicKey, err := common.HexToPubkey(publicKey)
+	if err != nil {
+		return err
+	}
+
+	if common.IsPubKeyEqual(contactPublicKey, &m.identity.PublicKey) {
+		return ErrCannotResetOwnSession
+	}
+
+	contact, err := buildContactFromPkString(publicKey)
+	if err != nil {
+		return err
+	}
+
+	err = m.encryptor.ResetSession(contactPublicKey)
+	if err != nil {
+		return err
+	}
+
+	err = m.sendSessionReset(contact, m.sessionResetResendSince(contact.ID), false)
+	if err != nil {
+		return err
+	}
+
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.SessionReset(contact.ID, signal.SessionResetRequested, 0)
+	}
+
+	return nil
+}
+
+func (m *Messenger) sendSessionReset(contact *Contact, resendSince uint64, ack bool) error {
+	_, clock, err := m.getOneToOneAndNextClock(contact)
+	if err != nil {
+		return err
+	}
+
+	encodedMessage, err := proto.Marshal(&protobuf.SessionReset{
+		Clock:       clock,
+		ResendSince: resendSince,
+		Ack:         ack,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = m.dispatchMessage(context.Background(), common.RawMessage{
+		LocalChatID: contact.ID,
+		Payload:     encodedMessage,
+		MessageType: protobuf.ApplicationMetadataMessage_SESSION_RESET,
+	})
+	return err
+}
+
+func (m *Messenger) HandleSessionReset(state *ReceivedMessageState, message *protobuf.SessionReset, statusMessage *v1protocol.StatusMessage) error {
+	// Our paired devices receive the session resets we send
+	if common.IsPubKeyEqual(state.CurrentMessageState.PublicKey, &m.identity.PublicKey) {
+		return nil
+	}
+
+	contact := state.CurrentMessageState.Contact
+	if !message.Ack && !m.acceptSessionReset(contact) {
+		m.logger.Warn("ignoring session reset", zap.String("publicKey", contact.ID))
+		return nil
+	}
+
+	m.clearDecryptionFailures(contact.ID)
+
+	if message.Ack {
+		if m.config.messengerSignalsHandler != nil {
+			m.config.messengerSignalsHandler.SessionReset(contact.ID, signal.SessionResetCompleted, 0)
+		}
+		return nil
+	}
+
+	err := m.encryptor.ResetSession(state.CurrentMessageState.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	resentMessages, err := m.resendMessagesSince(contact.ID, message.ResendSince)
+	if err != nil {
+		m.logger.Error("failed to resend messages after session reset", zap.String("publicKey", contact.ID), zap.Error(err))
+	}
+
+	err = m.sendSessionReset(contact, 0, true)
+	if err != nil {
+		return err
+	}
+
+	if m.config.messengerSignalsHandler != nil {
+		m.config.messengerSignalsHandler.SessionReset(contact.ID, signal.SessionResetByContact, resentMessages)
+	}
+
+	return nil
+}
+
+// resendMessagesSince resends the messages of the one to one chat sent since the given time
+func (m *Messenger) resendMessagesSince(chatID string, since uint64) (int, error) {
+	if _, ok := m.allChats.Load(chatID); !ok {
+		return 0, nil
+	}
+
+	oldest := m.getTimesource().GetCurrentTime() - uint64(sessionResetMaxResendPeriod.Milliseconds())
+	if since < oldest {
+		since = oldest
+	}
+
+	ids, err := m.persistence.RawMessagesIDsByChatSince(chatID, since)
+	if err != nil {
+		return 0, err
+	}
+
+	resent := 0
+	for _, id := range ids {
+		rawMessage, err := m.persistence.RawMessageByID(id)
+		if err != nil {
+			return resent, err
+		}
+		if rawMessage.MessageType == protobuf.ApplicationMetadataMessage_SESSION_RESET {
+			continue
+		}
+
+		err = m.reSendRawMessage(context.Background(), id)
+		if err != nil {
+			return resent, err
+		}
+		resent++
+	}
+
+	return resent, nil
+}
@@ -0,0 +1,197 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/signal"
+)
+
+func TestMessengerSessionResetSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSessionResetSuite))
+}
+
+type MessengerSessionResetSuite struct {
+	MessengerBaseTestSuite
+}
+
+type sessionResetSignalsHandler struct {
+	MessengerSignalsHandlerMock
+
+	mutex     sync.Mutex
+	outOfSync []string
+	resets    []string
+}
+
+func (h *sessionResetSignalsHandler) SessionOutOfSync(publicKey string, failures uint) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.outOfSync = append(h.outOfSync, publicKey)
+}
+
+func (h *sessionResetSignalsHandler) SessionReset(publicKey string, state string, resentMessages int) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.resets = append(h.resets, state)
+}
+
+func (h *sessionResetSignalsHandler) signals() ([]string, []string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return append([]string{}, h.outOfSync...), append([]string{}, h.resets...)
+}
+
+func (s *MessengerSessionResetSuite) sendMessage(from *Messenger, to *Messenger, text string) {
+	chat := CreateOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&to.identity.PublicKey)), &to.identity.PublicKey, from.transport)
+	s.Require().NoError(from.SaveChat(chat))
+
+	message := buildTestMessage(*chat)
+	message.Text = text
+	_, err := from.SendChatMessage(context.Background(), message)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSessionResetSuite) sendAndReceiveMessage(from *Messenger, to *Messenger, text string) {
+	s.sendMessage(from, to, text)
+
+	_, err := WaitOnMessengerResponse(
+		to,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == text {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
+
+func (s *MessengerSessionResetSuite) decryptionFailures(m *Messenger, publicKey string) uint {
+	m.sessionDecryptionFailuresMutex.Lock()
+	defer m.sessionDecryptionFailuresMutex.Unlock()
+
+	if failures, ok := m.sessionDecryptionFailures[publicKey]; ok {
+		return failures.count
+	}
+	return 0
+}
+
+func (s *MessengerSessionResetSuite) TestResetContactSession() {
+	alice := s.m
+	alicePublicKey := types.EncodeHex(crypto.FromECDSAPub(&alice.identity.PublicKey))
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	bobPublicKey := types.EncodeHex(crypto.FromECDSAPub(&bob.identity.PublicKey))
+
+	s.Require().NoError(makeMutualContact(alice, &bob.identity.PublicKey))
+	s.Require().NoError(makeMutualContact(bob, &alice.identity.PublicKey))
+
+	aliceSignals := &sessionResetSignalsHandler{}
+	alice.config.messengerSignalsHandler = aliceSignals
+	bobSignals := &sessionResetSignalsHandler{}
+	bob.config.messengerSignalsHandler = bobSignals
+
+	s.sendAndReceiveMessage(alice, bob, "hello bob")
+	s.sendAndReceiveMessage(bob, alice, "hello alice")
+	s.sendAndReceiveMessage(alice, bob, "how are you?")
+
+	// Bob loses the session, alice's messages can't be decrypted anymore
+	s.Require().NoError(bob.encryptor.ResetSession(&alice.identity.PublicKey))
+
+	for i := 1; i <= sessionOutOfSyncThreshold; i++ {
+		s.sendMessage(alice, bob, "lost message")
+
+		err := tt.RetryWithBackOff(func() error {
+			_, err := bob.RetrieveAll()
+			if err != nil {
+				return err
+			}
+			if s.decryptionFailures(bob, alicePublicKey) < uint(i) {
+				return errors.New("message decrypted")
+			}
+			return nil
+		})
+		s.Require().NoError(err)
+	}
+	outOfSync, _ := bobSignals.signals()
+	s.Require().Equal([]string{alicePublicKey}, outOfSync)
+
+	s.Require().ErrorIs(bob.ResetContactSession(bobPublicKey), ErrCannotResetOwnSession)
+	s.Require().NoError(bob.ResetContactSession(alicePublicKey))
+	_, resets := bobSignals.signals()
+	s.Require().Equal([]string{signal.SessionResetRequested}, resets)
+
+	// Alice resets the session and resends her messages
+	err := tt.RetryWithBackOff(func() error {
+		_, err := alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if _, resets := aliceSignals.signals(); len(resets) == 0 {
+			return errors.New("session not reset")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	_, resets = aliceSignals.signals()
+	s.Require().Equal([]string{signal.SessionResetByContact}, resets)
+
+	response, err := WaitOnMessengerResponse(
+		bob,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == "lost message" {
+					_, resets := bobSignals.signals()
+					return len(resets) == 2
+				}
+			}
+			return false
+		},
+		"lost message not received",
+	)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(response.Messages())
+	_, resets = bobSignals.signals()
+	s.Require().Equal([]string{signal.SessionResetRequested, signal.SessionResetCompleted}, resets)
+
+	s.Require().Zero(s.decryptionFailures(bob, alicePublicKey))
+
+	// The new session works both ways
+	s.sendAndReceiveMessage(alice, bob, "back in sync")
+	s.sendAndReceiveMessage(bob, alice, "indeed")
+	s.sendAndReceiveMessage(alice, bob, "great")
+}
+
+func (s *MessengerSessionResetSuite) TestAcceptSessionReset() {
+	key, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+
+	contact, err := BuildContactFromPublicKey(&key.PublicKey)
+	s.Require().NoError(err)
+
+	// Only mutual contacts can reset the session
+	s.Require().False(s.m.acceptSessionReset(contact))
+
+	contact.ContactRequestLocalState = ContactRequestStateSent
+	contact.ContactRequestRemoteState = ContactRequestStateReceived
+	s.Require().True(s.m.acceptSessionReset(contact))
+
+	// Resets are rate limited per contact
+	s.Require().False(s.m.acceptSessionReset(contact))
+
+	s.m.sessionDecryptionFailuresMutex.Lock()
+	s.m.sessionResetsHandled[contact.ID] -= uint64(sessionResetMinInterval.Milliseconds())
+	s.m.sessionDecryptionFailuresMutex.Unlock()
+	s.Require().True(s.m.acceptSessionReset(contact))
+}
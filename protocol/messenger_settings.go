@@ -16,6 +16,10 @@ func (m *Messenger) SetStoreConfirmationForMessagesSent(request *requests.SetSto
 	return nodecfg.SetStoreConfirmationForMessagesSent(m.database, request.Enabled)
 }
 
+func (m *Messenger) SetLocalDiscovery(request *requests.SetLocalDiscovery) error {
+	return nodecfg.SetLocalDiscovery(m.database, request.Enabled)
+}
+
 func (m *Messenger) SetSyncingOnMobileNetwork(request *requests.SetSyncingOnMobileNetwork) error {
 	if err := request.Validate(); err != nil {
 		return err
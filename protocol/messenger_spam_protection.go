@@ -0,0 +1,353 @@
+package protocol
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/status-go/nodecfg"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
+	v1protocol "github.com/status-im/status-go/protocol/v1"
+)
+
+const defaultSpamQuarantineScore = 30
+
+// Every dropped message adds 1 to the spam score of its sender, the score halves every spamScoreHalfLife
+const spamScoreHalfLife = 10 * time.Minute
+
+// Idle limiters are full again after a minute, so they can be dropped once the score is low
+const spamProtectionPruneInterval = 5 * time.Minute
+
+const (
+	spamDropReasonRateLimited = "rate_limited"
+	spamDropReasonQuarantined = "quarantined"
+)
+
+// rateLimitedMessageTypes are the messages users can send at will, other messages are always handled
+var rateLimitedMessageTypes = map[protobuf.ApplicationMetadataMessage_Type]bool{
+	protobuf.ApplicationMetadataMessage_CHAT_MESSAGE:                 true,
+	protobuf.ApplicationMetadataMessage_EMOJI_REACTION:               true,
+	protobuf.ApplicationMetadataMessage_EDIT_MESSAGE:                 true,
+	protobuf.ApplicationMetadataMessage_DELETE_MESSAGE:               true,
+	protobuf.ApplicationMetadataMessage_PIN_MESSAGE:                  true,
+	protobuf.ApplicationMetadataMessage_CONTACT_UPDATE:               true,
+	protobuf.ApplicationMetadataMessage_REQUEST_CONTACT_VERIFICATION: true,
+	protobuf.ApplicationMetadataMessage_COMMUNITY_REQUEST_TO_JOIN:    true,
+}
+
+var (
+	droppedIncomingMessagesCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "messenger_incoming_messages_dropped_total",
+		Help: "Number of incoming messages dropped by the per-sender rate limit, by reason.",
+	}, []string{"reason"})
+	quarantinedSendersCounter = prom.NewCounter(prom.CounterOpts{
+		Name: "messenger_senders_quarantined_total",
+		Help: "Number of senders quarantined for sending too many messages.",
+	})
+)
+
+func init() {
+	prom.MustRegister(droppedIncomingMessagesCounter)
+	prom.MustRegister(quarantinedSendersCounter)
+}
+
+// QuarantinedSender is a sender whose messages are dropped until it's released
+type QuarantinedSender struct {
+	ID        string  `json:"id"`
+	SpamScore float64 `json:"spamScore"`
+	// DroppedMessages is the number of messages dropped, before and after the quarantine
+	DroppedMessages uint64 `json:"droppedMessages"`
+	// QuarantinedAt and LastMessageAt are in ms
+	QuarantinedAt uint64 `json:"quarantinedAt"`
+	LastMessageAt uint64 `json:"lastMessageAt"`
+}
+
+// SpamProtectionStats describes the incoming messages rate limit, counters are since the messenger started
+type SpamProtectionStats struct {
+	MaxMessagesPerSenderPerMinute int     `json:"maxMessagesPerSenderPerMinute"`
+	QuarantineScore               float64 `json:"quarantineScore"`
+	// TrackedSenders is the number of senders whose rate is currently tracked
+	TrackedSenders      int    `json:"trackedSenders"`
+	QuarantinedSenders  int    `json:"quarantinedSenders"`
+	RateLimitedMessages uint64 `json:"rateLimitedMessages"`
+	QuarantinedMessages uint64 `json:"quarantinedMessages"`
+}
+
+type spamVerdict int
+
+const (
+	spamVerdictAllow spamVerdict = iota
+	// spamVerdictRateLimited is returned when the sender is over its rate limit
+	spamVerdictRateLimited
+	// spamVerdictSenderQuarantined is returned when the sender is over its rate limit, and its score got it quarantined
+	spamVerdictSenderQuarantined
+	// spamVerdictQuarantined is returned when the sender was already quarantined
+	spamVerdictQuarantined
+)
+
+type senderRate struct {
+	limiter  *rate.Limiter
+	score    float64
+	scoredAt time.Time
+	dropped  uint64
+	lastSeen time.Time
+}
+
+// decayedScore returns the score of the sender at the given time
+func (r *senderRate) decayedScore(at time.Time) float64 {
+	if !at.After(r.scoredAt) {
+		return r.score
+	}
+	return r.score * math.Pow(0.5, float64(at.Sub(r.scoredAt))/float64(spamScoreHalfLife))
+}
+
+// spamProtection rate limits the incoming messages of each sender with a token bucket,
+// and quarantines the senders which keep going over their limit
+type spamProtection struct {
+	mutex sync.Mutex
+
+	maxPerSenderPerMinute int
+	quarantineScore       float64
+
+	senders     map[string]*senderRate
+	quarantined map[string]*QuarantinedSender
+	// modified are the quarantined senders which haven't been persisted yet
+	modified   map[string]bool
+	lastPruned time.Time
+
+	rateLimitedMessages uint64
+	quarantinedMessages uint64
+}
+
+func newSpamProtection(maxPerSenderPerMinute, quarantineScore int) *spamProtection {
+	s := &spamProtection{
+		senders:     make(map[string]*senderRate),
+		quarantined: make(map[string]*QuarantinedSender),
+		modified:    make(map[string]bool),
+		lastPruned:  time.Now(),
+	}
+	s.configure(maxPerSenderPerMinute, quarantineScore)
+	return s
+}
+
+func (s *spamProtection) configure(maxPerSenderPerMinute, quarantineScore int) {
+	if quarantineScore <= 0 {
+		quarantineScore = defaultSpamQuarantineScore
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.maxPerSenderPerMinute = maxPerSenderPerMinute
+	s.quarantineScore = float64(quarantineScore)
+	// The limiters are created with the previous rate
+	s.senders = make(map[string]*senderRate)
+}
+
+// check returns whether the message of the sender should be dropped. Limiters work on the time the messages were sent at,
+// so that history fetched from store nodes isn't dropped, waku nodes reject messages far from their own time
+func (s *spamProtection) check(senderID string, sentAt time.Time, now time.Time) spamVerdict {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if sentAt.After(now) {
+		sentAt = now
+	}
+
+	if quarantined, ok := s.quarantined[senderID]; ok {
+		quarantined.DroppedMessages++
+		if sentAtMs := uint64(sentAt.UnixMilli()); sentAtMs > quarantined.LastMessageAt {
+			quarantined.LastMessageAt = sentAtMs
+		}
+		s.modified[senderID] = true
+		s.quarantinedMessages++
+		return spamVerdictQuarantined
+	}
+
+	if s.maxPerSenderPerMinute <= 0 {
+		return spamVerdictAllow
+	}
+
+	s.prune(now)
+
+	entry, ok := s.senders[senderID]
+	if !ok {
+		entry = &senderRate{
+			limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(s.maxPerSenderPerMinute)), s.maxPerSenderPerMinute),
+		}
+		s.senders[senderID] = entry
+	}
+	entry.lastSeen = now
+
+	if entry.limiter.AllowN(sentAt, 1) {
+		return spamVerdictAllow
+	}
+
+	s.rateLimitedMessages++
+	entry.dropped++
+	entry.score = entry.decayedScore(sentAt) + 1
+	if sentAt.After(entry.scoredAt) {
+		entry.scoredAt = sentAt
+	}
+
+	if entry.score < s.quarantineScore {
+		return spamVerdictRateLimited
+	}
+
+	s.quarantined[senderID] = &QuarantinedSender{
+		ID:              senderID,
+		SpamScore:       entry.score,
+		DroppedMessages: entry.dropped,
+		QuarantinedAt:   uint64(now.UnixMilli()),
+		LastMessageAt:   uint64(sentAt.UnixMilli()),
+	}
+	s.modified[senderID] = true
+	delete(s.senders, senderID)
+	return spamVerdictSenderQuarantined
+}
+
+// prune drops the senders which haven't sent anything for a while, unless their score is still significant
+func (s *spamProtection) prune(now time.Time) {
+	if now.Sub(s.lastPruned) < spamProtectionPruneInterval {
+		return
+	}
+	s.lastPruned = now
+
+	for senderID, entry := range s.senders {
+		idle := now.Sub(entry.lastSeen)
+		if idle > spamProtectionPruneInterval && entry.score*math.Pow(0.5, float64(idle)/float64(spamScoreHalfLife)) < 1 {
+			delete(s.senders, senderID)
+		}
+	}
+}
+
+func (s *spamProtection) load(senders []*QuarantinedSender) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, sender := range senders {
+		s.quarantined[sender.ID] = sender
+	}
+}
+
+func (s *spamProtection) release(senderID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.quarantined, senderID)
+	delete(s.modified, senderID)
+	delete(s.senders, senderID)
+}
+
+// takeModified returns a copy of the quarantined senders to persist
+func (s *spamProtection) takeModified() []*QuarantinedSender {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	var result []*QuarantinedSender
+	for senderID := range s.modified {
+		if quarantined, ok := s.quarantined[senderID]; ok {
+			sender := *quarantined
+			result = append(result, &sender)
+		}
+	}
+	s.modified = make(map[string]bool)
+	return result
+}
+
+func (s *spamProtection) stats() *SpamProtectionStats {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return &SpamProtectionStats{
+		MaxMessagesPerSenderPerMinute: s.maxPerSenderPerMinute,
+		QuarantineScore:               s.quarantineScore,
+		TrackedSenders:                len(s.senders),
+		QuarantinedSenders:            len(s.quarantined),
+		RateLimitedMessages:           s.rateLimitedMessages,
+		QuarantinedMessages:           s.quarantinedMessages,
+	}
+}
+
+func (m *Messenger) loadQuarantinedSenders() error {
+	senders, err := m.persistence.QuarantinedSenders()
+	if err != nil {
+		return err
+	}
+	m.spamProtection.load(senders)
+	return nil
+}
+
+// shouldDropIncomingMessage returns whether the message is dropped by the per-sender rate limit,
+// our own messages and the ones of mutual contacts are never dropped
+func (m *Messenger) shouldDropIncomingMessage(msg *v1protocol.StatusMessage, senderID string, contact *Contact) bool {
+	if !rateLimitedMessageTypes[msg.ApplicationLayer.Type] {
+		return false
+	}
+	if senderID == contactIDFromPublicKey(m.IdentityPublicKey()) {
+		return false
+	}
+	if contact != nil && contact.mutual() {
+		return false
+	}
+
+	sentAt := time.Unix(int64(msg.TransportLayer.Message.Timestamp), 0)
+	switch m.spamProtection.check(senderID, sentAt, time.Now()) {
+	case spamVerdictRateLimited:
+		droppedIncomingMessagesCounter.WithLabelValues(spamDropReasonRateLimited).Inc()
+	case spamVerdictSenderQuarantined:
+		droppedIncomingMessagesCounter.WithLabelValues(spamDropReasonRateLimited).Inc()
+		quarantinedSendersCounter.Inc()
+		m.logger.Info("sender quarantined", zap.String("senderID", senderID))
+		if m.config.messengerSignalsHandler != nil {
+			m.config.messengerSignalsHandler.SenderQuarantined(senderID)
+		}
+	case spamVerdictQuarantined:
+		droppedIncomingMessagesCounter.WithLabelValues(spamDropReasonQuarantined).Inc()
+	default:
+		return false
+	}
+	return true
+}
+
+// saveQuarantinedSenders persists the senders quarantined, or whose messages were dropped, while handling a batch
+func (m *Messenger) saveQuarantinedSenders() {
+	for _, sender := range m.spamProtection.takeModified() {
+		if err := m.persistence.SaveQuarantinedSender(sender); err != nil {
+			m.logger.Error("failed to save quarantined sender", zap.String("senderID", sender.ID), zap.Error(err))
+		}
+	}
+}
+
+// GetQuarantinedSenders returns the senders whose messages are dropped for spamming, most recently quarantined first
+func (m *Messenger) GetQuarantinedSenders() ([]*QuarantinedSender, error) {
+	m.saveQuarantinedSenders()
+	return m.persistence.QuarantinedSenders()
+}
+
+// ReleaseQuarantinedSender handles the messages of the sender again, the messages dropped are not recovered.
+// Senders can be blocked with BlockContact instead
+func (m *Messenger) ReleaseQuarantinedSender(senderID string) error {
+	if err := m.persistence.DeleteQuarantinedSender(senderID); err != nil {
+		return err
+	}
+	m.spamProtection.release(senderID)
+	return nil
+}
+
+func (m *Messenger) GetSpamProtectionStats() *SpamProtectionStats {
+	return m.spamProtection.stats()
+}
+
+// SetIncomingMessagesRateLimit changes the per-sender rate limit, it's applied right away and persisted
+func (m *Messenger) SetIncomingMessagesRateLimit(request *requests.SetIncomingMessagesRateLimit) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	if err := nodecfg.SetIncomingMessagesRateLimit(m.database, request.MaxPerSenderPerMinute, request.QuarantineScore); err != nil {
+		return err
+	}
+	m.spamProtection.configure(request.MaxPerSenderPerMinute, request.QuarantineScore)
+	return nil
+}
@@ -0,0 +1,181 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/requests"
+	"github.com/status-im/status-go/protocol/tt"
+)
+
+func TestSpamProtection(t *testing.T) {
+	now := time.Now()
+	s := newSpamProtection(3, 2)
+
+	// Messages are allowed up to the burst, then dropped until the sender is quarantined
+	for i := 0; i < 3; i++ {
+		require.Equal(t, spamVerdictAllow, s.check("spammer", now, now))
+	}
+	require.Equal(t, spamVerdictRateLimited, s.check("spammer", now, now))
+	require.Equal(t, spamVerdictSenderQuarantined, s.check("spammer", now, now))
+	require.Equal(t, spamVerdictQuarantined, s.check("spammer", now, now))
+
+	modified := s.takeModified()
+	require.Len(t, modified, 1)
+	require.Equal(t, "spammer", modified[0].ID)
+	require.Equal(t, uint64(3), modified[0].DroppedMessages)
+	require.Empty(t, s.takeModified())
+
+	// Messages sent at the allowed rate are never dropped, even when handled at once
+	for i := 0; i < 10; i++ {
+		sentAt := now.Add(-time.Hour).Add(time.Duration(i) * 20 * time.Second)
+		require.Equal(t, spamVerdictAllow, s.check("chatty", sentAt, now))
+	}
+
+	// The score decays, so that occasional bursts don't get the sender quarantined
+	for i := 0; i < 3; i++ {
+		require.Equal(t, spamVerdictAllow, s.check("bursty", now, now))
+	}
+	require.Equal(t, spamVerdictRateLimited, s.check("bursty", now, now))
+	later := now.Add(2 * spamScoreHalfLife)
+	for i := 0; i < 3; i++ {
+		require.Equal(t, spamVerdictAllow, s.check("bursty", later, later))
+	}
+	require.Equal(t, spamVerdictRateLimited, s.check("bursty", later, later))
+
+	stats := s.stats()
+	require.Equal(t, 1, stats.QuarantinedSenders)
+	require.Equal(t, uint64(4), stats.RateLimitedMessages)
+	require.Equal(t, uint64(1), stats.QuarantinedMessages)
+
+	s.release("spammer")
+	require.Equal(t, spamVerdictAllow, s.check("spammer", later, later))
+
+	// Disabling the limit still drops the messages of quarantined senders
+	s.load([]*QuarantinedSender{{ID: "quarantined"}})
+	s.configure(0, 0)
+	require.Equal(t, spamVerdictAllow, s.check("bursty", later, later))
+	require.Equal(t, spamVerdictQuarantined, s.check("quarantined", later, later))
+}
+
+func TestMessengerSpamProtectionSuite(t *testing.T) {
+	suite.Run(t, new(MessengerSpamProtectionSuite))
+}
+
+type MessengerSpamProtectionSuite struct {
+	MessengerBaseTestSuite
+}
+
+type spamProtectionSignalsHandler struct {
+	MessengerSignalsHandlerMock
+
+	mutex       sync.Mutex
+	quarantined []string
+}
+
+func (h *spamProtectionSignalsHandler) SenderQuarantined(senderID string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.quarantined = append(h.quarantined, senderID)
+}
+
+func (h *spamProtectionSignalsHandler) signals() []string {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return append([]string{}, h.quarantined...)
+}
+
+func (s *MessengerSpamProtectionSuite) sendMessages(from *Messenger, to *Messenger, texts ...string) {
+	chat := CreateOneToOneChat(types.EncodeHex(crypto.FromECDSAPub(&to.identity.PublicKey)), &to.identity.PublicKey, from.transport)
+	s.Require().NoError(from.SaveChat(chat))
+
+	for _, text := range texts {
+		message := buildTestMessage(*chat)
+		message.Text = text
+		_, err := from.SendChatMessage(context.Background(), message)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *MessengerSpamProtectionSuite) TestQuarantineSpammer() {
+	alice := s.m
+	aliceSignals := &spamProtectionSignalsHandler{}
+	alice.config.messengerSignalsHandler = aliceSignals
+	s.Require().NoError(alice.SetIncomingMessagesRateLimit(&requests.SetIncomingMessagesRateLimit{
+		MaxPerSenderPerMinute: 3,
+		QuarantineScore:       2,
+	}))
+
+	bob := s.newMessenger()
+	defer TearDownMessenger(&s.Suite, bob)
+	bobID := types.EncodeHex(crypto.FromECDSAPub(&bob.identity.PublicKey))
+
+	s.sendMessages(bob, alice, "1", "2", "3", "4", "5", "6", "7", "8")
+
+	received := make(map[string]bool)
+	err := tt.RetryWithBackOff(func() error {
+		response, err := alice.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		for _, message := range response.Messages() {
+			received[message.Text] = true
+		}
+		stats := alice.GetSpamProtectionStats()
+		if stats.RateLimitedMessages+stats.QuarantinedMessages < 5 {
+			return errors.New("messages not dropped")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	// Only the messages within the limit are handled, the sender is quarantined after 2 dropped messages
+	s.Require().Len(received, 3)
+	stats := alice.GetSpamProtectionStats()
+	s.Require().Equal(uint64(2), stats.RateLimitedMessages)
+	s.Require().Equal(uint64(3), stats.QuarantinedMessages)
+	s.Require().Equal([]string{bobID}, aliceSignals.signals())
+
+	quarantined, err := alice.GetQuarantinedSenders()
+	s.Require().NoError(err)
+	s.Require().Len(quarantined, 1)
+	s.Require().Equal(bobID, quarantined[0].ID)
+	s.Require().Equal(uint64(5), quarantined[0].DroppedMessages)
+	s.Require().NotZero(quarantined[0].QuarantinedAt)
+
+	// Quarantined senders are restored on restart
+	restored := newSpamProtection(3, 2)
+	senders, err := alice.persistence.QuarantinedSenders()
+	s.Require().NoError(err)
+	restored.load(senders)
+	s.Require().Equal(spamVerdictQuarantined, restored.check(bobID, time.Now(), time.Now()))
+
+	// Once released, the messages of the sender are handled again
+	s.Require().NoError(alice.ReleaseQuarantinedSender(bobID))
+	quarantined, err = alice.GetQuarantinedSenders()
+	s.Require().NoError(err)
+	s.Require().Empty(quarantined)
+
+	s.sendMessages(bob, alice, "released")
+	_, err = WaitOnMessengerResponse(
+		alice,
+		func(r *MessengerResponse) bool {
+			for _, message := range r.Messages() {
+				if message.Text == "released" {
+					return true
+				}
+			}
+			return false
+		},
+		"message not received",
+	)
+	s.Require().NoError(err)
+}
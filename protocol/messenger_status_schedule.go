@@ -0,0 +1,180 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/multiaccounts/settings"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const minutesInDay = 24 * 60
+
+var ErrInvalidDoNotDisturbWindow = errors.New("invalid do not disturb window")
+
+// DoNotDisturbWindow is a recurring period during which the status is set to do not disturb
+type DoNotDisturbWindow struct {
+	// Days are the week days on which the window starts, every day if empty
+	Days []time.Weekday `json:"days,omitempty"`
+	// Start and End are minutes since midnight in local time,
+	// the window spans midnight when End is before Start
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+func (w DoNotDisturbWindow) Validate() error {
+	if w.Start < 0 || w.Start >= minutesInDay || w.End < 0 || w.End >= minutesInDay || w.Start == w.End {
+		return ErrInvalidDoNotDisturbWindow
+	}
+	for _, day := range w.Days {
+		if day < time.Sunday || day > time.Saturday {
+			return ErrInvalidDoNotDisturbWindow
+		}
+	}
+	return nil
+}
+
+// activeUntil returns the end of the window if now is within it
+func (w DoNotDisturbWindow) activeUntil(now time.Time) (time.Time, bool) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	// A window which spans midnight might have started the day before
+	for _, day := range []time.Time{today, today.AddDate(0, 0, -1)} {
+		if !w.startsOn(day.Weekday()) {
+			continue
+		}
+
+		start := day.Add(time.Duration(w.Start) * time.Minute)
+		end := day.Add(time.Duration(w.End) * time.Minute)
+		if w.End < w.Start {
+			end = end.AddDate(0, 0, 1)
+		}
+		if !now.Before(start) && now.Before(end) {
+			return end, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (w DoNotDisturbWindow) startsOn(weekday time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, day := range w.Days {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// SetStatusSchedule replaces the do not disturb windows, the schedule is synced with paired devices
+func (m *Messenger) SetStatusSchedule(windows []DoNotDisturbWindow) error {
+	for _, window := range windows {
+		if err := window.Validate(); err != nil {
+			return err
+		}
+	}
+	return m.settings.SaveSettingField(settings.StatusSchedule, windows)
+}
+
+func (m *Messenger) GetStatusSchedule() ([]DoNotDisturbWindow, error) {
+	var windows []DoNotDisturbWindow
+	err := m.settings.GetStatusSchedule(&windows)
+	if err != nil {
+		return nil, err
+	}
+	return windows, nil
+}
+
+// watchUserStatusSchedule checks every minute whether the status expired, or a do not disturb window started
+func (m *Messenger) watchUserStatusSchedule() {
+	m.logger.Debug("Checking the user status schedule every minute")
+	go func() {
+		for {
+			now := time.Now()
+			waitDuration := time.Until(now.Truncate(time.Minute).Add(time.Minute))
+
+			select {
+			case <-time.After(waitDuration):
+				if err := m.checkUserStatusSchedule(context.Background(), time.Now()); err != nil {
+					m.logger.Warn("failed to check the user status schedule", zap.Error(err))
+				}
+			case <-m.quit:
+				return
+			}
+		}
+	}()
+}
+
+// checkUserStatusSchedule restores the previous status once the current one expires,
+// and sets do not disturb at the start of the scheduled windows
+func (m *Messenger) checkUserStatusSchedule(ctx context.Context, now time.Time) error {
+	m.statusScheduleMutex.Lock()
+	defer m.statusScheduleMutex.Unlock()
+
+	currentStatus, err := m.GetCurrentUserStatus()
+	if err != nil {
+		return err
+	}
+
+	nextStatus, err := m.scheduledUserStatus(currentStatus, now)
+	if err != nil || nextStatus == nil {
+		return err
+	}
+
+	err = m.setUserStatus(ctx, *nextStatus)
+	if err != nil {
+		return err
+	}
+
+	newStatus, err := m.GetCurrentUserStatus()
+	if err != nil {
+		return err
+	}
+
+	response := &MessengerResponse{}
+	response.SetCurrentStatus(*newStatus)
+	m.PublishMessengerResponse(response)
+	return nil
+}
+
+// scheduledUserStatus returns the status to set at the given time, nil if it shouldn't change
+func (m *Messenger) scheduledUserStatus(currentStatus *UserStatus, now time.Time) (*UserStatus, error) {
+	windows, err := m.GetStatusSchedule()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, window := range windows {
+		end, ok := window.activeUntil(now)
+		if !ok {
+			continue
+		}
+		expiresAt := uint64(end.Unix())
+		// The window is applied once, so that the user can still change their status during it
+		if m.appliedStatusScheduleEnd == expiresAt {
+			break
+		}
+		m.appliedStatusScheduleEnd = expiresAt
+		if currentStatus.StatusType == int(protobuf.StatusUpdate_DO_NOT_DISTURB) && currentStatus.ExpiresAt == 0 {
+			return nil, nil
+		}
+		return &UserStatus{
+			StatusType: int(protobuf.StatusUpdate_DO_NOT_DISTURB),
+			ExpiresAt:  expiresAt,
+		}, nil
+	}
+
+	if currentStatus.ExpiresAt == 0 || uint64(now.Unix()) < currentStatus.ExpiresAt {
+		return nil, nil
+	}
+
+	if currentStatus.Previous != nil {
+		return currentStatus.Previous, nil
+	}
+	return &UserStatus{StatusType: int(protobuf.StatusUpdate_AUTOMATIC)}, nil
+}
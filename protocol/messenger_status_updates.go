@@ -16,6 +16,7 @@ import (
 	"github.com/status-im/status-go/protocol/common"
 	"github.com/status-im/status-go/protocol/communities"
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 	"github.com/status-im/status-go/protocol/transport"
 	v1protocol "github.com/status-im/status-go/protocol/v1"
 )
@@ -59,6 +60,8 @@ func (m *Messenger) sendUserStatus(ctx context.Context, status UserStatus) error
 		Clock:      status.Clock,
 		StatusType: protobuf.StatusUpdate_StatusType(status.StatusType),
 		CustomText: status.CustomText,
+		Emoji:      status.Emoji,
+		ExpiresAt:  status.ExpiresAt,
 	}
 
 	encodedMessage, err := proto.Marshal(statusUpdate)
@@ -161,6 +164,8 @@ func (m *Messenger) sendCurrentUserStatusToCommunity(ctx context.Context, commun
 		Clock:      status.Clock,
 		StatusType: protobuf.StatusUpdate_StatusType(status.StatusType),
 		CustomText: status.CustomText,
+		Emoji:      status.Emoji,
+		ExpiresAt:  status.ExpiresAt,
 	}
 
 	encodedMessage, err := proto.Marshal(statusUpdate)
@@ -212,14 +217,41 @@ func (m *Messenger) broadcastLatestUserStatus() {
 }
 
 func (m *Messenger) SetUserStatus(ctx context.Context, newStatus int, newCustomText string) error {
-	if len([]rune(newCustomText)) > maxStatusMessageText {
+	return m.setUserStatus(ctx, UserStatus{StatusType: newStatus, CustomText: newCustomText})
+}
+
+// SetCustomUserStatus sets a status with an emoji, when ExpiresAt is set the previous
+// status is restored automatically once it expires
+func (m *Messenger) SetCustomUserStatus(ctx context.Context, request *requests.SetCustomUserStatus) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	if request.ExpiresAt != 0 && request.ExpiresAt <= uint64(time.Now().Unix()) {
+		return fmt.Errorf("status expiry should be in the future")
+	}
+
+	return m.setUserStatus(ctx, UserStatus{
+		StatusType: int(request.StatusType),
+		CustomText: request.CustomText,
+		Emoji:      request.Emoji,
+		ExpiresAt:  request.ExpiresAt,
+	})
+}
+
+func (m *Messenger) setUserStatus(ctx context.Context, newStatus UserStatus) error {
+	if len([]rune(newStatus.CustomText)) > maxStatusMessageText {
 		return fmt.Errorf("custom text shouldn't be longer than %d", maxStatusMessageText)
 	}
 
-	if newStatus != int(protobuf.StatusUpdate_AUTOMATIC) &&
-		newStatus != int(protobuf.StatusUpdate_DO_NOT_DISTURB) &&
-		newStatus != int(protobuf.StatusUpdate_ALWAYS_ONLINE) &&
-		newStatus != int(protobuf.StatusUpdate_INACTIVE) {
+	if len([]rune(newStatus.Emoji)) > maxStatusEmojiLength {
+		return fmt.Errorf("emoji shouldn't be longer than %d", maxStatusEmojiLength)
+	}
+
+	if newStatus.StatusType != int(protobuf.StatusUpdate_AUTOMATIC) &&
+		newStatus.StatusType != int(protobuf.StatusUpdate_DO_NOT_DISTURB) &&
+		newStatus.StatusType != int(protobuf.StatusUpdate_ALWAYS_ONLINE) &&
+		newStatus.StatusType != int(protobuf.StatusUpdate_INACTIVE) {
 		return fmt.Errorf("unknown status type")
 	}
 
@@ -229,15 +261,32 @@ func (m *Messenger) SetUserStatus(ctx context.Context, newStatus int, newCustomT
 		return err
 	}
 
-	if newStatus == currStatus.StatusType && newCustomText == currStatus.CustomText {
+	if newStatus.StatusType == currStatus.StatusType &&
+		newStatus.CustomText == currStatus.CustomText &&
+		newStatus.Emoji == currStatus.Emoji &&
+		newStatus.ExpiresAt == currStatus.ExpiresAt {
 		m.logger.Debug("Status type did not change")
 		return nil
 	}
 
-	currStatus.StatusType = newStatus
-	currStatus.CustomText = newCustomText
+	if newStatus.ExpiresAt != 0 {
+		newStatus.Previous = baseUserStatus(currStatus)
+	}
 
-	return m.sendUserStatus(ctx, *currStatus)
+	return m.sendUserStatus(ctx, newStatus)
+}
+
+// baseUserStatus returns the status to restore once a time-boxed status expires,
+// time-boxed statuses don't stack so the status they replaced is kept
+func baseUserStatus(status *UserStatus) *UserStatus {
+	if status.ExpiresAt != 0 && status.Previous != nil {
+		return status.Previous
+	}
+	return &UserStatus{
+		StatusType: status.StatusType,
+		CustomText: status.CustomText,
+		Emoji:      status.Emoji,
+	}
 }
 
 func (m *Messenger) HandleStatusUpdate(state *ReceivedMessageState, message *protobuf.StatusUpdate, statusMessage *v1protocol.StatusMessage) error {
@@ -256,6 +305,9 @@ func (m *Messenger) HandleStatusUpdate(state *ReceivedMessageState, message *pro
 			return nil // older status message, or status does not change ignoring it
 		}
 		newStatus := ToUserStatus(message)
+		if newStatus.ExpiresAt != 0 {
+			newStatus.Previous = baseUserStatus(currentStatus)
+		}
 		err = m.settings.SaveSettingField(settings.CurrentUserStatus, newStatus)
 		if err != nil {
 			return err
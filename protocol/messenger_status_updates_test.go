@@ -1,11 +1,14 @@
 package protocol
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
 	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/requests"
 )
 
 func TestMessengerStatusUpdatesSuite(t *testing.T) {
@@ -126,3 +129,109 @@ func (s *MessengerStatusUpdatesSuite) TestDeactivatedStatusUpdates() {
 	//Upper rannge ends at 401 (clock + 1)
 	s.Require().Equal(uint64(401), deactivatedAutomaticStatusUpdates[count-1].Clock)
 }
+
+func (s *MessengerStatusUpdatesSuite) TestStatusUpdateEmojiAndExpiry() {
+	statusUpdate := UserStatus{
+		StatusType: int(protobuf.StatusUpdate_DO_NOT_DISTURB),
+		Clock:      100,
+		CustomText: "in a meeting",
+		Emoji:      "📅",
+		ExpiresAt:  200,
+		PublicKey:  "pub-key1",
+	}
+
+	err := s.m.persistence.InsertStatusUpdate(statusUpdate)
+	s.Require().NoError(err)
+
+	statusUpdates, err := s.m.persistence.StatusUpdates()
+	s.Require().NoError(err)
+	s.Require().Equal([]UserStatus{statusUpdate}, statusUpdates)
+}
+
+func (s *MessengerStatusUpdatesSuite) TestCustomUserStatusExpiry() {
+	err := s.m.SetUserStatus(context.Background(), int(protobuf.StatusUpdate_ALWAYS_ONLINE), "working")
+	s.Require().NoError(err)
+
+	now := time.Now()
+	err = s.m.SetCustomUserStatus(context.Background(), &requests.SetCustomUserStatus{
+		StatusType: protobuf.StatusUpdate_DO_NOT_DISTURB,
+		CustomText: "in a meeting",
+		Emoji:      "📅",
+		ExpiresAt:  uint64(now.Add(time.Hour).Unix()),
+	})
+	s.Require().NoError(err)
+
+	// Time-boxed statuses don't stack, the status set before the first one is restored
+	err = s.m.SetCustomUserStatus(context.Background(), &requests.SetCustomUserStatus{
+		StatusType: protobuf.StatusUpdate_DO_NOT_DISTURB,
+		CustomText: "focus time",
+		ExpiresAt:  uint64(now.Add(2 * time.Hour).Unix()),
+	})
+	s.Require().NoError(err)
+
+	status, err := s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal("focus time", status.CustomText)
+	s.Require().NotNil(status.Previous)
+	s.Require().Equal(int(protobuf.StatusUpdate_ALWAYS_ONLINE), status.Previous.StatusType)
+	s.Require().Equal("working", status.Previous.CustomText)
+
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), now.Add(time.Hour)))
+	status, err = s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal("focus time", status.CustomText)
+
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), now.Add(2*time.Hour)))
+	status, err = s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(int(protobuf.StatusUpdate_ALWAYS_ONLINE), status.StatusType)
+	s.Require().Equal("working", status.CustomText)
+	s.Require().Zero(status.ExpiresAt)
+	s.Require().Nil(status.Previous)
+
+	err = s.m.SetCustomUserStatus(context.Background(), &requests.SetCustomUserStatus{
+		StatusType: protobuf.StatusUpdate_DO_NOT_DISTURB,
+		ExpiresAt:  uint64(now.Add(-time.Hour).Unix()),
+	})
+	s.Require().Error(err)
+
+	err = s.m.SetCustomUserStatus(context.Background(), &requests.SetCustomUserStatus{})
+	s.Require().ErrorIs(err, requests.ErrSetCustomUserStatusInvalidStatusType)
+}
+
+func (s *MessengerStatusUpdatesSuite) TestDoNotDisturbSchedule() {
+	err := s.m.SetStatusSchedule([]DoNotDisturbWindow{{Start: 60, End: 60}})
+	s.Require().ErrorIs(err, ErrInvalidDoNotDisturbWindow)
+
+	// From Monday 22:00 to Tuesday 07:00
+	err = s.m.SetStatusSchedule([]DoNotDisturbWindow{{Days: []time.Weekday{time.Monday}, Start: 22 * 60, End: 7 * 60}})
+	s.Require().NoError(err)
+
+	monday := time.Date(2030, time.January, 7, 0, 0, 0, 0, time.Local)
+	tuesday := monday.AddDate(0, 0, 1)
+
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), monday.Add(21*time.Hour)))
+	status, err := s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(int(protobuf.StatusUpdate_AUTOMATIC), status.StatusType)
+
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), monday.Add(23*time.Hour)))
+	status, err = s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(int(protobuf.StatusUpdate_DO_NOT_DISTURB), status.StatusType)
+	s.Require().Equal(uint64(tuesday.Add(7*time.Hour).Unix()), status.ExpiresAt)
+
+	// The window isn't applied again once the user changed their status
+	s.Require().NoError(s.m.SetUserStatus(context.Background(), int(protobuf.StatusUpdate_ALWAYS_ONLINE), ""))
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), tuesday.Add(time.Hour)))
+	status, err = s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(int(protobuf.StatusUpdate_ALWAYS_ONLINE), status.StatusType)
+
+	// The window isn't scheduled on Tuesdays
+	s.Require().NoError(s.m.SetUserStatus(context.Background(), int(protobuf.StatusUpdate_AUTOMATIC), ""))
+	s.Require().NoError(s.m.checkUserStatusSchedule(context.Background(), tuesday.Add(23*time.Hour)))
+	status, err = s.m.GetCurrentUserStatus()
+	s.Require().NoError(err)
+	s.Require().Equal(int(protobuf.StatusUpdate_AUTOMATIC), status.StatusType)
+}
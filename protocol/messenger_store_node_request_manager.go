@@ -1,6 +1,7 @@
 package protocol
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
@@ -539,6 +540,13 @@ func (r *storeNodeRequest) routine() {
 	// Start store node request
 	from, to := r.manager.messenger.calculateMailserverTimeBounds(oneMonthDuration)
 
+	if storeNode != nil {
+		if storenodes := r.manager.messenger.historyStorenodes(*storeNode); len(storenodes) > 1 {
+			r.result.err = r.raceStorenodes(storenodes, from, to)
+			return
+		}
+	}
+
 	_, err := r.manager.messenger.performMailserverRequest(storeNode, func(ms mailservers.Mailserver) (*MessengerResponse, error) {
 		batch := MailserverBatch{
 			From:        from,
@@ -557,6 +565,39 @@ func (r *storeNodeRequest) routine() {
 	r.result.err = err
 }
 
+// raceStorenodes sends the request to all the storenodes at once,
+// the first one which returns the data stops the others
+func (r *storeNodeRequest) raceStorenodes(storenodes []mailservers.Mailserver, from, to uint32) error {
+	batch := MailserverBatch{
+		From:        from,
+		To:          to,
+		PubsubTopic: r.pubsubTopic,
+		Topics:      []types.TopicType{r.contentTopic},
+	}
+	r.manager.logger.Info("perform store node request on several store nodes", zap.Any("batch", batch), zap.Int("storenodes", len(storenodes)))
+	if r.manager.onPerformingBatch != nil {
+		r.manager.onPerformingBatch(batch)
+	}
+
+	var lock sync.Mutex
+	return raceStorenodes(r.manager.messenger.ctx, storenodes, func(ctx context.Context, ms mailservers.Mailserver, stop func()) error {
+		_, err := r.manager.messenger.queryStorenode(ctx, ms, batch, r.config.InitialPageSize, func(envelopesCount int) (bool, uint32) {
+			lock.Lock()
+			defer lock.Unlock()
+			// Another store node already returned the data
+			if ctx.Err() != nil {
+				return false, 0
+			}
+			fetchNextPage, pageSize := r.shouldFetchNextPage(envelopesCount)
+			if !fetchNextPage {
+				stop()
+			}
+			return fetchNextPage, pageSize
+		}, true)
+		return err
+	})
+}
+
 func (r *storeNodeRequest) start() {
 	go r.routine()
 }
@@ -470,6 +470,52 @@ func (s *MessengerStoreNodeRequestSuite) TestRequestCommunityInfoWithStoreNodeDi
 	s.fetchCommunity(s.bob, community.CommunityShard(), community)
 }
 
+func (s *MessengerStoreNodeRequestSuite) TestRequestCommunityInfoFromSeveralStoreNodes() {
+	// A second store node, relaying and storing the same messages
+	cfg := testWakuV2Config{
+		logger:      s.logger.Named("store-waku-2"),
+		enableStore: true,
+		clusterID:   shard.MainStatusShardCluster,
+	}
+	storeNode2 := NewTestWakuV2(&s.Suite, cfg)
+	defer func() { _ = storeNode2.Stop() }()
+	storeNode2Address := s.wakuListenAddress(storeNode2)
+	s.Require().NoError(storeNode2.DialPeer(s.storeNodeAddress))
+
+	s.createOwner()
+
+	wakuV2 := NewTestWakuV2(&s.Suite, testWakuV2Config{
+		logger:    s.logger.Named("bob-waku"),
+		clusterID: shard.MainStatusShardCluster,
+	})
+	s.bobWaku = gethbridge.NewGethWakuV2Wrapper(wakuV2)
+
+	privateKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	s.bob, err = newMessengerWithKey(s.bobWaku, privateKey, s.logger.Named("bob-messenger"), []Option{
+		WithAutoRequestHistoricMessages(false),
+		WithCuratedCommunitiesUpdateLoop(false),
+		WithTestStoreNode(&s.Suite, localMailserverID, s.storeNodeAddress, localFleet, s.collectiblesServiceMock),
+		func(c *config) error {
+			return c.mailserversDatabase.Add(mailserversDB.Mailserver{
+				ID:      localMailserverID + "-2",
+				Name:    localMailserverID + "-2",
+				Address: storeNode2Address.String(),
+				Fleet:   localFleet,
+			})
+		},
+		WithParallelHistoryFetching(2, 4),
+	})
+	s.Require().NoError(err)
+
+	community := s.createCommunity(s.owner)
+	s.waitForAvailableStoreNode(s.bob)
+
+	// The request is sent to both store nodes
+	s.Require().Len(s.bob.historyStorenodes(*s.bob.getActiveMailserver()), 2)
+	s.fetchCommunity(s.bob, community.CommunityShard(), community)
+}
+
 func (s *MessengerStoreNodeRequestSuite) TestRequestCommunityPagingAlgorithm() {
 	const spamAmount = defaultStoreNodeRequestPageSize + initialStoreNodeRequestPageSize
 
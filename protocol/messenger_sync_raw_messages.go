@@ -154,6 +154,28 @@ func (m *Messenger) HandleSyncRawMessages(rawMessages []*protobuf.RawMessage) er
 				m.logger.Error("failed to handleSyncVerificationRequest when HandleSyncRawMessages", zap.Error(err))
 				continue
 			}
+		case protobuf.ApplicationMetadataMessage_SYNC_SAFETY_NUMBER_VERIFICATION:
+			var message protobuf.SyncSafetyNumberVerification
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.HandleSyncSafetyNumberVerification(state, &message, nil)
+			if err != nil {
+				m.logger.Error("failed to handleSyncSafetyNumberVerification when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
+		case protobuf.ApplicationMetadataMessage_SYNC_CONTACT_GROUP:
+			var message protobuf.SyncContactGroup
+			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
+			if err != nil {
+				return err
+			}
+			err = m.HandleSyncContactGroup(state, &message, nil)
+			if err != nil {
+				m.logger.Error("failed to handleSyncContactGroup when HandleSyncRawMessages", zap.Error(err))
+				continue
+			}
 		case protobuf.ApplicationMetadataMessage_SYNC_SETTING:
 			var message protobuf.SyncSetting
 			err := proto.Unmarshal(rawMessage.GetPayload(), &message)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -211,6 +212,83 @@ func (s *MessengerSyncSettingsSuite) TestSyncSettings() {
 	s.Require().Exactly(settings.URLUnfurlingDisableAll, aos.URLUnfurlingMode)
 }
 
+func (s *MessengerSyncSettingsSuite) TestSyncSettings_StatusSchedule() {
+	PairDevices(&s.Suite, s.alice2, s.alice)
+	PairDevices(&s.Suite, s.alice, s.alice2)
+
+	windows := []DoNotDisturbWindow{{Days: []time.Weekday{time.Monday, time.Friday}, Start: 22 * 60, End: 7 * 60}}
+	s.Require().NoError(s.alice.SetStatusSchedule(windows))
+
+	err := tt.RetryWithBackOff(func() error {
+		mr, err := s.alice2.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(mr.Settings) == 0 {
+			return errors.New("sync settings not in MessengerResponse")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	synced, err := s.alice2.GetStatusSchedule()
+	s.Require().NoError(err)
+	s.Require().Equal(windows, synced)
+}
+
+func (s *MessengerSyncSettingsSuite) TestSyncSettings_ContactRequestsPolicy() {
+	PairDevices(&s.Suite, s.alice2, s.alice)
+	PairDevices(&s.Suite, s.alice, s.alice2)
+
+	policy := ContactRequestsPolicy{SharedCommunity: true, ProofOfWork: true}
+	s.Require().NoError(s.alice.SetContactRequestsPolicy(policy))
+
+	err := tt.RetryWithBackOff(func() error {
+		mr, err := s.alice2.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(mr.Settings) == 0 {
+			return errors.New("sync settings not in MessengerResponse")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	synced, err := s.alice2.GetContactRequestsPolicy()
+	s.Require().NoError(err)
+	s.Require().Equal(policy, synced)
+}
+
+func (s *MessengerSyncSettingsSuite) TestSyncSettings_URLUnfurlingPrivacy() {
+	PairDevices(&s.Suite, s.alice2, s.alice)
+	PairDevices(&s.Suite, s.alice, s.alice2)
+
+	privacy := URLUnfurlingPrivacy{
+		DeniedDomains:     []string{"example.com"},
+		Proxy:             "socks5://127.0.0.1:9050",
+		DisabledChatTypes: []ChatType{ChatTypeCommunityChat},
+		CacheTTL:          3600,
+	}
+	s.Require().NoError(s.alice.SetURLUnfurlingPrivacy(privacy))
+
+	err := tt.RetryWithBackOff(func() error {
+		mr, err := s.alice2.RetrieveAll()
+		if err != nil {
+			return err
+		}
+		if len(mr.Settings) == 0 {
+			return errors.New("sync settings not in MessengerResponse")
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	synced, err := s.alice2.GetURLUnfurlingPrivacy()
+	s.Require().NoError(err)
+	s.Require().Equal(privacy, synced)
+}
+
 func (s *MessengerSyncSettingsSuite) TestSyncSettings_StickerPacks() {
 	if s.ignoreTests {
 		s.T().Skip("Currently sticker pack syncing has been deactivated, testing to resume after sticker packs works correctly")
@@ -143,6 +143,15 @@
 // 1719906191_add_community_token_version.up.sql (65B)
 // 1720636181_add_community_encryption_keys_requests.up.sql (236B)
 // 1721222369_add_shared_addresses.up.sql (98B)
+// 1721800000_add_communities_requests_to_join_answers.up.sql (189B)
+// 1721900000_add_communities_members_directory.up.sql (1.034kB)
+// 1722000000_add_safety_number_verifications.up.sql (270B)
+// 1722200000_add_contact_groups.up.sql (562B)
+// 1722700000_add_message_delivery_timeline.up.sql (447B)
+// 1722800000_add_quarantined_senders.up.sql (272B)
+// 1722900000_add_contact_request_senders.up.sql (279B)
+// 1723000000_add_link_preview_cache.up.sql (274B)
+// 1723100000_add_safety_number_installation_keys.up.sql (75B)
 // README.md (554B)
 // doc.go (870B)
 
@@ -3072,6 +3081,186 @@ func _1721222369_add_shared_addressesUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1721800000_add_communities_requests_to_join_answersUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcc\xb1\xaa\xc2\x30\x14\x87\xf1\x3d\x4f\xf1\x1f\x5b\xe8\x1b\xdc\xa9\xb9\x1c\x21\x18\x5b\x49\x8f\xd0\x4e\x41\x34\x43\x84\x26\x98\xa4\xf8\xfa\x62\x3b\xa8\x38\x7f\x7c\xbf\x7f\x43\x2d\x13\xb8\x95\x9a\xa0\x76\xe8\x7a\x06\x8d\x6a\xe0\x01\x97\x38\xcf\x4b\xf0\xc5\xbb\x6c\x93\xbb\x2f\x2e\x97\x6c\x4b\xb4\xb7\xe8\x83\x3d\x87\xfc\x70\x29\xa3\x12\x40\xda\xa2\xf5\x57\x48\xdd\xcb\xd5\xe8\x4e\x5a\x37\x02\x58\x8b\x8f\xe1\x15\x99\x46\xfe\x8a\x1b\xf2\x3b\x1d\x8d\x3a\xb4\x66\xc2\x9e\xa6\xea\x8d\x37\x9f\x58\x2d\xea\x3f\xf1\x0c\x00\x00\xff\xff\xe8\x08\xf2\x09\xbd\x00\x00\x00")
+
+func _1721800000_add_communities_requests_to_join_answersUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1721800000_add_communities_requests_to_join_answersUpSql,
+		"1721800000_add_communities_requests_to_join_answers.up.sql",
+	)
+}
+
+func _1721800000_add_communities_requests_to_join_answersUpSql() (*asset, error) {
+	bytes, err := _1721800000_add_communities_requests_to_join_answersUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1721800000_add_communities_requests_to_join_answers.up.sql", size: 189, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbc, 0x47, 0x1b, 0x72, 0x5f, 0x96, 0x9, 0x34, 0x46, 0x86, 0x6b, 0x5e, 0x17, 0x2a, 0x21, 0x3f, 0x9b, 0xc0, 0x41, 0x84, 0x0, 0x20, 0xea, 0x4d, 0x51, 0x1b, 0x9e, 0x10, 0xae, 0xc6, 0xe7, 0x12}}
+	return a, nil
+}
+
+var __1721900000_add_communities_members_directoryUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x53\xbd\x6e\x83\x30\x10\xde\x79\x8a\x1b\x83\xc4\xd0\x3d\x13\x90\x43\xb2\xea\x9a\x88\x38\x12\x99\xac\x06\x3c\xb8\x01\x1c\x61\x32\xf0\xf6\x95\xa9\x14\xec\xb4\x4d\x4b\xa3\xae\xbe\xfb\xee\xfb\x93\xd3\x02\x63\x8e\xc0\xe3\x84\x22\x90\x0c\x58\xce\x01\x4b\xb2\xe3\x3b\xa8\x74\xdb\x5e\x3a\x35\x28\x69\x44\x2b\xdb\xa3\xec\x8d\xa8\x55\x2f\xab\x41\xf7\x23\xac\x02\xb8\x6e\x8c\x42\xd5\x90\xd0\x3c\x99\xe0\x6c\x4f\x69\x14\x00\x9c\x2f\xc7\x46\x55\xe2\x24\x47\xe0\x58\x72\x6f\xd6\xeb\x46\x02\x61\xf3\x23\x6c\x30\x8b\xf7\x94\xc3\x93\x1d\xbf\x69\xd5\xc9\x5a\xbc\x0e\x77\x76\xb6\x05\x79\x89\x8b\x03\x3c\xe3\x01\x56\xae\x92\xc8\x61\x0e\x21\x67\x90\xe6\x2c\xa3\x24\xe5\x50\xe0\x96\xc6\x29\x06\xe1\x3a\x08\xd2\x0f\xe3\x84\x6d\xb0\x5c\x62\x5c\xcc\xda\x72\x76\x7f\xf5\x46\xd5\x15\x18\xae\xff\xce\x3e\x05\xb7\x90\xd8\x62\x1c\xcb\x8b\xbb\x16\x67\xd9\xb7\xca\x18\xa5\x3b\xf3\x60\xef\xf3\x25\x0b\xfd\x34\xfe\x55\xa7\x91\x7f\xe5\x1f\x2a\x76\xfc\x0a\x5f\xf1\x4f\xc9\xbb\xd0\x5b\x03\x9e\xe8\x47\xea\xa8\x1a\x5d\x9d\xbe\x6b\xc2\x8d\xf0\x8b\x60\x6c\xca\x13\xde\xfb\x59\x36\xae\xf7\x01\x00\x02\x09\xcc\x3b\x0a\x04\x00\x00")
+
+func _1721900000_add_communities_members_directoryUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1721900000_add_communities_members_directoryUpSql,
+		"1721900000_add_communities_members_directory.up.sql",
+	)
+}
+
+func _1721900000_add_communities_members_directoryUpSql() (*asset, error) {
+	bytes, err := _1721900000_add_communities_members_directoryUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1721900000_add_communities_members_directory.up.sql", size: 1034, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf3, 0x28, 0x86, 0x77, 0x14, 0x88, 0xb2, 0x21, 0xde, 0xbf, 0x5, 0x3f, 0x54, 0x14, 0x94, 0xb7, 0xf6, 0x34, 0x3c, 0xa0, 0x41, 0xe9, 0xaa, 0x17, 0x84, 0x20, 0x8b, 0x4c, 0xb5, 0x9d, 0xc1, 0x34}}
+	return a, nil
+}
+
+var __1722000000_add_safety_number_verificationsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8d\x31\x4f\xc3\x30\x10\x46\xf7\xfc\x8a\x6f\x04\x89\x81\x9d\xc9\x49\x2f\xc2\xe2\xf0\x55\xce\x45\x6d\xa7\x28\xb8\x4e\xb1\x00\x07\xa5\x06\x89\x7f\x8f\x08\x12\x0b\x4b\xe7\xf7\xf4\x5e\xe3\xc9\x28\x41\x4d\xcd\x04\xdb\xc2\x89\x82\xf6\xb6\xd3\x0e\xe7\x71\x8a\xe5\x6b\xc8\x1f\x6f\x4f\x71\x19\x3e\xe3\x92\xa6\x14\xc6\x92\xe6\x7c\xc6\x55\x05\x84\x39\x97\x31\x94\x21\x1d\xa1\xb4\x57\x6c\xbd\x7d\x34\xfe\x80\x07\x3a\x40\x1c\x1a\x71\x2d\xdb\x46\xe1\x69\xcb\xa6\xa1\x9b\x0a\x98\x52\x3e\xc5\xe5\x7d\x49\xb9\xa0\x66\xa9\xd7\x9d\xeb\x99\x7f\xe0\xef\x21\x1e\x51\x8b\x30\x19\xf7\x07\xb1\xa1\xd6\xf4\xac\x68\x0d\x77\x6b\x27\x3c\x8f\xf9\x74\x99\xf9\x3a\x87\x17\x58\xa7\xff\x9d\xdb\xea\x1a\x3b\xab\xf7\xd2\x2b\xbc\xec\xec\xe6\xae\xfa\x0e\x00\x00\xff\xff\xee\x12\xee\x33\x0e\x01\x00\x00")
+
+func _1722000000_add_safety_number_verificationsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722000000_add_safety_number_verificationsUpSql,
+		"1722000000_add_safety_number_verifications.up.sql",
+	)
+}
+
+func _1722000000_add_safety_number_verificationsUpSql() (*asset, error) {
+	bytes, err := _1722000000_add_safety_number_verificationsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722000000_add_safety_number_verifications.up.sql", size: 270, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x81, 0x7f, 0x5b, 0x2c, 0x8d, 0xe5, 0x68, 0xcc, 0x21, 0x86, 0xe5, 0x55, 0xae, 0x8a, 0x0, 0xe2, 0x52, 0xfc, 0x4d, 0x15, 0x9f, 0x6d, 0x90, 0x93, 0x61, 0x65, 0xc3, 0xae, 0xc2, 0x82, 0xb, 0x48}}
+	return a, nil
+}
+
+var __1722200000_add_contact_groupsUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\x6e\xab\x30\x10\x45\xf7\xfe\x8a\xbb\x0b\x91\xb2\x78\xfb\xac\x1c\x18\x5e\xad\xba\x76\x64\x06\x85\xac\x50\x0a\x56\x55\x15\xe2\x8a\x92\xff\xaf\x52\x0b\x89\xaa\xb4\x62\x6b\x9f\x99\x7b\xe7\xa4\x8e\x24\x13\x58\x1e\x34\x41\xe5\x30\x96\x41\x95\x2a\xb8\x40\x13\xae\xe3\xa5\x19\xeb\x97\x21\xdc\xde\x3f\x90\x08\xe0\xb5\x05\x53\xc5\x38\x3a\xf5\x24\xdd\x19\x8f\x74\x86\x35\x48\xad\xc9\xb5\x4a\x19\x8e\x8e\x5a\xa6\xb4\x13\xc0\xf5\xd2\xfb\x08\xdf\x57\x9a\x52\x6b\x64\x94\xcb\x52\x33\x36\x9b\x3b\xd0\x84\x2e\x0c\x7f\x12\xfd\x6d\xf4\x2d\x0e\xd6\x6a\x92\xe6\x27\x94\x4b\x5d\x7c\x45\xb5\xbe\xf3\xeb\xc8\xa6\x0b\xcd\x1b\x94\x59\x88\xfc\x27\xb6\x38\x29\x7e\xb0\x25\xc3\xd9\x93\xca\xf6\x42\xac\x95\x53\xf7\xbe\x7f\xf6\x43\x74\x14\x5f\x26\x53\x53\x4e\xbc\x38\xce\x2c\xfd\xcd\x8d\x26\xd3\x8a\xdd\x6c\x64\xfb\x4d\xb4\xfa\x6f\xac\xa3\xdf\x2b\x2b\x93\x51\xb5\xa6\x72\x3d\x2b\x65\xcd\x32\x93\xcc\x5a\xec\xc5\xe7\x00\x3d\xdd\xfb\x10\x32\x02\x00\x00")
+
+func _1722200000_add_contact_groupsUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722200000_add_contact_groupsUpSql,
+		"1722200000_add_contact_groups.up.sql",
+	)
+}
+
+func _1722200000_add_contact_groupsUpSql() (*asset, error) {
+	bytes, err := _1722200000_add_contact_groupsUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722200000_add_contact_groups.up.sql", size: 562, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x42, 0xc, 0x31, 0x45, 0x91, 0xc4, 0x47, 0xe2, 0x3a, 0x82, 0x8f, 0x55, 0xa4, 0x79, 0xe0, 0x1e, 0xf5, 0xfd, 0xaf, 0x63, 0x50, 0xf0, 0x20, 0x72, 0xd9, 0x71, 0x81, 0xcd, 0xa, 0x75, 0x8f, 0xaa}}
+	return a, nil
+}
+
+var __1722700000_add_message_delivery_timelineUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\x51\x4b\xc3\x30\x14\x05\xe0\xf7\xfc\x8a\xf3\xb6\x15\xfc\x07\x7d\x8a\xdd\x9d\x06\xbb\x54\xb2\x5b\xd9\x9e\x4a\xa1\x17\x17\x48\xeb\x30\xa1\xe0\xbf\x97\x88\x74\x82\x4e\x7d\xbe\x1f\x27\xe7\xa4\x72\xa4\x99\xc0\xfa\xb6\x26\x98\x2d\x6c\xc3\xa0\x83\xd9\xf3\x1e\xa3\xc4\xd8\x3f\x4b\x37\x48\xf0\xb3\xbc\xbe\x75\xc9\x8f\x12\xfc\x24\x58\x2b\xc0\x0f\x30\x96\xe9\x8e\x1c\x1e\x9d\xd9\x69\x77\xc4\x03\x1d\xa1\x5b\x6e\x8c\xad\x1c\xed\xc8\xf2\x8d\xc2\x92\xe2\x07\x3c\x69\x57\xdd\x6b\xf7\xf1\x86\x6d\xeb\x3a\x9f\x65\x96\x29\xfd\x7c\x99\x66\x09\x2f\x67\xe9\x4e\x7d\x3c\x7d\x13\xd8\xd0\x56\xb7\x35\x63\xb5\xca\x78\x90\xd4\xfb\x10\xff\x62\x79\x41\x4c\xfd\x78\xce\xdd\x17\xa4\x8a\x52\xa9\xcf\x8f\x30\x76\x43\x87\xeb\xd3\xbb\x2f\x73\x1a\x7b\xdd\xad\x2f\xae\x28\xff\x9b\x7d\x69\xf7\x6b\xf4\xc2\x8a\x52\xbd\x07\x00\x00\xff\xff\x53\xdd\x33\xcf\xbf\x01\x00\x00")
+
+func _1722700000_add_message_delivery_timelineUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722700000_add_message_delivery_timelineUpSql,
+		"1722700000_add_message_delivery_timeline.up.sql",
+	)
+}
+
+func _1722700000_add_message_delivery_timelineUpSql() (*asset, error) {
+	bytes, err := _1722700000_add_message_delivery_timelineUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722700000_add_message_delivery_timeline.up.sql", size: 447, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb5, 0xc0, 0x81, 0xf0, 0xe7, 0xfa, 0x91, 0x29, 0x85, 0x6, 0x79, 0xc6, 0x63, 0x93, 0x60, 0xf, 0xbd, 0x34, 0x51, 0x11, 0x46, 0x80, 0x7f, 0x2e, 0x86, 0x97, 0xab, 0xbd, 0x94, 0xd4, 0x22, 0x1a}}
+	return a, nil
+}
+
+var __1722800000_add_quarantined_sendersUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xbd\x4a\xc6\x30\x14\x87\xf1\x3d\x57\xf1\x1f\x15\x1c\xdc\x9d\x62\xde\x53\x0c\xc6\xe4\x25\x3d\xa5\xed\x54\x82\x09\x52\xb0\x1f\xe6\xd4\xfb\x17\x05\xc1\xa5\xee\x3f\x1e\x1e\x13\x49\x33\x81\xf5\xa3\x23\xd8\x06\x3e\x30\x68\xb0\x2d\xb7\xf8\xf8\x4c\x35\xad\xc7\xbc\x96\x3c\x49\x59\x73\xa9\x82\x1b\x05\xcc\x19\x4c\x03\xe3\x1a\xed\x8b\x8e\x23\x9e\x69\x44\xf0\x30\xc1\x37\xce\x1a\x46\xa4\xab\xd3\x86\xee\x14\x20\x7b\x5a\x26\x79\xdd\x6a\x41\x24\xed\x7e\xea\xbe\x73\x0e\x17\x6a\x74\xe7\x18\xf7\xdf\x2a\xd7\x6d\xdf\x4b\x9e\x96\x22\x92\xde\x8a\xc0\x7a\x3e\xa1\x7f\x9f\xd2\xf1\x0f\x7c\x4f\x72\xfc\x06\xcf\xa5\xba\x45\x6f\xf9\x29\x74\x8c\x18\x7a\x7b\x79\x50\x5f\x01\x00\x00\xff\xff\x57\xf4\xb7\x02\x10\x01\x00\x00")
+
+func _1722800000_add_quarantined_sendersUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722800000_add_quarantined_sendersUpSql,
+		"1722800000_add_quarantined_senders.up.sql",
+	)
+}
+
+func _1722800000_add_quarantined_sendersUpSql() (*asset, error) {
+	bytes, err := _1722800000_add_quarantined_sendersUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722800000_add_quarantined_senders.up.sql", size: 272, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb3, 0x50, 0x72, 0xd6, 0xb8, 0xc5, 0xd9, 0x82, 0x7c, 0x2b, 0x41, 0x2a, 0xee, 0xce, 0xe2, 0x61, 0x0, 0x90, 0xc3, 0x60, 0xb8, 0xb5, 0xcd, 0xcc, 0x64, 0xb9, 0xf3, 0xed, 0x9f, 0xf7, 0x17, 0x90}}
+	return a, nil
+}
+
+var __1722900000_add_contact_request_sendersUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xb1\x4e\xc3\x30\x14\x85\xe1\x3d\x4f\x71\x46\x90\x18\xd8\x99\xdc\xf4\x46\x58\x5c\xec\xca\xb9\x51\xdb\x29\x8a\x6c\x0f\x11\x91\x2d\x6c\x57\x7d\x7d\x04\x0c\x0c\x48\xd9\xbf\x73\xf4\xf7\x8e\x94\x10\x44\x1d\x98\xa0\x07\x18\x2b\xa0\x8b\x1e\x65\x84\xcf\xa9\x2d\xbe\xcd\x25\x7e\xde\x62\x6d\x73\x8d\x29\xc4\x52\xf1\xd0\x01\x6b\x80\xd0\x45\x70\x72\xfa\x5d\xb9\x2b\xde\xe8\x0a\x6b\xd0\x5b\x33\xb0\xee\x05\x8e\x4e\xac\x7a\x7a\xea\x80\xf2\x3b\xaf\xd0\x46\x7e\xee\xcd\xc4\x8c\x23\x0d\x6a\x62\xc1\xf3\x37\xb9\xaf\x29\xe4\xfb\x5c\xdb\x52\xda\x0e\xdb\x96\xfa\x57\xe3\xb7\xec\x3f\x76\xf0\x72\x6b\x79\x0e\xd1\x6f\x6b\x8a\x01\x07\x6b\x99\x94\xf9\x6f\x07\xc5\x23\x75\x8f\x38\x6b\x79\xb5\x93\xc0\xd9\xb3\x3e\xbe\x74\x5f\x01\x00\x00\xff\xff\xef\x41\xda\x02\x17\x01\x00\x00")
+
+func _1722900000_add_contact_request_sendersUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722900000_add_contact_request_sendersUpSql,
+		"1722900000_add_contact_request_senders.up.sql",
+	)
+}
+
+func _1722900000_add_contact_request_sendersUpSql() (*asset, error) {
+	bytes, err := _1722900000_add_contact_request_sendersUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722900000_add_contact_request_senders.up.sql", size: 279, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x17, 0xb7, 0xd3, 0xf, 0xea, 0xf3, 0xa9, 0xc1, 0x68, 0x24, 0x52, 0xa9, 0xcc, 0xc9, 0xcb, 0x55, 0x83, 0xaa, 0x93, 0x85, 0x21, 0x91, 0x4c, 0xc5, 0x70, 0x62, 0x58, 0x6d, 0x14, 0x59, 0xce}}
+	return a, nil
+}
+
+var __1723000000_add_link_preview_cacheUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8e\xc1\x4a\x86\x40\x14\x46\xf7\xf3\x14\xdf\xf2\x17\x7a\x03\x57\xe3\x78\xa5\x4b\xd3\x8c\x8c\x57\xd4\xd5\x20\x36\xa1\x24\x11\x66\x05\x3d\x7d\x14\x42\x09\xc1\xbf\xbd\xdf\xb9\x87\x63\x02\x69\x21\x88\x2e\x2c\x81\x2b\x38\x2f\xa0\x9e\x1b\x69\xb0\x2e\xcf\x4f\xf1\x65\x4b\xef\x4b\xfa\x88\xd3\x38\xcd\x09\x17\x05\xbc\x6d\x2b\x84\x7a\x41\x1d\xf8\x5e\x87\x01\x77\x34\xc0\x3b\x18\xef\x2a\xcb\x46\x10\xa8\xb6\xda\xd0\x8d\x02\x8e\x6f\x14\xd6\x17\x3f\x6a\xd7\x5a\xfb\x3d\xbc\x2e\x9f\x09\xec\xe4\x74\x7c\x4c\xfb\x34\xa7\x87\x38\xee\xa7\x49\x65\xe8\x58\x6e\x7d\x2b\x08\xbe\xe3\x32\x57\xea\xa8\x66\x57\x52\x7f\xb5\x3a\xfe\xf1\x7a\xf7\x0f\x70\xf9\x05\xb2\x5c\x7d\x05\x00\x00\xff\xff\xe2\xeb\x36\x52\x12\x01\x00\x00")
+
+func _1723000000_add_link_preview_cacheUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723000000_add_link_preview_cacheUpSql,
+		"1723000000_add_link_preview_cache.up.sql",
+	)
+}
+
+func _1723000000_add_link_preview_cacheUpSql() (*asset, error) {
+	bytes, err := _1723000000_add_link_preview_cacheUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723000000_add_link_preview_cache.up.sql", size: 274, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x80, 0x78, 0x57, 0xda, 0xaa, 0xdf, 0xa6, 0xaf, 0xab, 0xcb, 0xc1, 0xc1, 0xc1, 0x7, 0x92, 0x3e, 0x29, 0x7e, 0x9e, 0xe4, 0xaf, 0xb, 0x4e, 0xb0, 0xf3, 0xda, 0xe6, 0x86, 0x9f, 0x17, 0x4f, 0x59}}
+	return a, nil
+}
+
+var __1723100000_add_safety_number_installation_keysUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x73\xf4\x09\x71\x0d\x52\x08\x71\x74\xf2\x71\x55\x28\x4e\x4c\x4b\x2d\xa9\x8c\xcf\x2b\xcd\x4d\x4a\x2d\x8a\x2f\x4b\x2d\xca\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\x2b\x56\x70\x74\x71\x51\x70\xf6\xf7\x09\xf5\xf5\x53\xc8\xcc\x2b\x2e\x49\xcc\xc9\x01\x4b\xc4\x67\xa7\x56\x16\x2b\x38\xf9\xf8\x3b\x59\x73\x01\x00\xb7\x55\x39\xc5\x4b\x00\x00\x00")
+
+func _1723100000_add_safety_number_installation_keysUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1723100000_add_safety_number_installation_keysUpSql,
+		"1723100000_add_safety_number_installation_keys.up.sql",
+	)
+}
+
+func _1723100000_add_safety_number_installation_keysUpSql() (*asset, error) {
+	bytes, err := _1723100000_add_safety_number_installation_keysUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1723100000_add_safety_number_installation_keys.up.sql", size: 75, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9, 0xe8, 0x68, 0xf6, 0x77, 0x42, 0xa5, 0xe2, 0xb7, 0x96, 0x82, 0x8, 0xd, 0x4d, 0xa6, 0x28, 0xd0, 0x30, 0xef, 0x5c, 0xed, 0xfb, 0x71, 0xf2, 0x52, 0xe7, 0x97, 0x56, 0xc4, 0xe6, 0xc2, 0x3b}}
+	return a, nil
+}
+
 var _readmeMd = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xc1\xce\xd3\x30\x10\x84\xef\x7e\x8a\x91\x7a\x01\xa9\x2a\x8f\xc0\x0d\x71\x82\x03\x48\x1c\xc9\x36\x9e\x36\x96\x1c\x6f\xf0\xae\x93\xe6\xed\x91\xa3\xc2\xdf\xff\x66\xed\xd8\x33\xdf\x78\x4f\xa7\x13\xbe\xea\x06\x57\x6c\x35\x39\x31\xa7\x7b\x15\x4f\x5a\xec\x73\x08\xbf\x08\x2d\x79\x7f\x4a\x43\x5b\x86\x17\xfd\x8c\x21\xea\x56\x5e\x47\x90\x4a\x14\x75\x48\xde\x64\x37\x2c\x6a\x96\xae\x99\x48\x05\xf6\x27\x77\x13\xad\x08\xae\x8a\x51\xe7\x25\xf3\xf1\xa9\x9f\xf9\x58\x58\x2c\xad\xbc\xe0\x8b\x56\xf0\x21\x5d\xeb\x4c\x95\xb3\xae\x84\x60\xd4\xdc\xe6\x82\x5d\x1b\x36\x6d\x39\x62\x92\xf5\xb8\x11\xdb\x92\xd3\x28\xce\xe0\x13\xe1\x72\xcd\x3c\x63\xd4\x65\x87\xae\xac\xe8\xc3\x28\x2e\x67\x44\x66\x3a\x21\x25\xa2\x72\xac\x14\x67\xbc\x84\x9f\x53\x32\x8c\x52\x70\x25\x56\xd6\xfd\x8d\x05\x37\xad\x30\x9d\x9f\xa6\x86\x0f\xcd\x58\x7f\xcf\x34\x93\x3b\xed\x90\x9f\xa4\x1f\xcf\x30\x85\x4d\x07\x58\xaf\x7f\x25\xc4\x9d\xf3\x72\x64\x84\xd0\x7f\xf9\x9b\x3a\x2d\x84\xef\x85\x48\x66\x8d\xd8\x88\x9b\x8c\x8c\x98\x5b\xf6\x74\x14\x4e\x33\x0d\xc9\xe0\x93\x38\xda\x12\xc5\x69\xbd\xe4\xf0\x2e\x7a\x78\x07\x1c\xfe\x13\x9f\x91\x29\x31\x95\x7b\x7f\x62\x59\x37\xb4\xe5\x5e\x25\xfe\x33\xee\xd5\x53\x71\xd6\xda\x3a\xd8\xcb\xde\x2e\xf8\xa1\x90\x55\x53\x0c\xc7\xaa\x0d\xe9\x76\x14\x29\x1c\x7b\x68\xdd\x2f\xe1\x6f\x00\x00\x00\xff\xff\x3c\x0a\xc2\xfe\x2a\x02\x00\x00")
 
 func readmeMdBytes() ([]byte, error) {
@@ -3346,8 +3535,17 @@ var _bindata = map[string]func() (*asset, error){
 	"1719906191_add_community_token_version.up.sql":                               _1719906191_add_community_token_versionUpSql,
 	"1720636181_add_community_encryption_keys_requests.up.sql":                    _1720636181_add_community_encryption_keys_requestsUpSql,
 	"1721222369_add_shared_addresses.up.sql":                                      _1721222369_add_shared_addressesUpSql,
-	"README.md":                                                                   readmeMd,
-	"doc.go":                                                                      docGo,
+	"1721800000_add_communities_requests_to_join_answers.up.sql":                  _1721800000_add_communities_requests_to_join_answersUpSql,
+	"1721900000_add_communities_members_directory.up.sql":                         _1721900000_add_communities_members_directoryUpSql,
+	"1722000000_add_safety_number_verifications.up.sql":                           _1722000000_add_safety_number_verificationsUpSql,
+	"1722200000_add_contact_groups.up.sql":                                        _1722200000_add_contact_groupsUpSql,
+	"1722700000_add_message_delivery_timeline.up.sql":                             _1722700000_add_message_delivery_timelineUpSql,
+	"1722800000_add_quarantined_senders.up.sql":                                   _1722800000_add_quarantined_sendersUpSql,
+	"1722900000_add_contact_request_senders.up.sql":                               _1722900000_add_contact_request_sendersUpSql,
+	"1723000000_add_link_preview_cache.up.sql":                                    _1723000000_add_link_preview_cacheUpSql,
+	"1723100000_add_safety_number_installation_keys.up.sql":                       _1723100000_add_safety_number_installation_keysUpSql,
+	"README.md": readmeMd,
+	"doc.go":    docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -3539,8 +3737,17 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1719906191_add_community_token_version.up.sql":                               {_1719906191_add_community_token_versionUpSql, map[string]*bintree{}},
 	"1720636181_add_community_encryption_keys_requests.up.sql":                    {_1720636181_add_community_encryption_keys_requestsUpSql, map[string]*bintree{}},
 	"1721222369_add_shared_addresses.up.sql":                                      {_1721222369_add_shared_addressesUpSql, map[string]*bintree{}},
-	"README.md":                                                                   {readmeMd, map[string]*bintree{}},
-	"doc.go":                                                                      {docGo, map[string]*bintree{}},
+	"1721800000_add_communities_requests_to_join_answers.up.sql":                  {_1721800000_add_communities_requests_to_join_answersUpSql, map[string]*bintree{}},
+	"1721900000_add_communities_members_directory.up.sql":                         {_1721900000_add_communities_members_directoryUpSql, map[string]*bintree{}},
+	"1722000000_add_safety_number_verifications.up.sql":                           {_1722000000_add_safety_number_verificationsUpSql, map[string]*bintree{}},
+	"1722200000_add_contact_groups.up.sql":                                        {_1722200000_add_contact_groupsUpSql, map[string]*bintree{}},
+	"1722700000_add_message_delivery_timeline.up.sql":                             {_1722700000_add_message_delivery_timelineUpSql, map[string]*bintree{}},
+	"1722800000_add_quarantined_senders.up.sql":                                   {_1722800000_add_quarantined_sendersUpSql, map[string]*bintree{}},
+	"1722900000_add_contact_request_senders.up.sql":                               {_1722900000_add_contact_request_sendersUpSql, map[string]*bintree{}},
+	"1723000000_add_link_preview_cache.up.sql":                                    {_1723000000_add_link_preview_cacheUpSql, map[string]*bintree{}},
+	"1723100000_add_safety_number_installation_keys.up.sql":                       {_1723100000_add_safety_number_installation_keysUpSql, map[string]*bintree{}},
+	"README.md": {readmeMd, map[string]*bintree{}},
+	"doc.go":    {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
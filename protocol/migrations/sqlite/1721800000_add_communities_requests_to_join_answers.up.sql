@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS communities_requests_to_join_answers (
+  request_id BLOB NOT NULL,
+  question_id TEXT NOT NULL,
+  answer BLOB NOT NULL,
+  PRIMARY KEY(request_id, question_id)
+);
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS communities_members_directory (
+  community_id BLOB NOT NULL,
+  public_key TEXT NOT NULL,
+  role INT NOT NULL DEFAULT 0,
+  joined_at INT NOT NULL DEFAULT 0,
+  PRIMARY KEY (community_id, public_key) ON CONFLICT REPLACE
+);
+
+CREATE INDEX IF NOT EXISTS communities_members_directory_joined_at ON communities_members_directory(community_id, joined_at);
+CREATE INDEX IF NOT EXISTS communities_members_directory_role ON communities_members_directory(community_id, role);
+
+CREATE TABLE IF NOT EXISTS communities_members_directory_permissions (
+  community_id BLOB NOT NULL,
+  public_key TEXT NOT NULL,
+  permission_id TEXT NOT NULL,
+  PRIMARY KEY (community_id, public_key, permission_id) ON CONFLICT REPLACE
+);
+
+CREATE INDEX IF NOT EXISTS communities_members_directory_permissions_permission_id ON communities_members_directory_permissions(community_id, permission_id);
+
+CREATE TABLE IF NOT EXISTS communities_members_directory_clocks (
+  community_id BLOB PRIMARY KEY ON CONFLICT REPLACE,
+  clock INT NOT NULL
+);
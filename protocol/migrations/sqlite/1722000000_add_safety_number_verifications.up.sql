@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS safety_number_verifications (
+  contact_id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  fingerprint BLOB NOT NULL,
+  verified BOOLEAN NOT NULL DEFAULT FALSE,
+  changed BOOLEAN NOT NULL DEFAULT FALSE,
+  clock INT NOT NULL DEFAULT 0
+) WITHOUT ROWID;
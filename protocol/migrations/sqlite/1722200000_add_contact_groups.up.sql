@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS contact_groups (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  name TEXT NOT NULL DEFAULT '',
+  color TEXT NOT NULL DEFAULT '',
+  muted BOOLEAN NOT NULL DEFAULT FALSE,
+  deleted BOOLEAN NOT NULL DEFAULT FALSE,
+  clock INT NOT NULL DEFAULT 0
+) WITHOUT ROWID;
+
+CREATE TABLE IF NOT EXISTS contact_group_members (
+  group_id TEXT NOT NULL,
+  contact_id TEXT NOT NULL,
+  PRIMARY KEY (group_id, contact_id) ON CONFLICT IGNORE
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS contact_group_members_contact_id ON contact_group_members(contact_id);
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS message_delivery_timeline (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  message_id VARCHAR NOT NULL,
+  event VARCHAR NOT NULL,
+  envelope_hash VARCHAR NOT NULL DEFAULT '',
+  details VARCHAR NOT NULL DEFAULT '',
+  timestamp INT NOT NULL
+);
+
+CREATE INDEX message_delivery_timeline_message_id ON message_delivery_timeline(message_id);
+CREATE INDEX message_delivery_timeline_timestamp ON message_delivery_timeline(timestamp);
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS quarantined_senders (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  spam_score REAL NOT NULL DEFAULT 0,
+  dropped_messages INT NOT NULL DEFAULT 0,
+  quarantined_at INT NOT NULL DEFAULT 0,
+  last_message_at INT NOT NULL DEFAULT 0
+) WITHOUT ROWID;
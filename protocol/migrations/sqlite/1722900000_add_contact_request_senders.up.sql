@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS contact_request_senders (
+  id TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  requests INT NOT NULL DEFAULT 0,
+  window_start INT NOT NULL DEFAULT 0,
+  last_request_clock INT NOT NULL DEFAULT 0,
+  auto_declined BOOLEAN NOT NULL DEFAULT FALSE
+) WITHOUT ROWID;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS link_preview_cache (
+  url TEXT PRIMARY KEY ON CONFLICT REPLACE,
+  preview BLOB NOT NULL,
+  size INT NOT NULL,
+  fetched_at INT NOT NULL
+) WITHOUT ROWID;
+
+CREATE INDEX IF NOT EXISTS link_preview_cache_fetched_at ON link_preview_cache(fetched_at);
@@ -0,0 +1 @@
+ALTER TABLE safety_number_verifications ADD COLUMN installation_keys BLOB;
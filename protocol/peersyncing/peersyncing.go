@@ -40,6 +40,11 @@ func (p *PeerSyncing) AvailableMessagesMapByChatIDs(groupIDs [][]byte, limit int
 	return availableMessagesMap, err
 }
 
+// AvailableMessagesByChatID returns the latest messages of the chat, most recent first
+func (p *PeerSyncing) AvailableMessagesByChatID(chatID []byte, limit int) ([]SyncMessage, error) {
+	return p.persistence.ByChatIDs([][]byte{chatID}, limit)
+}
+
 func (p *PeerSyncing) MessagesByIDs(messageIDs [][]byte) ([]SyncMessage, error) {
 	return p.persistence.ByMessageIDs(messageIDs)
 }
@@ -124,4 +124,10 @@ func (s *PeerSyncingSuite) TestOrderAndLimit() {
 	s.Require().NoError(err)
 	s.Require().Len(byChatID, 1)
 	s.Require().Len(byChatID[types.Bytes2Hex(testCommunityID)], 3)
+
+	messages, err := s.p.AvailableMessagesByChatID(testCommunityID, 2)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 2)
+	s.Require().Equal(syncMessage4.ID, messages[0].ID)
+	s.Require().Equal(syncMessage3.ID, messages[1].ID)
 }
@@ -1137,12 +1137,16 @@ func (db sqlitePersistence) InsertStatusUpdate(userStatus UserStatus) error {
 		public_key,
 		status_type,
 		clock,
-		custom_text)
-		VALUES (?, ?, ?, ?)`,
+		custom_text,
+		emoji,
+		expires_at)
+		VALUES (?, ?, ?, ?, ?, ?)`,
 		userStatus.PublicKey,
 		userStatus.StatusType,
 		userStatus.Clock,
 		userStatus.CustomText,
+		userStatus.Emoji,
+		userStatus.ExpiresAt,
 	)
 
 	return err
@@ -1164,7 +1168,9 @@ func (db sqlitePersistence) StatusUpdates() (statusUpdates []UserStatus, err err
 			public_key,
 			status_type,
 			clock,
-			custom_text
+			custom_text,
+			emoji,
+			expires_at
 		FROM status_updates
 	`)
 	if err != nil {
@@ -1179,6 +1185,8 @@ func (db sqlitePersistence) StatusUpdates() (statusUpdates []UserStatus, err err
 			&userStatus.StatusType,
 			&userStatus.Clock,
 			&userStatus.CustomText,
+			&userStatus.Emoji,
+			&userStatus.ExpiresAt,
 		)
 		if err != nil {
 			return
@@ -1267,7 +1275,9 @@ func (db sqlitePersistence) DeactivatedAutomaticStatusUpdates(fromClock uint64,
 			public_key,
 			?,
 			clock + 1,
-			custom_text
+			custom_text,
+			emoji,
+			expires_at
 		FROM status_updates
 		WHERE clock > ? AND clock <= ? AND status_type = ?
 	`, protobuf.StatusUpdate_INACTIVE, fromClock, tillClock, protobuf.StatusUpdate_AUTOMATIC)
@@ -1283,6 +1293,8 @@ func (db sqlitePersistence) DeactivatedAutomaticStatusUpdates(fromClock uint64,
 			&userStatus.StatusType,
 			&userStatus.Clock,
 			&userStatus.CustomText,
+			&userStatus.Emoji,
+			&userStatus.ExpiresAt,
 		)
 		if err != nil {
 			return
@@ -0,0 +1,156 @@
+package protocol
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SaveContactGroup replaces the group and its members
+func (db *sqlitePersistence) SaveContactGroup(group *ContactGroup) (err error) {
+	tx, err := db.db.BeginTx(context.Background(), &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec("INSERT INTO contact_groups(id, name, color, muted, deleted, clock) VALUES(?,?,?,?,?,?)",
+		group.ID, group.Name, group.Color, group.Muted, group.Deleted, group.Clock)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("DELETE FROM contact_group_members WHERE group_id = ?", group.ID)
+	if err != nil {
+		return err
+	}
+
+	if group.Deleted {
+		return nil
+	}
+
+	stmt, err := tx.Prepare("INSERT INTO contact_group_members(group_id, contact_id) VALUES(?,?)")
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, memberID := range group.MemberIDs {
+		_, err = stmt.Exec(group.ID, memberID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *sqlitePersistence) ContactGroup(id string) (*ContactGroup, error) {
+	groups, err := db.contactGroups("WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	return groups[0], nil
+}
+
+// ContactGroups returns all the groups, including the deleted ones
+func (db *sqlitePersistence) ContactGroups() ([]*ContactGroup, error) {
+	return db.contactGroups("")
+}
+
+func (db *sqlitePersistence) contactGroups(where string, args ...interface{}) ([]*ContactGroup, error) {
+	rows, err := db.db.Query(`
+  SELECT
+    id,
+    name,
+    color,
+    muted,
+    deleted,
+    clock
+  FROM
+    contact_groups
+  `+where+`
+  ORDER BY name`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []*ContactGroup
+	groupsByID := make(map[string]*ContactGroup)
+	for rows.Next() {
+		g := &ContactGroup{MemberIDs: []string{}}
+		err = rows.Scan(
+			&g.ID,
+			&g.Name,
+			&g.Color,
+			&g.Muted,
+			&g.Deleted,
+			&g.Clock,
+		)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, g)
+		groupsByID[g.ID] = g
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	members, err := db.db.Query("SELECT group_id, contact_id FROM contact_group_members ORDER BY contact_id")
+	if err != nil {
+		return nil, err
+	}
+	defer members.Close()
+
+	for members.Next() {
+		var groupID, contactID string
+		if err = members.Scan(&groupID, &contactID); err != nil {
+			return nil, err
+		}
+		if g, ok := groupsByID[groupID]; ok {
+			g.MemberIDs = append(g.MemberIDs, contactID)
+		}
+	}
+
+	return groups, members.Err()
+}
+
+// MutedContactGroupMembers returns the contacts belonging to at least one muted group
+func (db *sqlitePersistence) MutedContactGroupMembers() (map[string]bool, error) {
+	rows, err := db.db.Query(`
+  SELECT DISTINCT
+    m.contact_id
+  FROM
+    contact_group_members m
+  JOIN
+    contact_groups g ON g.id = m.group_id
+  WHERE
+    g.muted AND NOT g.deleted
+  `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	muted := make(map[string]bool)
+	for rows.Next() {
+		var contactID string
+		if err = rows.Scan(&contactID); err != nil {
+			return nil, err
+		}
+		muted[contactID] = true
+	}
+
+	return muted, rows.Err()
+}
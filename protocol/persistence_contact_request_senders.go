@@ -0,0 +1,58 @@
+package protocol
+
+import (
+	"database/sql"
+)
+
+// contactRequestSender counts the contact requests received from a sender
+type contactRequestSender struct {
+	ID       string
+	Requests int
+	// WindowStart is the time in ms the requests are counted from
+	WindowStart      uint64
+	LastRequestClock uint64
+	AutoDeclined     bool
+}
+
+func (db *sqlitePersistence) ContactRequestSender(id string) (*contactRequestSender, error) {
+	sender := &contactRequestSender{ID: id}
+	err := db.db.QueryRow("SELECT requests, window_start, last_request_clock, auto_declined FROM contact_request_senders WHERE id = ?", id).
+		Scan(&sender.Requests, &sender.WindowStart, &sender.LastRequestClock, &sender.AutoDeclined)
+	if err == sql.ErrNoRows {
+		return sender, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sender, nil
+}
+
+func (db *sqlitePersistence) SaveContactRequestSender(sender *contactRequestSender) error {
+	_, err := db.db.Exec("INSERT INTO contact_request_senders(id, requests, window_start, last_request_clock, auto_declined) VALUES(?,?,?,?,?)",
+		sender.ID, sender.Requests, sender.WindowStart, sender.LastRequestClock, sender.AutoDeclined)
+	return err
+}
+
+// AutoDeclinedContactRequestSenders returns the IDs of the senders declined for sending too many contact requests
+func (db *sqlitePersistence) AutoDeclinedContactRequestSenders() ([]string, error) {
+	rows, err := db.db.Query("SELECT id FROM contact_request_senders WHERE auto_declined ORDER BY window_start DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+	return result, rows.Err()
+}
+
+func (db *sqlitePersistence) DeleteContactRequestSender(id string) error {
+	_, err := db.db.Exec("DELETE FROM contact_request_senders WHERE id = ?", id)
+	return err
+}
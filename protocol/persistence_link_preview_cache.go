@@ -0,0 +1,95 @@
+package protocol
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/status-im/status-go/protocol/common"
+)
+
+// CachedLinkPreview returns the preview cached for url if it was fetched after fetchedAfter
+func (db *sqlitePersistence) CachedLinkPreview(url string, fetchedAfter uint64) (*common.LinkPreview, error) {
+	var data []byte
+	err := db.db.QueryRow("SELECT preview FROM link_preview_cache WHERE url = ? AND fetched_at > ?", url, fetchedAfter).Scan(&data)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &common.LinkPreview{}
+	err = json.Unmarshal(data, preview)
+	if err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+func (db *sqlitePersistence) SaveCachedLinkPreview(url string, preview *common.LinkPreview, fetchedAt uint64) error {
+	data, err := json.Marshal(preview)
+	if err != nil {
+		return err
+	}
+	_, err = db.db.Exec("INSERT INTO link_preview_cache(url, preview, size, fetched_at) VALUES(?,?,?,?)", url, data, len(data), fetchedAt)
+	return err
+}
+
+// PruneLinkPreviewCache removes the previews fetched before fetchedAfter,
+// and the least recently fetched previews over maxSize bytes in total
+func (db *sqlitePersistence) PruneLinkPreviewCache(fetchedAfter uint64, maxSize uint64) (err error) {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.Exec("DELETE FROM link_preview_cache WHERE fetched_at <= ?", fetchedAfter)
+	if err != nil {
+		return err
+	}
+
+	rows, err := tx.Query("SELECT url, size FROM link_preview_cache ORDER BY fetched_at DESC")
+	if err != nil {
+		return err
+	}
+
+	var total uint64
+	var evicted []string
+	for rows.Next() {
+		var url string
+		var size uint64
+		if err = rows.Scan(&url, &size); err != nil {
+			rows.Close()
+			return err
+		}
+		total += size
+		if total > maxSize {
+			evicted = append(evicted, url)
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return err
+	}
+
+	for _, url := range evicted {
+		_, err = tx.Exec("DELETE FROM link_preview_cache WHERE url = ?", url)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *sqlitePersistence) ClearLinkPreviewCache() error {
+	_, err := db.db.Exec("DELETE FROM link_preview_cache")
+	return err
+}
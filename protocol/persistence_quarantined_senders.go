@@ -0,0 +1,32 @@
+package protocol
+
+func (db *sqlitePersistence) SaveQuarantinedSender(sender *QuarantinedSender) error {
+	_, err := db.db.Exec("INSERT INTO quarantined_senders(id, spam_score, dropped_messages, quarantined_at, last_message_at) VALUES(?,?,?,?,?)",
+		sender.ID, sender.SpamScore, sender.DroppedMessages, sender.QuarantinedAt, sender.LastMessageAt)
+	return err
+}
+
+func (db *sqlitePersistence) DeleteQuarantinedSender(id string) error {
+	_, err := db.db.Exec("DELETE FROM quarantined_senders WHERE id = ?", id)
+	return err
+}
+
+// QuarantinedSenders returns the quarantined senders, most recently quarantined first
+func (db *sqlitePersistence) QuarantinedSenders() ([]*QuarantinedSender, error) {
+	rows, err := db.db.Query("SELECT id, spam_score, dropped_messages, quarantined_at, last_message_at FROM quarantined_senders ORDER BY quarantined_at DESC")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*QuarantinedSender
+	for rows.Next() {
+		sender := &QuarantinedSender{}
+		err := rows.Scan(&sender.ID, &sender.SpamScore, &sender.DroppedMessages, &sender.QuarantinedAt, &sender.LastMessageAt)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, sender)
+	}
+	return result, rows.Err()
+}
@@ -2128,3 +2128,46 @@ func TestGetCommunityMemberMessages(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, messages, 2)
 }
+
+func TestContactGroups(t *testing.T) {
+	db, err := openTestDB()
+	require.NoError(t, err)
+	p := newSQLitePersistence(db)
+
+	work := &ContactGroup{ID: "work", Name: "Work", MemberIDs: []string{"0x01", "0x02"}, Clock: 1}
+	family := &ContactGroup{ID: "family", Name: "Family", MemberIDs: []string{"0x02", "0x03"}, Muted: true, Clock: 1}
+	require.NoError(t, p.SaveContactGroup(work))
+	require.NoError(t, p.SaveContactGroup(family))
+
+	groups, err := p.ContactGroups()
+	require.NoError(t, err)
+	require.Equal(t, []*ContactGroup{family, work}, groups)
+
+	muted, err := p.MutedContactGroupMembers()
+	require.NoError(t, err)
+	require.Equal(t, map[string]bool{"0x02": true, "0x03": true}, muted)
+
+	// Members are replaced
+	work.MemberIDs = []string{"0x03"}
+	work.Clock = 2
+	require.NoError(t, p.SaveContactGroup(work))
+	group, err := p.ContactGroup("work")
+	require.NoError(t, err)
+	require.Equal(t, work, group)
+
+	// Deleted groups are kept without members
+	family.Deleted = true
+	require.NoError(t, p.SaveContactGroup(family))
+	group, err = p.ContactGroup("family")
+	require.NoError(t, err)
+	require.True(t, group.Deleted)
+	require.Empty(t, group.MemberIDs)
+
+	muted, err = p.MutedContactGroupMembers()
+	require.NoError(t, err)
+	require.Empty(t, muted)
+
+	group, err = p.ContactGroup("other")
+	require.NoError(t, err)
+	require.Nil(t, group)
+}
@@ -43,6 +43,9 @@ const (
 	SyncSetting_SHOW_COMMUNITY_ASSET_WHEN_SENDING_TOKENS SyncSetting_Type = 19
 	SyncSetting_DISPLAY_ASSETS_BELOW_BALANCE             SyncSetting_Type = 20
 	SyncSetting_DISPLAY_ASSETS_BELOW_BALANCE_THRESHOLD   SyncSetting_Type = 21
+	SyncSetting_STATUS_SCHEDULE                          SyncSetting_Type = 22
+	SyncSetting_CONTACT_REQUESTS_POLICY                  SyncSetting_Type = 23
+	SyncSetting_URL_UNFURLING_PRIVACY                    SyncSetting_Type = 24
 )
 
 // Enum value maps for SyncSetting_Type.
@@ -68,6 +71,9 @@ var (
 		19: "SHOW_COMMUNITY_ASSET_WHEN_SENDING_TOKENS",
 		20: "DISPLAY_ASSETS_BELOW_BALANCE",
 		21: "DISPLAY_ASSETS_BELOW_BALANCE_THRESHOLD",
+		22: "STATUS_SCHEDULE",
+		23: "CONTACT_REQUESTS_POLICY",
+		24: "URL_UNFURLING_PRIVACY",
 	}
 	SyncSetting_Type_value = map[string]int32{
 		"UNKNOWN":                                  0,
@@ -90,6 +96,9 @@ var (
 		"SHOW_COMMUNITY_ASSET_WHEN_SENDING_TOKENS": 19,
 		"DISPLAY_ASSETS_BELOW_BALANCE":             20,
 		"DISPLAY_ASSETS_BELOW_BALANCE_THRESHOLD":   21,
+		"STATUS_SCHEDULE":                          22,
+		"CONTACT_REQUESTS_POLICY":                  23,
+		"URL_UNFURLING_PRIVACY":                    24,
 	}
 )
 
@@ -250,7 +259,7 @@ var File_sync_settings_proto protoreflect.FileDescriptor
 var file_sync_settings_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x22,
-	0xa6, 0x06, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0xf3, 0x06, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12,
 	0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x74,
 	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
@@ -264,7 +273,7 @@ var file_sync_settings_proto_rawDesc = []byte{
 	0x08, 0x48, 0x00, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x21,
 	0x0a, 0x0b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20,
 	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x22, 0xbb, 0x04, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x34, 0x22, 0x88, 0x05, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
 	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x55, 0x52, 0x52, 0x45,
 	0x4e, 0x43, 0x59, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x49, 0x46, 0x5f, 0x52, 0x45, 0x43,
 	0x45, 0x4e, 0x54, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x49, 0x46, 0x5f, 0x46, 0x41,
@@ -296,12 +305,17 @@ var file_sync_settings_proto_rawDesc = []byte{
 	0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x14, 0x12, 0x2a, 0x0a,
 	0x26, 0x44, 0x49, 0x53, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x41, 0x53, 0x53, 0x45, 0x54, 0x53, 0x5f,
 	0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x54, 0x48,
-	0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x15, 0x22, 0x04, 0x08, 0x10, 0x10, 0x10, 0x22,
-	0x04, 0x08, 0x11, 0x10, 0x11, 0x2a, 0x0d, 0x45, 0x4e, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e,
-	0x41, 0x4d, 0x45, 0x53, 0x2a, 0x19, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x57, 0x41,
-	0x54, 0x43, 0x48, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x42,
-	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x10, 0x15, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x10, 0x16, 0x12, 0x1b,
+	0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53,
+	0x54, 0x53, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x10, 0x17, 0x12, 0x19, 0x0a, 0x15, 0x55,
+	0x52, 0x4c, 0x5f, 0x55, 0x4e, 0x46, 0x55, 0x52, 0x4c, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52, 0x49,
+	0x56, 0x41, 0x43, 0x59, 0x10, 0x18, 0x22, 0x04, 0x08, 0x10, 0x10, 0x10, 0x22, 0x04, 0x08, 0x11,
+	0x10, 0x11, 0x2a, 0x0d, 0x45, 0x4e, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x4e, 0x41, 0x4d, 0x45,
+	0x53, 0x2a, 0x19, 0x49, 0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x5f, 0x57, 0x41, 0x54, 0x43, 0x48,
+	0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
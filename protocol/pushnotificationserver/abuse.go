@@ -0,0 +1,231 @@
+package pushnotificationserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const defaultMaxNotificationsPerSenderPerMinute = 60
+const defaultMaxNotificationsPerTokenPerMinute = 20
+const defaultDuplicateNotificationWindow = 30 * time.Second
+
+// Idle limiters are full again after a minute, so they can be dropped
+const abuseProtectionPruneInterval = 5 * time.Minute
+
+const (
+	StatRegistrations = "registrations"
+	StatQueries       = "queries"
+	StatRequests      = "requests"
+	StatDeliveries    = "deliveries"
+	StatFailures      = "failures"
+	StatInvalidTokens = "invalid_tokens"
+	StatRateLimited   = "rate_limited"
+	StatDuplicates    = "duplicates"
+	StatBlocked       = "blocked"
+)
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// abuseProtection keeps track of the registrations, senders and device tokens, to drop the registrations
+// of blocked public keys, the notifications to and from them, senders and tokens over their rate limit
+// and duplicate notifications.
+// Requests are sent from ephemeral keys, the sender of a notification is only known from the author
+// it claims, so the sender limit and blocking are best effort: a sender can evade them by claiming
+// another author, and a flood claiming someone else's author throttles theirs in that chat only
+type abuseProtection struct {
+	mutex sync.Mutex
+
+	maxNotificationsPerSender int
+	maxNotificationsPerToken  int
+	duplicateWindow           time.Duration
+
+	senderLimiters      map[string]*limiterEntry
+	tokenLimiters       map[string]*limiterEntry
+	recentNotifications map[string]time.Time
+	lastPruned          time.Time
+
+	// blocked public keys, as key and hashed key of the registrations, and as author of the notifications
+	blockedKeys       map[string]bool
+	blockedHashedKeys map[string]bool
+	blockedAuthors    map[string]bool
+}
+
+func newAbuseProtection(config *Config) *abuseProtection {
+	return &abuseProtection{
+		maxNotificationsPerSender: config.MaxNotificationsPerSenderPerMinute,
+		maxNotificationsPerToken:  config.MaxNotificationsPerTokenPerMinute,
+		duplicateWindow:           config.DuplicateNotificationWindow,
+		senderLimiters:            make(map[string]*limiterEntry),
+		tokenLimiters:             make(map[string]*limiterEntry),
+		recentNotifications:       make(map[string]time.Time),
+		lastPruned:                time.Now(),
+		blockedKeys:               make(map[string]bool),
+		blockedHashedKeys:         make(map[string]bool),
+		blockedAuthors:            make(map[string]bool),
+	}
+}
+
+// authorID is the author of the notifications sent by the public key
+func authorID(publicKey *ecdsa.PublicKey) string {
+	return hex.EncodeToString(common.Shake256([]byte(types.EncodeHex(crypto.FromECDSAPub(publicKey)))))
+}
+
+// senderKey identifies the sender of a notification, as the author it claims in the chat
+func senderKey(pn *protobuf.PushNotification) string {
+	return hex.EncodeToString(pn.Author) + ":" + hex.EncodeToString(pn.ChatId)
+}
+
+func (a *abuseProtection) block(publicKey *ecdsa.PublicKey) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.blockedKeys[types.EncodeHex(crypto.FromECDSAPub(publicKey))] = true
+	a.blockedHashedKeys[hex.EncodeToString(common.HashPublicKey(publicKey))] = true
+	a.blockedAuthors[authorID(publicKey)] = true
+}
+
+func (a *abuseProtection) unblock(publicKey *ecdsa.PublicKey) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.blockedKeys, types.EncodeHex(crypto.FromECDSAPub(publicKey)))
+	delete(a.blockedHashedKeys, hex.EncodeToString(common.HashPublicKey(publicKey)))
+	delete(a.blockedAuthors, authorID(publicKey))
+}
+
+// isBlocked returns whether the public key, or its hashed version, has been blocked
+func (a *abuseProtection) isBlocked(publicKey *ecdsa.PublicKey) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.blockedKeys[types.EncodeHex(crypto.FromECDSAPub(publicKey))] || a.blockedHashedKeys[hex.EncodeToString(common.HashPublicKey(publicKey))]
+}
+
+// checkNotification returns the stat of the reason why the notification shouldn't be sent, if any
+func (a *abuseProtection) checkNotification(pn *protobuf.PushNotification, registration *protobuf.PushNotificationRegistration, now time.Time) string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.prune(now)
+
+	// pn.PublicKey is the hashed key of the registration
+	if a.blockedHashedKeys[hex.EncodeToString(pn.PublicKey)] || a.blockedAuthors[hex.EncodeToString(pn.Author)] {
+		return StatBlocked
+	}
+
+	duplicateKey := notificationKey(pn)
+	if sentAt, ok := a.recentNotifications[duplicateKey]; ok && now.Sub(sentAt) < a.duplicateWindow {
+		return StatDuplicates
+	}
+
+	if !a.allow(a.senderLimiters, senderKey(pn), a.maxNotificationsPerSender, now) {
+		return StatRateLimited
+	}
+	if !a.allow(a.tokenLimiters, registration.DeviceToken, a.maxNotificationsPerToken, now) {
+		return StatRateLimited
+	}
+
+	a.recentNotifications[duplicateKey] = now
+	return ""
+}
+
+func (a *abuseProtection) allow(limiters map[string]*limiterEntry, key string, perMinute int, now time.Time) bool {
+	entry, ok := limiters[key]
+	if !ok {
+		entry = &limiterEntry{limiter: rate.NewLimiter(rate.Every(time.Minute/time.Duration(perMinute)), perMinute)}
+		limiters[key] = entry
+	}
+	entry.lastSeen = now
+	return entry.limiter.AllowN(now, 1)
+}
+
+// prune drops the limiters which haven't been used for a while, and the notifications out of the duplicate window
+func (a *abuseProtection) prune(now time.Time) {
+	if now.Sub(a.lastPruned) < abuseProtectionPruneInterval {
+		return
+	}
+	a.lastPruned = now
+
+	for _, limiters := range []map[string]*limiterEntry{a.senderLimiters, a.tokenLimiters} {
+		for key, entry := range limiters {
+			if now.Sub(entry.lastSeen) > abuseProtectionPruneInterval {
+				delete(limiters, key)
+			}
+		}
+	}
+	for key, sentAt := range a.recentNotifications {
+		if now.Sub(sentAt) >= a.duplicateWindow {
+			delete(a.recentNotifications, key)
+		}
+	}
+}
+
+// notificationKey identifies identical notifications for the same installation
+func notificationKey(pn *protobuf.PushNotification) string {
+	hash := sha256.New()
+	for _, field := range [][]byte{pn.PublicKey, []byte(pn.InstallationId), pn.ChatId, pn.Author, pn.Message, {byte(pn.Type)}} {
+		hash.Write(field)
+		// Separate the fields, so that they can't be shifted
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// recordStat increments the persisted counter and its metric
+func (s *Server) recordStat(name string, delta int) {
+	if delta == 0 {
+		return
+	}
+	serverEventsCounter.WithLabelValues(name).Add(float64(delta))
+	if err := s.persistence.IncrementStat(name, int64(delta)); err != nil {
+		s.config.Logger.Error("failed to record stat", zap.String("stat", name), zap.Error(err))
+	}
+}
+
+// Stats returns the persisted counters of the server
+func (s *Server) Stats() (map[string]int64, error) {
+	return s.persistence.GetStats()
+}
+
+// BlockPublicKey drops any further registration from the public key, the notifications to its
+// registrations, and the notifications claiming it as author, which is best effort
+func (s *Server) BlockPublicKey(publicKey *ecdsa.PublicKey) error {
+	if err := s.persistence.BlockPublicKey(publicKey, uint64(time.Now().Unix())); err != nil {
+		return err
+	}
+	s.abuseProtection.block(publicKey)
+	return nil
+}
+
+func (s *Server) UnblockPublicKey(publicKey *ecdsa.PublicKey) error {
+	if err := s.persistence.UnblockPublicKey(publicKey); err != nil {
+		return err
+	}
+	s.abuseProtection.unblock(publicKey)
+	return nil
+}
+
+func (s *Server) BlockedPublicKeys() ([]*ecdsa.PublicKey, error) {
+	return s.persistence.GetBlockedPublicKeys()
+}
+
+func (s *Server) loadBlockedPublicKeys() error {
+	publicKeys, err := s.persistence.GetBlockedPublicKeys()
+	if err != nil {
+		return err
+	}
+	for _, publicKey := range publicKeys {
+		s.abuseProtection.block(publicKey)
+	}
+	return nil
+}
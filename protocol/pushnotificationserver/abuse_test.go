@@ -0,0 +1,88 @@
+package pushnotificationserver
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestAbuseProtectionSenderRateLimit(t *testing.T) {
+	protection := newAbuseProtection(&Config{MaxNotificationsPerSenderPerMinute: 2, MaxNotificationsPerTokenPerMinute: 10, DuplicateNotificationWindow: time.Minute})
+	author, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherAuthor, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	notification := func(author *ecdsa.PrivateKey, chatID string, message string) *protobuf.PushNotification {
+		return &protobuf.PushNotification{
+			ChatId:  []byte(chatID),
+			Author:  common.Shake256([]byte(common.PubkeyToHex(&author.PublicKey))),
+			Message: []byte(message),
+		}
+	}
+	registration := &protobuf.PushNotificationRegistration{DeviceToken: "token"}
+
+	now := time.Now()
+	require.Equal(t, "", protection.checkNotification(notification(author, "chat-1", "1"), registration, now))
+	require.Equal(t, "", protection.checkNotification(notification(author, "chat-1", "2"), registration, now))
+	require.Equal(t, StatRateLimited, protection.checkNotification(notification(author, "chat-1", "3"), registration, now))
+
+	// The limit applies to the author in the chat
+	require.Equal(t, "", protection.checkNotification(notification(author, "chat-2", "3"), registration, now))
+	require.Equal(t, "", protection.checkNotification(notification(otherAuthor, "chat-1", "3"), registration, now))
+
+	// The limit is replenished over time
+	require.Equal(t, "", protection.checkNotification(notification(author, "chat-1", "4"), registration, now.Add(30*time.Second)))
+
+	// Notifications claiming a blocked author are dropped
+	protection.block(&author.PublicKey)
+	require.Equal(t, StatBlocked, protection.checkNotification(notification(author, "chat-3", "5"), registration, now.Add(time.Hour)))
+	protection.unblock(&author.PublicKey)
+	require.Equal(t, "", protection.checkNotification(notification(author, "chat-3", "5"), registration, now.Add(time.Hour)))
+}
+
+func TestAbuseProtectionCheckNotification(t *testing.T) {
+	protection := newAbuseProtection(&Config{MaxNotificationsPerSenderPerMinute: 10, MaxNotificationsPerTokenPerMinute: 2, DuplicateNotificationWindow: time.Minute})
+	author, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	recipient, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	registration := &protobuf.PushNotificationRegistration{DeviceToken: "token"}
+	notification := func(chatID string) *protobuf.PushNotification {
+		return &protobuf.PushNotification{
+			ChatId:         []byte(chatID),
+			Author:         common.Shake256([]byte(common.PubkeyToHex(&author.PublicKey))),
+			PublicKey:      common.HashPublicKey(&recipient.PublicKey),
+			InstallationId: "installation-id",
+			Type:           protobuf.PushNotification_MESSAGE,
+		}
+	}
+
+	now := time.Now()
+	require.Equal(t, "", protection.checkNotification(notification("chat-1"), registration, now))
+	require.Equal(t, StatDuplicates, protection.checkNotification(notification("chat-1"), registration, now))
+	require.Equal(t, "", protection.checkNotification(notification("chat-2"), registration, now))
+	require.Equal(t, StatRateLimited, protection.checkNotification(notification("chat-3"), registration, now))
+
+	// Out of the duplicate window
+	require.Equal(t, "", protection.checkNotification(notification("chat-1"), registration, now.Add(time.Minute)))
+
+	// The notifications to the registrations of a blocked key are dropped
+	protection.block(&recipient.PublicKey)
+	require.Equal(t, StatBlocked, protection.checkNotification(notification("chat-5"), registration, now.Add(time.Hour)))
+
+	protection.unblock(&recipient.PublicKey)
+	require.Equal(t, "", protection.checkNotification(notification("chat-5"), registration, now.Add(time.Hour)))
+
+	// Idle limiters are pruned
+	protection.checkNotification(notification("chat-6"), &protobuf.PushNotificationRegistration{DeviceToken: "other-token"}, now.Add(abuseProtectionPruneInterval+2*time.Hour))
+	require.Len(t, protection.tokenLimiters, 1)
+	require.Len(t, protection.senderLimiters, 1)
+}
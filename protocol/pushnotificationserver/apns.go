@@ -0,0 +1,194 @@
+package pushnotificationserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const apnsProductionURL = "https://api.push.apple.com"
+const apnsSandboxURL = "https://api.sandbox.push.apple.com"
+
+// Apple rejects provider tokens older than an hour, and refreshing them more than
+// every 20 minutes
+const apnsTokenLifetime = 50 * time.Minute
+
+var errAPNsKeyNotECDSA = errors.New("apns private key is not an ECDSA key")
+
+// APNsConfig is the token-based authentication config of the APNs dispatcher
+type APNsConfig struct {
+	// KeyID is the identifier of the signing key
+	KeyID string
+	// TeamID is the identifier of the Apple developer team
+	TeamID string
+	// PrivateKey is the PEM encoded .p8 signing key
+	PrivateKey string
+	// Sandbox sends notifications through the development environment
+	Sandbox bool
+}
+
+type apnsPayload struct {
+	Aps apnsAps `json:"aps"`
+	*NotificationData
+}
+
+type apnsAps struct {
+	Alert          string `json:"alert"`
+	MutableContent int    `json:"mutable-content"`
+}
+
+type apnsErrorResponse struct {
+	Reason string `json:"reason"`
+}
+
+// APNsDispatcher delivers APN notifications natively through the APNs HTTP/2 API
+type APNsDispatcher struct {
+	config *APNsConfig
+	key    *ecdsa.PrivateKey
+	url    string
+	client *http.Client
+	logger *zap.Logger
+
+	tokenMutex    sync.Mutex
+	token         string
+	tokenIssuedAt time.Time
+}
+
+func NewAPNsDispatcher(config *APNsConfig, logger *zap.Logger) (*APNsDispatcher, error) {
+	key, err := parsePKCS8PrivateKey(config.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	ecdsaKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errAPNsKeyNotECDSA
+	}
+
+	url := apnsProductionURL
+	if config.Sandbox {
+		url = apnsSandboxURL
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ForceAttemptHTTP2 = true
+
+	return &APNsDispatcher{
+		config: config,
+		key:    ecdsaKey,
+		url:    url,
+		client: &http.Client{Transport: transport},
+		logger: logger,
+	}, nil
+}
+
+func (d *APNsDispatcher) Name() string {
+	return "apns"
+}
+
+// providerToken returns the cached authentication token, signing a new one when it's about to expire
+func (d *APNsDispatcher) providerToken() (string, error) {
+	d.tokenMutex.Lock()
+	defer d.tokenMutex.Unlock()
+
+	if d.token != "" && time.Since(d.tokenIssuedAt) < apnsTokenLifetime {
+		return d.token, nil
+	}
+
+	now := time.Now()
+	token, err := signJWT(
+		map[string]string{"alg": "ES256", "kid": d.config.KeyID},
+		map[string]interface{}{"iss": d.config.TeamID, "iat": now.Unix()},
+		d.key,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	d.token = token
+	d.tokenIssuedAt = now
+	return token, nil
+}
+
+func (d *APNsDispatcher) resetProviderToken() {
+	d.tokenMutex.Lock()
+	defer d.tokenMutex.Unlock()
+	d.token = ""
+}
+
+func (d *APNsDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+
+	token, err := d.providerToken()
+	if err != nil {
+		d.logger.Error("failed to sign apns provider token", zap.Error(err))
+		for _, notification := range notifications {
+			result.fail(notification, false)
+		}
+		return result
+	}
+
+	for _, notification := range notifications {
+		d.send(ctx, token, notification, result)
+	}
+
+	return result
+}
+
+func (d *APNsDispatcher) send(ctx context.Context, token string, notification *RequestAndRegistration, result *DispatchResult) {
+	payload, err := json.Marshal(&apnsPayload{
+		Aps: apnsAps{
+			Alert:          notificationText(notification.Request),
+			MutableContent: 1,
+		},
+		NotificationData: notificationData(notification.Request),
+	})
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url+"/3/device/"+notification.Registration.DeviceToken, bytes.NewReader(payload))
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+	request.Header.Set("authorization", "bearer "+token)
+	request.Header.Set("apns-topic", notification.Registration.ApnTopic)
+	request.Header.Set("apns-push-type", "alert")
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		d.logger.Warn("failed to send apns notification", zap.Error(err))
+		result.fail(notification, true)
+		return
+	}
+	defer response.Body.Close()
+
+	if isSuccessStatus(response.StatusCode) {
+		return
+	}
+
+	var errorResponse apnsErrorResponse
+	body, _ := ioutil.ReadAll(response.Body)
+	_ = json.Unmarshal(body, &errorResponse)
+	d.logger.Warn("apns notification failed", zap.Int("status", response.StatusCode), zap.String("reason", errorResponse.Reason))
+
+	switch {
+	case response.StatusCode == http.StatusGone,
+		response.StatusCode == http.StatusBadRequest && (errorResponse.Reason == "BadDeviceToken" || errorResponse.Reason == "DeviceTokenNotForTopic"):
+		result.InvalidTokens = append(result.InvalidTokens, notification)
+	case response.StatusCode == http.StatusForbidden && errorResponse.Reason == "ExpiredProviderToken":
+		d.resetProviderToken()
+		result.fail(notification, true)
+	default:
+		result.fail(notification, isRetryableStatus(response.StatusCode))
+	}
+}
@@ -0,0 +1,207 @@
+package pushnotificationserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const defaultDispatchRetries = 3
+const defaultDispatchRetryBackoff = 500 * time.Millisecond
+const dispatchTimeout = 30 * time.Second
+
+// dispatchRetryQueueSize bounds the batches of notifications waiting to be retried,
+// the notifications which don't fit are reported as failed
+const dispatchRetryQueueSize = 1000
+
+// Dispatcher delivers notifications to a push notification provider
+type Dispatcher interface {
+	// Name identifies the dispatcher in logs and metrics
+	Name() string
+	// Dispatch delivers the notifications, failures are reported in the result
+	// so that only the failed notifications are retried
+	Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult
+}
+
+// DispatchResult is the outcome of the delivery of a batch of notifications
+type DispatchResult struct {
+	// Failed are the notifications which could not be delivered, but might be on a later attempt
+	Failed []*RequestAndRegistration
+	// Rejected are the notifications which the provider refused, and won't be retried
+	Rejected []*RequestAndRegistration
+	// InvalidTokens are the notifications whose device token the provider reported as invalid
+	InvalidTokens []*RequestAndRegistration
+}
+
+func (r *DispatchResult) fail(notification *RequestAndRegistration, retryable bool) {
+	if retryable {
+		r.Failed = append(r.Failed, notification)
+	} else {
+		r.Rejected = append(r.Rejected, notification)
+	}
+}
+
+// isRetryableStatus returns whether a request which failed with the status code might succeed later
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+func isSuccessStatus(statusCode int) bool {
+	return statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices
+}
+
+// NotificationData is the payload delivered to the device along with the notification text
+type NotificationData struct {
+	EncryptedMessage string `json:"encryptedMessage"`
+	ChatID           string `json:"chatId"`
+	PublicKey        string `json:"publicKey"`
+}
+
+func notificationData(request *protobuf.PushNotification) *NotificationData {
+	return &NotificationData{
+		EncryptedMessage: types.EncodeHex(request.Message),
+		ChatID:           types.EncodeHex(request.ChatId),
+		PublicKey:        types.EncodeHex(request.PublicKey),
+	}
+}
+
+func notificationText(request *protobuf.PushNotification) string {
+	switch request.Type {
+	case protobuf.PushNotification_MESSAGE:
+		return defaultNewMessageNotificationText
+	case protobuf.PushNotification_REQUEST_TO_JOIN_COMMUNITY:
+		return defaultRequestToJoinCommunityNotificationText
+	default:
+		return defaultMentionNotificationText
+	}
+}
+
+// buildDispatchers returns the dispatcher of each token type, the dispatchers configured
+// explicitly take precedence, then the native ones, falling back to the webhook or Gorush
+func buildDispatchers(config *Config) (map[protobuf.PushNotificationRegistration_TokenType]Dispatcher, error) {
+	var fallback Dispatcher = NewGorushDispatcher(config.GorushURL, config.Logger)
+	if config.Webhook != nil && config.Webhook.URL != "" {
+		fallback = NewWebhookDispatcher(config.Webhook, config.Logger)
+	}
+
+	dispatchers := map[protobuf.PushNotificationRegistration_TokenType]Dispatcher{
+		protobuf.PushNotificationRegistration_APN_TOKEN:          fallback,
+		protobuf.PushNotificationRegistration_FIREBASE_TOKEN:     fallback,
+		protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN: NewUnifiedPushDispatcher(config.Logger),
+	}
+
+	if config.APNs != nil {
+		apns, err := NewAPNsDispatcher(config.APNs, config.Logger)
+		if err != nil {
+			return nil, err
+		}
+		dispatchers[protobuf.PushNotificationRegistration_APN_TOKEN] = apns
+	}
+
+	if config.FCM != nil {
+		fcm, err := NewFCMDispatcher(config.FCM, config.Logger)
+		if err != nil {
+			return nil, err
+		}
+		dispatchers[protobuf.PushNotificationRegistration_FIREBASE_TOKEN] = fcm
+	}
+
+	for tokenType, dispatcher := range config.Dispatchers {
+		dispatchers[tokenType] = dispatcher
+	}
+
+	return dispatchers, nil
+}
+
+// dispatchRetry is a batch of notifications which failed, waiting to be dispatched again
+type dispatchRetry struct {
+	dispatcher    Dispatcher
+	notifications []*RequestAndRegistration
+	attempt       int
+	at            time.Time
+}
+
+// dispatch delivers the notifications, the failed ones are retried in the background with an exponential
+// backoff. It returns the notifications which couldn't be delivered and whose token is invalid
+func (s *Server) dispatch(dispatcher Dispatcher, notifications []*RequestAndRegistration) (undelivered []*RequestAndRegistration, invalidTokens []*RequestAndRegistration) {
+	return s.dispatchAttempt(dispatcher, notifications, 0)
+}
+
+func (s *Server) dispatchAttempt(dispatcher Dispatcher, notifications []*RequestAndRegistration, attempt int) (undelivered []*RequestAndRegistration, invalidTokens []*RequestAndRegistration) {
+	name := dispatcher.Name()
+
+	ctx, cancel := context.WithTimeout(context.Background(), dispatchTimeout)
+	start := time.Now()
+	result := dispatcher.Dispatch(ctx, notifications)
+	cancel()
+	dispatchDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+
+	delivered := len(notifications) - len(result.Failed) - len(result.Rejected) - len(result.InvalidTokens)
+	dispatchedNotificationsCounter.WithLabelValues(name).Add(float64(delivered))
+	rejectedNotificationsCounter.WithLabelValues(name).Add(float64(len(result.Rejected)))
+	invalidTokensCounter.WithLabelValues(name).Add(float64(len(result.InvalidTokens)))
+
+	undelivered = result.Rejected
+	if len(result.Failed) != 0 && !s.scheduleRetry(dispatcher, result.Failed, attempt+1) {
+		undelivered = append(undelivered, result.Failed...)
+	}
+
+	s.recordStat(StatDeliveries, delivered)
+	s.recordStat(StatFailures, len(undelivered))
+	s.recordStat(StatInvalidTokens, len(result.InvalidTokens))
+	s.unregisterInvalidTokens(result.InvalidTokens)
+
+	return undelivered, result.InvalidTokens
+}
+
+// scheduleRetry queues the failed notifications for another attempt,
+// it returns false if they aren't retried anymore
+func (s *Server) scheduleRetry(dispatcher Dispatcher, notifications []*RequestAndRegistration, attempt int) bool {
+	name := dispatcher.Name()
+	if attempt > s.config.DispatchRetries {
+		failedNotificationsCounter.WithLabelValues(name).Add(float64(len(notifications)))
+		s.config.Logger.Warn("failed to dispatch notifications", zap.String("dispatcher", name), zap.Int("count", len(notifications)))
+		return false
+	}
+
+	s.retryLoopOnce.Do(func() {
+		go s.retryLoop()
+	})
+
+	backoff := s.config.DispatchRetryBackoff << (attempt - 1)
+	select {
+	case s.retries <- &dispatchRetry{dispatcher: dispatcher, notifications: notifications, attempt: attempt, at: time.Now().Add(backoff)}:
+		retriedNotificationsCounter.WithLabelValues(name).Add(float64(len(notifications)))
+		s.config.Logger.Debug("retrying notifications", zap.String("dispatcher", name), zap.Int("count", len(notifications)), zap.Duration("backoff", backoff))
+		return true
+	default:
+		failedNotificationsCounter.WithLabelValues(name).Add(float64(len(notifications)))
+		s.config.Logger.Warn("dispatch retry queue is full", zap.String("dispatcher", name), zap.Int("count", len(notifications)))
+		return false
+	}
+}
+
+// retryLoop dispatches the queued notifications again once their backoff elapsed,
+// so that retries don't hold the handling of the requests
+func (s *Server) retryLoop() {
+	for {
+		select {
+		case <-s.quit:
+			return
+		case retry := <-s.retries:
+			timer := time.NewTimer(time.Until(retry.at))
+			select {
+			case <-s.quit:
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+			s.dispatchAttempt(retry.dispatcher, retry.notifications, retry.attempt)
+		}
+	}
+}
@@ -0,0 +1,364 @@
+package pushnotificationserver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/protocol/sqlite"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/t/helpers"
+)
+
+func encodePKCS8PrivateKey(t *testing.T, key interface{}) string {
+	encoded, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encoded}))
+}
+
+func testNotification(tokenType protobuf.PushNotificationRegistration_TokenType, token string) *RequestAndRegistration {
+	return &RequestAndRegistration{
+		Request: &protobuf.PushNotification{
+			ChatId:         []byte("chat-id"),
+			Type:           protobuf.PushNotification_MESSAGE,
+			PublicKey:      []byte("public-key"),
+			InstallationId: "installation-id",
+			Message:        []byte("message"),
+		},
+		Registration: &protobuf.PushNotificationRegistration{
+			DeviceToken: token,
+			TokenType:   tokenType,
+			ApnTopic:    "im.status.ethereum",
+		},
+	}
+}
+
+type flakyDispatcher struct {
+	mutex    sync.Mutex
+	failures int
+	calls    int
+}
+
+func (d *flakyDispatcher) Name() string {
+	return "flaky"
+}
+
+func (d *flakyDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.calls++
+	result := &DispatchResult{}
+	if d.calls <= d.failures {
+		result.Failed = notifications
+	}
+	return result
+}
+
+func (d *flakyDispatcher) Calls() int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	return d.calls
+}
+
+func newDispatchTestServer(t *testing.T, backoff time.Duration) *Server {
+	db, err := helpers.SetupTestMemorySQLDB(appdatabase.DbInitializer{})
+	require.NoError(t, err)
+	require.NoError(t, sqlite.Migrate(db))
+
+	server := New(&Config{Logger: tt.MustCreateTestLogger(), DispatchRetries: 2, DispatchRetryBackoff: backoff}, NewSQLitePersistence(db), nil)
+	t.Cleanup(func() { require.NoError(t, server.Stop()) })
+	return server
+}
+
+func TestDispatchRetries(t *testing.T) {
+	server := newDispatchTestServer(t, time.Millisecond)
+	notifications := []*RequestAndRegistration{testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "token")}
+
+	// The failed notifications are retried in the background
+	dispatcher := &flakyDispatcher{failures: 2}
+	undelivered, invalidTokens := server.dispatch(dispatcher, notifications)
+	require.Empty(t, undelivered)
+	require.Empty(t, invalidTokens)
+	require.Eventually(t, func() bool {
+		stats, err := server.Stats()
+		return err == nil && stats[StatDeliveries] == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 3, dispatcher.Calls())
+
+	dispatcher = &flakyDispatcher{failures: 5}
+	undelivered, _ = server.dispatch(dispatcher, notifications)
+	require.Empty(t, undelivered)
+	require.Eventually(t, func() bool {
+		stats, err := server.Stats()
+		return err == nil && stats[StatFailures] == 1
+	}, time.Second, time.Millisecond)
+	require.Equal(t, 3, dispatcher.Calls())
+}
+
+func TestDispatchRetriesDontBlock(t *testing.T) {
+	server := newDispatchTestServer(t, time.Hour)
+	notifications := []*RequestAndRegistration{testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "token")}
+
+	dispatcher := &flakyDispatcher{failures: 1}
+	start := time.Now()
+	undelivered, _ := server.dispatch(dispatcher, notifications)
+	require.Empty(t, undelivered)
+	require.Less(t, time.Since(start), time.Second)
+	require.Equal(t, 1, dispatcher.Calls())
+}
+
+func TestBuildDispatchers(t *testing.T) {
+	logger := tt.MustCreateTestLogger()
+	flaky := &flakyDispatcher{}
+
+	dispatchers, err := buildDispatchers(&Config{GorushURL: defaultGorushURL, Logger: logger})
+	require.NoError(t, err)
+	require.Equal(t, "gorush", dispatchers[protobuf.PushNotificationRegistration_APN_TOKEN].Name())
+	require.Equal(t, "gorush", dispatchers[protobuf.PushNotificationRegistration_FIREBASE_TOKEN].Name())
+	require.Equal(t, "unifiedpush", dispatchers[protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN].Name())
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	dispatchers, err = buildDispatchers(&Config{
+		Logger:      logger,
+		APNs:        &APNsConfig{KeyID: "key-id", TeamID: "team-id", PrivateKey: encodePKCS8PrivateKey(t, key)},
+		Webhook:     &WebhookConfig{URL: "https://example.com"},
+		Dispatchers: map[protobuf.PushNotificationRegistration_TokenType]Dispatcher{protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN: flaky},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "apns", dispatchers[protobuf.PushNotificationRegistration_APN_TOKEN].Name())
+	require.Equal(t, "webhook", dispatchers[protobuf.PushNotificationRegistration_FIREBASE_TOKEN].Name())
+	require.Equal(t, flaky, dispatchers[protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN])
+
+	_, err = buildDispatchers(&Config{Logger: logger, APNs: &APNsConfig{PrivateKey: "not a key"}})
+	require.Error(t, err)
+}
+
+func TestGorushDispatcher(t *testing.T) {
+	statusCode := http.StatusInternalServerError
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/api/push", r.URL.Path)
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+
+	dispatcher := NewGorushDispatcher(server.URL, tt.MustCreateTestLogger())
+	notifications := []*RequestAndRegistration{testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "token")}
+
+	result := dispatcher.Dispatch(context.Background(), notifications)
+	require.Equal(t, notifications, result.Failed)
+
+	statusCode = http.StatusBadRequest
+	result = dispatcher.Dispatch(context.Background(), notifications)
+	require.Equal(t, notifications, result.Rejected)
+
+	statusCode = http.StatusOK
+	result = dispatcher.Dispatch(context.Background(), notifications)
+	require.Empty(t, result.Failed)
+	require.Empty(t, result.Rejected)
+}
+
+func TestAPNsDispatcher(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "im.status.ethereum", r.Header.Get("apns-topic"))
+		require.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+		// Verify the provider token
+		token := strings.TrimPrefix(r.Header.Get("authorization"), "bearer ")
+		parts := strings.Split(token, ".")
+		require.Len(t, parts, 3)
+		header, err := base64.RawURLEncoding.DecodeString(parts[0])
+		require.NoError(t, err)
+		require.JSONEq(t, `{"alg":"ES256","kid":"key-id"}`, string(header))
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		require.Len(t, signature, 64)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		require.True(t, ecdsa.Verify(&key.PublicKey, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])))
+
+		var payload apnsPayload
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		require.Equal(t, defaultNewMessageNotificationText, payload.Aps.Alert)
+
+		switch r.URL.Path {
+		case "/3/device/valid":
+			w.WriteHeader(http.StatusOK)
+		case "/3/device/unregistered":
+			w.WriteHeader(http.StatusGone)
+			_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+		case "/3/device/bad":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"BadDeviceToken"}`))
+		case "/3/device/busy":
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"reason":"TooManyRequests"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"reason":"PayloadTooLarge"}`))
+		}
+	}))
+	defer server.Close()
+
+	dispatcher, err := NewAPNsDispatcher(&APNsConfig{KeyID: "key-id", TeamID: "team-id", PrivateKey: encodePKCS8PrivateKey(t, key)}, tt.MustCreateTestLogger())
+	require.NoError(t, err)
+	dispatcher.url = server.URL
+	dispatcher.client = server.Client()
+
+	valid := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "valid")
+	unregistered := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "unregistered")
+	bad := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "bad")
+	busy := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "busy")
+	tooLarge := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "too-large")
+
+	result := dispatcher.Dispatch(context.Background(), []*RequestAndRegistration{valid, unregistered, bad, busy, tooLarge})
+	require.Equal(t, []*RequestAndRegistration{unregistered, bad}, result.InvalidTokens)
+	require.Equal(t, []*RequestAndRegistration{busy}, result.Failed)
+	require.Equal(t, []*RequestAndRegistration{tooLarge}, result.Rejected)
+}
+
+func TestFCMDispatcher(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	var tokenRequests int
+	var mutex sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.URL.Path == "/token" {
+			tokenRequests++
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+			require.Len(t, strings.Split(r.Form.Get("assertion"), "."), 3)
+			_, _ = w.Write([]byte(`{"access_token":"access-token","expires_in":3600}`))
+			return
+		}
+
+		require.Equal(t, "/v1/projects/project-id/messages:send", r.URL.Path)
+		require.Equal(t, "Bearer access-token", r.Header.Get("Authorization"))
+
+		var request fcmRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Equal(t, defaultNewMessageNotificationText, request.Message.Notification.Body)
+
+		switch request.Message.Token {
+		case "valid":
+			_, _ = w.Write([]byte(`{"name":"projects/project-id/messages/1"}`))
+		case "unregistered":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	serviceAccount, err := json.Marshal(map[string]string{
+		"project_id":   "project-id",
+		"private_key":  encodePKCS8PrivateKey(t, key),
+		"client_email": "push@project-id.iam.gserviceaccount.com",
+		"token_uri":    server.URL + "/token",
+	})
+	require.NoError(t, err)
+
+	dispatcher, err := NewFCMDispatcher(&FCMConfig{ServiceAccount: string(serviceAccount)}, tt.MustCreateTestLogger())
+	require.NoError(t, err)
+	dispatcher.url = server.URL
+
+	valid := testNotification(protobuf.PushNotificationRegistration_FIREBASE_TOKEN, "valid")
+	unregistered := testNotification(protobuf.PushNotificationRegistration_FIREBASE_TOKEN, "unregistered")
+	unavailable := testNotification(protobuf.PushNotificationRegistration_FIREBASE_TOKEN, "unavailable")
+
+	result := dispatcher.Dispatch(context.Background(), []*RequestAndRegistration{valid, unregistered, unavailable})
+	require.Equal(t, []*RequestAndRegistration{unregistered}, result.InvalidTokens)
+	require.Equal(t, []*RequestAndRegistration{unavailable}, result.Failed)
+
+	// The access token is cached
+	dispatcher.Dispatch(context.Background(), []*RequestAndRegistration{valid})
+	require.Equal(t, 1, tokenRequests)
+}
+
+func TestWebhookDispatcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var request WebhookRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		require.Len(t, request.Notifications, 2)
+		require.Equal(t, "APN_TOKEN", request.Notifications[0].TokenType)
+		require.Equal(t, "FIREBASE_TOKEN", request.Notifications[1].TokenType)
+
+		_, _ = w.Write([]byte(`{"invalidTokens":["invalid"]}`))
+	}))
+	defer server.Close()
+
+	dispatcher := NewWebhookDispatcher(&WebhookConfig{URL: server.URL, AuthorizationHeader: "Bearer secret"}, tt.MustCreateTestLogger())
+
+	valid := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "valid")
+	invalid := testNotification(protobuf.PushNotificationRegistration_FIREBASE_TOKEN, "invalid")
+
+	result := dispatcher.Dispatch(context.Background(), []*RequestAndRegistration{valid, invalid})
+	require.Equal(t, []*RequestAndRegistration{invalid}, result.InvalidTokens)
+	require.Empty(t, result.Failed)
+	require.Empty(t, result.Rejected)
+}
+
+func TestUnifiedPushDispatcher(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification UnifiedPushNotification
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&notification))
+		require.Equal(t, defaultNewMessageNotificationText, notification.Message)
+
+		switch r.URL.Path {
+		case "/valid":
+			w.WriteHeader(http.StatusCreated)
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	valid := testNotification(protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN, server.URL+"/valid")
+	gone := testNotification(protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN, server.URL+"/gone")
+	failing := testNotification(protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN, server.URL+"/failing")
+	notifications := []*RequestAndRegistration{valid, gone, failing}
+
+	// Endpoints in the local network are refused
+	dispatcher := NewUnifiedPushDispatcher(tt.MustCreateTestLogger())
+	result := dispatcher.Dispatch(context.Background(), notifications)
+	require.Equal(t, notifications, result.Rejected)
+
+	dispatcher.client = server.Client()
+	result = dispatcher.Dispatch(context.Background(), notifications)
+	require.Equal(t, []*RequestAndRegistration{gone}, result.InvalidTokens)
+	require.Equal(t, []*RequestAndRegistration{failing}, result.Failed)
+	require.Empty(t, result.Rejected)
+
+	require.NoError(t, validateUnifiedPushEndpoint(valid.Registration))
+	require.Error(t, validateUnifiedPushEndpoint(&protobuf.PushNotificationRegistration{DeviceToken: "http://example.com/push"}))
+	require.Error(t, validateUnifiedPushEndpoint(&protobuf.PushNotificationRegistration{DeviceToken: "not-a-url"}))
+}
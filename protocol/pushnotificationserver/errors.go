@@ -11,3 +11,4 @@ var ErrMalformedPushNotificationRegistrationDeviceToken = errors.New("invalid de
 var ErrMalformedPushNotificationRegistrationGrant = errors.New("invalid grant")
 var ErrMalformedPushNotificationRegistrationAccessToken = errors.New("invalid access token")
 var ErrUnknownPushNotificationRegistrationTokenType = errors.New("invalid token type")
+var ErrUnsupportedPushNotificationRegistrationTokenType = errors.New("unsupported token type")
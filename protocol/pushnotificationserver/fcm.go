@@ -0,0 +1,261 @@
+package pushnotificationserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const fcmURL = "https://fcm.googleapis.com"
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+const fcmDefaultTokenURI = "https://oauth2.googleapis.com/token"
+
+// Access tokens are refreshed a bit before they expire, to account for clock skew
+const fcmAccessTokenExpiryMargin = time.Minute
+
+var errFCMKeyNotRSA = errors.New("fcm private key is not an RSA key")
+var errFCMMissingProjectID = errors.New("fcm service account has no project id")
+
+// FCMConfig is the config of the FCM HTTP v1 dispatcher
+type FCMConfig struct {
+	// ServiceAccount is the JSON service account key of the Firebase project
+	ServiceAccount string
+}
+
+type fcmServiceAccount struct {
+	ProjectID   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type fcmAccessTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type fcmRequest struct {
+	Message *fcmMessage `json:"message"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification *fcmNotification  `json:"notification"`
+	Data         map[string]string `json:"data"`
+}
+
+type fcmNotification struct {
+	Body string `json:"body"`
+}
+
+type fcmErrorResponse struct {
+	Error struct {
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (r *fcmErrorResponse) errorCode() string {
+	for _, detail := range r.Error.Details {
+		if detail.ErrorCode != "" {
+			return detail.ErrorCode
+		}
+	}
+	return r.Error.Status
+}
+
+// FCMDispatcher delivers Firebase notifications natively through the FCM HTTP v1 API,
+// authenticating with a service account
+type FCMDispatcher struct {
+	account *fcmServiceAccount
+	key     *rsa.PrivateKey
+	url     string
+	client  *http.Client
+	logger  *zap.Logger
+
+	tokenMutex        sync.Mutex
+	accessToken       string
+	accessTokenExpiry time.Time
+}
+
+func NewFCMDispatcher(config *FCMConfig, logger *zap.Logger) (*FCMDispatcher, error) {
+	account := &fcmServiceAccount{}
+	if err := json.Unmarshal([]byte(config.ServiceAccount), account); err != nil {
+		return nil, err
+	}
+	if account.ProjectID == "" {
+		return nil, errFCMMissingProjectID
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = fcmDefaultTokenURI
+	}
+
+	key, err := parsePKCS8PrivateKey(account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errFCMKeyNotRSA
+	}
+
+	return &FCMDispatcher{
+		account: account,
+		key:     rsaKey,
+		url:     fcmURL,
+		client:  http.DefaultClient,
+		logger:  logger,
+	}, nil
+}
+
+func (d *FCMDispatcher) Name() string {
+	return "fcm"
+}
+
+// getAccessToken returns the cached OAuth2 access token, exchanging a signed assertion for a new one when it expired
+func (d *FCMDispatcher) getAccessToken(ctx context.Context) (string, error) {
+	d.tokenMutex.Lock()
+	defer d.tokenMutex.Unlock()
+
+	if d.accessToken != "" && time.Now().Before(d.accessTokenExpiry) {
+		return d.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion, err := signJWT(
+		map[string]string{"alg": "RS256", "typ": "JWT"},
+		map[string]interface{}{
+			"iss":   d.account.ClientEmail,
+			"scope": fcmScope,
+			"aud":   d.account.TokenURI,
+			"iat":   now.Unix(),
+			"exp":   now.Add(time.Hour).Unix(),
+		},
+		d.key,
+	)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if !isSuccessStatus(response.StatusCode) {
+		return "", fmt.Errorf("fcm access token request failed with status %d", response.StatusCode)
+	}
+
+	var tokenResponse fcmAccessTokenResponse
+	if err := json.NewDecoder(response.Body).Decode(&tokenResponse); err != nil {
+		return "", err
+	}
+
+	d.accessToken = tokenResponse.AccessToken
+	d.accessTokenExpiry = now.Add(time.Duration(tokenResponse.ExpiresIn)*time.Second - fcmAccessTokenExpiryMargin)
+	return d.accessToken, nil
+}
+
+func (d *FCMDispatcher) resetAccessToken() {
+	d.tokenMutex.Lock()
+	defer d.tokenMutex.Unlock()
+	d.accessToken = ""
+}
+
+func (d *FCMDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+
+	accessToken, err := d.getAccessToken(ctx)
+	if err != nil {
+		d.logger.Warn("failed to get fcm access token", zap.Error(err))
+		for _, notification := range notifications {
+			result.fail(notification, true)
+		}
+		return result
+	}
+
+	for _, notification := range notifications {
+		d.send(ctx, accessToken, notification, result)
+	}
+
+	return result
+}
+
+func (d *FCMDispatcher) send(ctx context.Context, accessToken string, notification *RequestAndRegistration, result *DispatchResult) {
+	data := notificationData(notification.Request)
+	payload, err := json.Marshal(&fcmRequest{
+		Message: &fcmMessage{
+			Token:        notification.Registration.DeviceToken,
+			Notification: &fcmNotification{Body: notificationText(notification.Request)},
+			Data: map[string]string{
+				"encryptedMessage": data.EncryptedMessage,
+				"chatId":           data.ChatID,
+				"publicKey":        data.PublicKey,
+			},
+		},
+	})
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.url+"/v1/projects/"+d.account.ProjectID+"/messages:send", bytes.NewReader(payload))
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		d.logger.Warn("failed to send fcm notification", zap.Error(err))
+		result.fail(notification, true)
+		return
+	}
+	defer response.Body.Close()
+
+	if isSuccessStatus(response.StatusCode) {
+		return
+	}
+
+	var errorResponse fcmErrorResponse
+	body, _ := ioutil.ReadAll(response.Body)
+	_ = json.Unmarshal(body, &errorResponse)
+	errorCode := errorResponse.errorCode()
+	d.logger.Warn("fcm notification failed", zap.Int("status", response.StatusCode), zap.String("error", errorCode))
+
+	switch {
+	case response.StatusCode == http.StatusNotFound || errorCode == "UNREGISTERED":
+		result.InvalidTokens = append(result.InvalidTokens, notification)
+	case response.StatusCode == http.StatusUnauthorized:
+		d.resetAccessToken()
+		result.fail(notification, true)
+	default:
+		result.fail(notification, isRetryableStatus(response.StatusCode))
+	}
+}
@@ -2,13 +2,14 @@ package pushnotificationserver
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"go.uber.org/zap"
 
-	"github.com/status-im/status-go/eth-node/types"
 	"github.com/status-im/status-go/protocol/protobuf"
 )
 
@@ -16,11 +17,7 @@ const defaultNewMessageNotificationText = "You have a new message"
 const defaultMentionNotificationText = "Someone mentioned you"
 const defaultRequestToJoinCommunityNotificationText = "Someone requested to join a community you are an admin of"
 
-type GoRushRequestData struct {
-	EncryptedMessage string `json:"encryptedMessage"`
-	ChatID           string `json:"chatId"`
-	PublicKey        string `json:"publicKey"`
-}
+type GoRushRequestData NotificationData
 
 type GoRushRequestNotification struct {
 	Tokens   []string           `json:"tokens"`
@@ -54,44 +51,73 @@ func PushNotificationRegistrationToGoRushRequest(requestAndRegistrations []*Requ
 	for _, requestAndRegistration := range requestAndRegistrations {
 		request := requestAndRegistration.Request
 		registration := requestAndRegistration.Registration
-		var text string
-		if request.Type == protobuf.PushNotification_MESSAGE {
-			text = defaultNewMessageNotificationText
-		} else if request.Type == protobuf.PushNotification_REQUEST_TO_JOIN_COMMUNITY {
-			text = defaultRequestToJoinCommunityNotificationText
-		} else {
-			text = defaultMentionNotificationText
-		}
 		goRushRequests.Notifications = append(goRushRequests.Notifications,
 			&GoRushRequestNotification{
 				Tokens:   []string{registration.DeviceToken},
 				Platform: tokenTypeToGoRushPlatform(registration.TokenType),
-				Message:  text,
+				Message:  notificationText(request),
 				Topic:    registration.ApnTopic,
-				Data: &GoRushRequestData{
-					EncryptedMessage: types.EncodeHex(request.Message),
-					ChatID:           types.EncodeHex(request.ChatId),
-					PublicKey:        types.EncodeHex(request.PublicKey),
-				},
+				Data:     (*GoRushRequestData)(notificationData(request)),
 			})
 	}
 	return goRushRequests
 }
 
-func sendGoRushNotification(request *GoRushRequest, url string, logger *zap.Logger) error {
+func sendGoRushNotification(ctx context.Context, client *http.Client, request *GoRushRequest, url string, logger *zap.Logger) (int, error) {
 	payload, err := json.Marshal(request)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, url+"/api/push", bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
 	}
+	httpRequest.Header.Set("Content-Type", "application/json")
 
-	response, err := http.Post(url+"/api/push", "application/json", bytes.NewReader(payload))
+	response, err := client.Do(httpRequest)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer response.Body.Close()
 	body, _ := ioutil.ReadAll(response.Body)
 
-	logger.Info("Sent gorush request", zap.String("response", string(body)))
+	logger.Info("Sent gorush request", zap.Int("status", response.StatusCode), zap.String("response", string(body)))
+
+	if !isSuccessStatus(response.StatusCode) {
+		return response.StatusCode, fmt.Errorf("gorush request failed with status %d", response.StatusCode)
+	}
+
+	return response.StatusCode, nil
+}
+
+// GorushDispatcher delivers APN and Firebase notifications through a Gorush service
+type GorushDispatcher struct {
+	url    string
+	client *http.Client
+	logger *zap.Logger
+}
+
+func NewGorushDispatcher(url string, logger *zap.Logger) *GorushDispatcher {
+	return &GorushDispatcher{url: url, client: http.DefaultClient, logger: logger}
+}
+
+func (d *GorushDispatcher) Name() string {
+	return "gorush"
+}
+
+func (d *GorushDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+
+	// Gorush only reports whether the whole batch was accepted
+	statusCode, err := sendGoRushNotification(ctx, d.client, PushNotificationRegistrationToGoRushRequest(notifications), d.url, d.logger)
+	if err != nil {
+		d.logger.Warn("failed to send gorush notification", zap.Error(err))
+		retryable := statusCode == 0 || isRetryableStatus(statusCode)
+		for _, notification := range notifications {
+			result.fail(notification, retryable)
+		}
+	}
 
-	return nil
+	return result
 }
@@ -0,0 +1,69 @@
+package pushnotificationserver
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+)
+
+var errInvalidPrivateKey = errors.New("invalid private key")
+
+// parsePKCS8PrivateKey parses a PEM encoded PKCS #8 private key, as issued by Apple and Google
+func parsePKCS8PrivateKey(encoded string) (interface{}, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errInvalidPrivateKey
+	}
+	return x509.ParsePKCS8PrivateKey(block.Bytes)
+}
+
+// signJWT returns a compact JWT signed with ES256 or RS256, depending on the key
+func signJWT(header map[string]string, claims map[string]interface{}, key interface{}) (string, error) {
+	encodedHeader, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	encodedClaims, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(encodedHeader) + "." + base64.RawURLEncoding.EncodeToString(encodedClaims)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var signature []byte
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		if err != nil {
+			return "", err
+		}
+		// JWS uses the fixed size concatenation of r and s rather than ASN.1
+		size := (key.Curve.Params().BitSize + 7) / 8
+		signature = append(padBigInt(r, size), padBigInt(s, size)...)
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		if err != nil {
+			return "", err
+		}
+	default:
+		return "", errInvalidPrivateKey
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func padBigInt(n *big.Int, size int) []byte {
+	bytes := n.Bytes()
+	padded := make([]byte, size)
+	copy(padded[size-len(bytes):], bytes)
+	return padded
+}
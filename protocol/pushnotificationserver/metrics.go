@@ -0,0 +1,46 @@
+package pushnotificationserver
+
+import (
+	prom "github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	dispatchedNotificationsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notifications_dispatched_total",
+		Help: "Number of push notifications delivered to the provider.",
+	}, []string{"dispatcher"})
+	failedNotificationsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notifications_failed_total",
+		Help: "Number of push notifications which could not be delivered after all retries.",
+	}, []string{"dispatcher"})
+	rejectedNotificationsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notifications_rejected_total",
+		Help: "Number of push notifications rejected by the provider.",
+	}, []string{"dispatcher"})
+	retriedNotificationsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notifications_retried_total",
+		Help: "Number of push notifications retried.",
+	}, []string{"dispatcher"})
+	invalidTokensCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notifications_invalid_tokens_total",
+		Help: "Number of device tokens reported as invalid by the provider.",
+	}, []string{"dispatcher"})
+	serverEventsCounter = prom.NewCounterVec(prom.CounterOpts{
+		Name: "push_notification_server_events_total",
+		Help: "Number of registrations, queries, requests and notifications handled, by outcome.",
+	}, []string{"event"})
+	dispatchDuration = prom.NewHistogramVec(prom.HistogramOpts{
+		Name: "push_notifications_dispatch_duration_seconds",
+		Help: "Duration of the requests to the push notification provider.",
+	}, []string{"dispatcher"})
+)
+
+func init() {
+	prom.MustRegister(dispatchedNotificationsCounter)
+	prom.MustRegister(failedNotificationsCounter)
+	prom.MustRegister(rejectedNotificationsCounter)
+	prom.MustRegister(retriedNotificationsCounter)
+	prom.MustRegister(invalidTokensCounter)
+	prom.MustRegister(serverEventsCounter)
+	prom.MustRegister(dispatchDuration)
+}
@@ -4,6 +4,8 @@
 // 1593601728_initial_schema.up.sql (675B)
 // 1598419937_add_push_notifications_table.down.sql (51B)
 // 1598419937_add_push_notifications_table.up.sql (104B)
+// 1722600000_add_abuse_protection.down.sql (93B)
+// 1722600000_add_abuse_protection.up.sql (276B)
 // doc.go (402B)
 
 package migrations
@@ -152,6 +154,46 @@ func _1598419937_add_push_notifications_tableUpSql() (*asset, error) {
 	return a, nil
 }
 
+var __1722600000_add_abuse_protectionDownSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x72\x09\xf2\x0f\x50\x08\x71\x74\xf2\x71\x55\x28\x28\x2d\xce\x88\xcf\xcb\x2f\xc9\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\x8b\x2f\x4e\x2d\x2a\x4b\x2d\x8a\x2f\x2e\x49\x2c\x29\xb6\xe6\x22\x46\x69\x52\x4e\x7e\x72\x76\x6a\x4a\x7c\x76\x6a\x65\xb1\x35\x17\x20\x00\x00\xff\xff\x35\x91\x91\x59\x5d\x00\x00\x00")
+
+func _1722600000_add_abuse_protectionDownSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722600000_add_abuse_protectionDownSql,
+		"1722600000_add_abuse_protection.down.sql",
+	)
+}
+
+func _1722600000_add_abuse_protectionDownSql() (*asset, error) {
+	bytes, err := _1722600000_add_abuse_protectionDownSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722600000_add_abuse_protection.down.sql", size: 93, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x12, 0x15, 0xac, 0xbf, 0x33, 0x81, 0xd7, 0x81, 0x3d, 0xd6, 0x5b, 0x9, 0x72, 0xfd, 0x3, 0xa6, 0xa8, 0xf4, 0xd5, 0xd3, 0x27, 0x49, 0x2, 0x51, 0x39, 0x53, 0xa8, 0xb7, 0xb7, 0x89, 0xbd, 0x1b}}
+	return a, nil
+}
+
+var __1722600000_add_abuse_protectionUpSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xce\xc1\x6b\x83\x30\x1c\xc5\xf1\x7b\xfe\x8a\x77\xdc\x60\x87\xdd\x77\x8a\xee\xe7\x16\x96\xe9\x88\x71\xcc\x53\x88\x2e\xa5\x41\xab\x62\xa2\xd0\xff\xbe\x48\x29\x3d\x94\x5e\x7a\x7c\xf0\xbe\xf0\x49\x15\x71\x4d\xd0\x3c\x91\x04\x91\x21\x2f\x34\xe8\x4f\x94\xba\xc4\xb4\x84\xbd\x19\xc6\xe8\x77\xbe\xb5\xd1\x8f\x83\x09\x6e\x5e\xdd\x6c\x42\xb4\x31\xe0\x89\x01\x83\x3d\x38\xfc\x72\x95\x7e\x72\x85\x2d\xcd\x2b\x29\xf1\xa3\xc4\x37\x57\x35\xbe\xa8\x7e\x61\xc0\x6a\xfb\xc5\x41\xe4\x9a\x3e\x48\x5d\x5f\xef\x94\xf1\x4a\x6a\xbc\xb2\xe7\x37\xc6\x1e\x71\x34\xfd\xd8\x76\xee\xdf\x74\xee\x78\xe6\x4c\x4b\xd3\xfb\x76\xdb\x48\x64\x91\xdc\x15\x5d\x42\x1b\x6f\x58\x1b\xe6\x14\x00\x00\xff\xff\xd2\xd4\xd9\x8b\x14\x01\x00\x00")
+
+func _1722600000_add_abuse_protectionUpSqlBytes() ([]byte, error) {
+	return bindataRead(
+		__1722600000_add_abuse_protectionUpSql,
+		"1722600000_add_abuse_protection.up.sql",
+	)
+}
+
+func _1722600000_add_abuse_protectionUpSql() (*asset, error) {
+	bytes, err := _1722600000_add_abuse_protectionUpSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "1722600000_add_abuse_protection.up.sql", size: 276, mode: os.FileMode(0644), modTime: time.Unix(1700000000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfb, 0xc9, 0x16, 0xf7, 0x2b, 0x2e, 0xe7, 0x7f, 0x6, 0x2f, 0xab, 0x6d, 0x46, 0xca, 0x25, 0x73, 0xd3, 0x3b, 0x22, 0x9f, 0xa7, 0xee, 0x97, 0x1f, 0xc, 0xda, 0xf0, 0xe2, 0xba, 0x76, 0x2d, 0x87}}
+	return a, nil
+}
+
 var _docGo = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x3d\x6a\x04\x31\x0c\x85\xfb\x39\xc5\x63\x9b\x6d\x32\x76\x02\x81\x40\x20\x45\xca\xf4\xb9\x80\xd6\xd6\xd8\x62\xc7\xf6\x60\x69\xff\x6e\x1f\x66\xb3\x90\xe9\xa2\xf2\x43\xdf\xd3\x93\xf7\xf8\xce\xa2\x98\x64\x66\x88\xa2\x72\x60\x55\xea\x37\x1c\x38\xd0\x49\x19\xbb\x24\x96\x4f\x07\x17\x5a\xf1\x6a\x64\x27\x1d\xa5\xf8\x22\xa9\x93\xb1\x3f\xbf\xee\x06\xef\x11\xa8\xee\x0d\x99\x6a\x9c\xf9\x9e\xa5\x50\xa3\x6e\x52\x13\x2e\x62\x19\x84\xa5\xf3\x24\x57\x87\x4f\xc3\xcc\xa4\x06\xcb\x64\x7b\x85\x65\x46\x20\xe5\x35\x66\x6a\x1d\xa9\x8d\x07\xa9\x91\x8c\xdc\x8a\xbe\xa6\x0d\x59\x1b\x06\x9a\x67\x8e\x98\x7a\x2b\x77\x57\xa9\x30\xa2\x74\x0e\xd6\xfa\xed\x09\xa4\xca\x86\x4a\x85\x75\xf5\x33\x9d\x19\xb5\x3d\xce\x83\x6a\xfc\xff\x23\x5c\x5a\x3f\x2a\x48\xc1\xd7\x85\x83\x71\x74\xc3\xb0\x50\x38\x52\x62\xfc\xee\x49\xab\x3a\x0c\xde\xa7\xf6\x9e\xb8\xf2\x6a\x6e\x7b\x8e\xa5\x45\x93\xc2\x1f\x2f\x6f\xcf\x8f\xc1\xb8\x1c\xd3\xc6\xc6\xd8\xe0\x9c\xff\x03\x2e\x35\x38\x3f\xfc\x04\x00\x00\xff\xff\xdd\xba\x79\x90\x92\x01\x00\x00")
 
 func docGoBytes() ([]byte, error) {
@@ -267,7 +309,9 @@ var _bindata = map[string]func() (*asset, error){
 	"1593601728_initial_schema.up.sql":                 _1593601728_initial_schemaUpSql,
 	"1598419937_add_push_notifications_table.down.sql": _1598419937_add_push_notifications_tableDownSql,
 	"1598419937_add_push_notifications_table.up.sql":   _1598419937_add_push_notifications_tableUpSql,
-	"doc.go": docGo,
+	"1722600000_add_abuse_protection.down.sql":         _1722600000_add_abuse_protectionDownSql,
+	"1722600000_add_abuse_protection.up.sql":           _1722600000_add_abuse_protectionUpSql,
+	"doc.go":                                           docGo,
 }
 
 // AssetDebug is true if the assets were built with the debug flag enabled.
@@ -320,7 +364,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"1593601728_initial_schema.up.sql":                 {_1593601728_initial_schemaUpSql, map[string]*bintree{}},
 	"1598419937_add_push_notifications_table.down.sql": {_1598419937_add_push_notifications_tableDownSql, map[string]*bintree{}},
 	"1598419937_add_push_notifications_table.up.sql":   {_1598419937_add_push_notifications_tableUpSql, map[string]*bintree{}},
-	"doc.go": {docGo, map[string]*bintree{}},
+	"1722600000_add_abuse_protection.down.sql":         {_1722600000_add_abuse_protectionDownSql, map[string]*bintree{}},
+	"1722600000_add_abuse_protection.up.sql":           {_1722600000_add_abuse_protectionUpSql, map[string]*bintree{}},
+	"doc.go":                                           {docGo, map[string]*bintree{}},
 }}
 
 // RestoreAsset restores an asset under the given directory.
@@ -0,0 +1,2 @@
+DROP TABLE push_notification_server_stats;
+DROP TABLE push_notification_server_blocked_keys;
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS push_notification_server_stats (
+  name VARCHAR NOT NULL PRIMARY KEY,
+  value INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS push_notification_server_blocked_keys (
+  public_key BLOB NOT NULL PRIMARY KEY,
+  blocked_at INTEGER NOT NULL
+);
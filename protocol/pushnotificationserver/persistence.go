@@ -35,6 +35,16 @@ type Persistence interface {
 	SaveIdentity(*ecdsa.PrivateKey) error
 	// PushNotificationExists checks whether a push notification exists and inserts it otherwise
 	PushNotificationExists([]byte) (bool, error)
+	// IncrementStat adds delta to the counter with the given name
+	IncrementStat(name string, delta int64) error
+	// GetStats returns all the counters
+	GetStats() (map[string]int64, error)
+	// BlockPublicKey stops handling messages from the given public key
+	BlockPublicKey(publicKey *ecdsa.PublicKey, blockedAt uint64) error
+	// UnblockPublicKey resumes handling messages from the given public key
+	UnblockPublicKey(publicKey *ecdsa.PublicKey) error
+	// GetBlockedPublicKeys returns all the blocked public keys
+	GetBlockedPublicKeys() ([]*ecdsa.PublicKey, error)
 }
 
 type SQLitePersistence struct {
@@ -192,3 +202,59 @@ func (p *SQLitePersistence) PushNotificationExists(messageID []byte) (bool, erro
 
 	return false, nil
 }
+
+func (p *SQLitePersistence) IncrementStat(name string, delta int64) error {
+	_, err := p.db.Exec(`INSERT INTO push_notification_server_stats (name, value) VALUES (?, ?) ON CONFLICT(name) DO UPDATE SET value = value + excluded.value`, name, delta)
+	return err
+}
+
+func (p *SQLitePersistence) GetStats() (map[string]int64, error) {
+	rows, err := p.db.Query(`SELECT name, value FROM push_notification_server_stats`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[string]int64)
+	for rows.Next() {
+		var name string
+		var value int64
+		if err := rows.Scan(&name, &value); err != nil {
+			return nil, err
+		}
+		stats[name] = value
+	}
+	return stats, rows.Err()
+}
+
+func (p *SQLitePersistence) BlockPublicKey(publicKey *ecdsa.PublicKey, blockedAt uint64) error {
+	_, err := p.db.Exec(`INSERT OR REPLACE INTO push_notification_server_blocked_keys (public_key, blocked_at) VALUES (?, ?)`, crypto.CompressPubkey(publicKey), blockedAt)
+	return err
+}
+
+func (p *SQLitePersistence) UnblockPublicKey(publicKey *ecdsa.PublicKey) error {
+	_, err := p.db.Exec(`DELETE FROM push_notification_server_blocked_keys WHERE public_key = ?`, crypto.CompressPubkey(publicKey))
+	return err
+}
+
+func (p *SQLitePersistence) GetBlockedPublicKeys() ([]*ecdsa.PublicKey, error) {
+	rows, err := p.db.Query(`SELECT public_key FROM push_notification_server_blocked_keys ORDER BY blocked_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var publicKeys []*ecdsa.PublicKey
+	for rows.Next() {
+		var compressedPublicKey []byte
+		if err := rows.Scan(&compressedPublicKey); err != nil {
+			return nil, err
+		}
+		publicKey, err := crypto.DecompressPubkey(compressedPublicKey)
+		if err != nil {
+			return nil, err
+		}
+		publicKeys = append(publicKeys, publicKey)
+	}
+	return publicKeys, rows.Err()
+}
@@ -5,6 +5,8 @@ import (
 	"context"
 	"crypto/ecdsa"
 	"encoding/hex"
+	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/google/uuid"
@@ -29,6 +31,25 @@ type Config struct {
 	Identity *ecdsa.PrivateKey
 	// GorushUrl is the url for the gorush service
 	GorushURL string
+	// APNs enables the native delivery of APN notifications
+	APNs *APNsConfig
+	// FCM enables the native delivery of Firebase notifications
+	FCM *FCMConfig
+	// Webhook replaces gorush for the token types without a native dispatcher
+	Webhook *WebhookConfig
+	// Dispatchers overrides the dispatcher of a token type
+	Dispatchers map[protobuf.PushNotificationRegistration_TokenType]Dispatcher
+	// DispatchRetries is the number of times a failed notification is retried
+	DispatchRetries int
+	// DispatchRetryBackoff is the delay before the first retry, doubled on each attempt
+	DispatchRetryBackoff time.Duration
+	// MaxNotificationsPerSenderPerMinute limits the notifications from a single author in a single chat,
+	// the author is claimed by the sender so the limit is best effort
+	MaxNotificationsPerSenderPerMinute int
+	// MaxNotificationsPerTokenPerMinute limits the notifications sent to a single device token
+	MaxNotificationsPerTokenPerMinute int
+	// DuplicateNotificationWindow is the period during which identical notifications are only sent once
+	DuplicateNotificationWindow time.Duration
 
 	Logger *zap.Logger
 }
@@ -37,8 +58,17 @@ type Server struct {
 	persistence   Persistence
 	config        *Config
 	messageSender *common.MessageSender
+	dispatchers   map[protobuf.PushNotificationRegistration_TokenType]Dispatcher
+	// abuseProtection drops the requests of blocked and abusive senders
+	abuseProtection *abuseProtection
 	// SentRequests keeps track of the requests sent to gorush, for testing only
 	SentRequests int64
+
+	// retries are the failed notifications, dispatched again by the retry loop
+	retries       chan *dispatchRetry
+	retryLoopOnce sync.Once
+	quit          chan struct{}
+	stopOnce      sync.Once
 }
 
 func New(config *Config, persistence Persistence, messageSender *common.MessageSender) *Server {
@@ -46,7 +76,29 @@ func New(config *Config, persistence Persistence, messageSender *common.MessageS
 		config.GorushURL = defaultGorushURL
 
 	}
-	return &Server{persistence: persistence, config: config, messageSender: messageSender}
+	if config.DispatchRetries == 0 {
+		config.DispatchRetries = defaultDispatchRetries
+	}
+	if config.DispatchRetryBackoff == 0 {
+		config.DispatchRetryBackoff = defaultDispatchRetryBackoff
+	}
+	if config.MaxNotificationsPerSenderPerMinute == 0 {
+		config.MaxNotificationsPerSenderPerMinute = defaultMaxNotificationsPerSenderPerMinute
+	}
+	if config.MaxNotificationsPerTokenPerMinute == 0 {
+		config.MaxNotificationsPerTokenPerMinute = defaultMaxNotificationsPerTokenPerMinute
+	}
+	if config.DuplicateNotificationWindow == 0 {
+		config.DuplicateNotificationWindow = defaultDuplicateNotificationWindow
+	}
+	return &Server{
+		persistence:     persistence,
+		config:          config,
+		messageSender:   messageSender,
+		abuseProtection: newAbuseProtection(config),
+		retries:         make(chan *dispatchRetry, dispatchRetryQueueSize),
+		quit:            make(chan struct{}),
+	}
 }
 
 func (s *Server) Start() error {
@@ -78,6 +130,16 @@ func (s *Server) Start() error {
 		s.config.Identity = identity
 	}
 
+	dispatchers, err := buildDispatchers(s.config)
+	if err != nil {
+		return err
+	}
+	s.dispatchers = dispatchers
+
+	if err := s.loadBlockedPublicKeys(); err != nil {
+		return err
+	}
+
 	pks, err := s.persistence.GetPushNotificationRegistrationPublicKeys()
 	if err != nil {
 		return err
@@ -96,6 +158,11 @@ func (s *Server) Start() error {
 
 // HandlePushNotificationRegistration builds a response for the registration and sends it back to the user
 func (s *Server) HandlePushNotificationRegistration(publicKey *ecdsa.PublicKey, payload []byte) error {
+	if publicKey != nil && s.abuseProtection.isBlocked(publicKey) {
+		s.recordStat(StatBlocked, 1)
+		return nil
+	}
+
 	response := s.buildPushNotificationRegistrationResponse(publicKey, payload)
 	if response == nil {
 		return nil
@@ -122,6 +189,8 @@ func (s *Server) HandlePushNotificationQuery(publicKey *ecdsa.PublicKey, message
 		return nil
 	}
 
+	s.recordStat(StatQueries, 1)
+
 	response := s.buildPushNotificationQueryResponse(query)
 	if response == nil {
 		return nil
@@ -160,15 +229,18 @@ func (s *Server) HandlePushNotificationRequest(publicKey *ecdsa.PublicKey,
 		return nil
 	}
 
+	s.recordStat(StatRequests, 1)
+
 	response, requestsAndRegistrations := s.buildPushNotificationRequestResponse(request)
 	//AndSendNotification(&request)
 	if response == nil {
 		return nil
 	}
-	err = s.sendPushNotification(requestsAndRegistrations)
-	if err != nil {
-		s.config.Logger.Error("failed to send go rush notification", zap.Error(err))
-		return err
+	// The notifications which couldn't be delivered are reported, the others are still notified
+	failures := s.sendPushNotification(requestsAndRegistrations)
+	if len(failures) != 0 {
+		s.config.Logger.Warn("failed to deliver notifications", zap.Int("count", len(failures)))
+		reportFailures(response, failures)
 	}
 	encodedMessage, err := proto.Marshal(response)
 	if err != nil {
@@ -306,6 +378,20 @@ func (s *Server) validateRegistration(publicKey *ecdsa.PublicKey, payload []byte
 		return nil, ErrUnknownPushNotificationRegistrationTokenType
 	}
 
+	if _, ok := protobuf.PushNotificationRegistration_TokenType_name[int32(registration.TokenType)]; !ok {
+		return nil, ErrUnsupportedPushNotificationRegistrationTokenType
+	}
+
+	if s.dispatchers != nil && s.dispatchers[registration.TokenType] == nil {
+		return nil, ErrUnsupportedPushNotificationRegistrationTokenType
+	}
+
+	if registration.TokenType == protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN {
+		if err := validateUnifiedPushEndpoint(registration); err != nil {
+			return nil, err
+		}
+	}
+
 	return registration, nil
 }
 
@@ -430,6 +516,16 @@ func (s *Server) buildPushNotificationRequestResponse(request *protobuf.PushNoti
 				continue
 			}
 
+			// Notifications dropped by the abuse protection are still reported as successful,
+			// so that the sender doesn't retry them
+			if response.sendNotification {
+				if reason := s.abuseProtection.checkNotification(pn, registration, time.Now()); reason != "" {
+					s.config.Logger.Debug("dropping notification", zap.String("reason", reason))
+					s.recordStat(reason, 1)
+					response.sendNotification = false
+				}
+			}
+
 			if response.sendNotification {
 				requestAndRegistrations = append(requestAndRegistrations, &RequestAndRegistration{
 					Request:      pn,
@@ -452,13 +548,93 @@ func (s *Server) buildPushNotificationRequestResponse(request *protobuf.PushNoti
 	return response, requestAndRegistrations
 }
 
-func (s *Server) sendPushNotification(requestAndRegistrations []*RequestAndRegistration) error {
+// sendPushNotification dispatches the notifications and returns the error to report
+// for each notification which couldn't be delivered
+func (s *Server) sendPushNotification(requestAndRegistrations []*RequestAndRegistration) map[*protobuf.PushNotification]protobuf.PushNotificationReport_ErrorType {
 	if len(requestAndRegistrations) == 0 {
 		return nil
 	}
 	s.SentRequests++
-	goRushRequest := PushNotificationRegistrationToGoRushRequest(requestAndRegistrations)
-	return sendGoRushNotification(goRushRequest, s.config.GorushURL, s.config.Logger)
+
+	failures := make(map[*protobuf.PushNotification]protobuf.PushNotificationReport_ErrorType)
+
+	if s.dispatchers == nil {
+		dispatchers, err := buildDispatchers(s.config)
+		if err != nil {
+			s.config.Logger.Error("failed to build dispatchers", zap.Error(err))
+			for _, requestAndRegistration := range requestAndRegistrations {
+				failures[requestAndRegistration.Request] = protobuf.PushNotificationReport_INTERNAL_ERROR
+			}
+			return failures
+		}
+		s.dispatchers = dispatchers
+	}
+
+	notificationsByDispatcher := make(map[Dispatcher][]*RequestAndRegistration)
+	for _, requestAndRegistration := range requestAndRegistrations {
+		dispatcher := s.dispatchers[requestAndRegistration.Registration.TokenType]
+		if dispatcher == nil {
+			s.config.Logger.Warn("no dispatcher for token type", zap.Stringer("token-type", requestAndRegistration.Registration.TokenType))
+			failures[requestAndRegistration.Request] = protobuf.PushNotificationReport_INTERNAL_ERROR
+			continue
+		}
+		notificationsByDispatcher[dispatcher] = append(notificationsByDispatcher[dispatcher], requestAndRegistration)
+	}
+
+	for dispatcher, notifications := range notificationsByDispatcher {
+		undelivered, invalidTokens := s.dispatch(dispatcher, notifications)
+		for _, requestAndRegistration := range undelivered {
+			failures[requestAndRegistration.Request] = protobuf.PushNotificationReport_INTERNAL_ERROR
+		}
+		for _, requestAndRegistration := range invalidTokens {
+			failures[requestAndRegistration.Request] = protobuf.PushNotificationReport_NOT_REGISTERED
+		}
+	}
+
+	return failures
+}
+
+// reportFailures marks the reports of the notifications which couldn't be delivered as failed
+func reportFailures(response *protobuf.PushNotificationResponse, failures map[*protobuf.PushNotification]protobuf.PushNotificationReport_ErrorType) {
+	for pn, errorType := range failures {
+		for _, report := range response.Reports {
+			if bytes.Equal(report.PublicKey, pn.PublicKey) && report.InstallationId == pn.InstallationId {
+				report.Success = false
+				report.Error = errorType
+			}
+		}
+	}
+}
+
+// Stop stops retrying the notifications which failed
+func (s *Server) Stop() error {
+	s.stopOnce.Do(func() {
+		close(s.quit)
+	})
+	return nil
+}
+
+// unregisterInvalidTokens removes the registrations whose device token was reported as invalid by the provider,
+// so that no further notification is sent to them
+func (s *Server) unregisterInvalidTokens(invalidTokens []*RequestAndRegistration) {
+	for _, requestAndRegistration := range invalidTokens {
+		publicKey := requestAndRegistration.Request.PublicKey
+		registration, err := s.persistence.GetPushNotificationRegistrationByPublicKeyAndInstallationID(publicKey, requestAndRegistration.Request.InstallationId)
+		if err != nil {
+			s.config.Logger.Error("failed to get registration", zap.Error(err))
+			continue
+		}
+		// The client might have registered a new token in the meantime
+		if registration == nil || registration.DeviceToken != requestAndRegistration.Registration.DeviceToken {
+			continue
+		}
+
+		s.config.Logger.Info("unregistering invalid token", zap.String("installation-id", registration.InstallationId))
+		err = s.persistence.UnregisterPushNotificationRegistration(publicKey, registration.InstallationId, registration.Version)
+		if err != nil {
+			s.config.Logger.Error("failed to unregister invalid token", zap.Error(err))
+		}
+	}
 }
 
 // listenToPublicKeyQueryTopic listen to a topic derived from the hashed public key
@@ -484,6 +660,8 @@ func (s *Server) buildPushNotificationRegistrationResponse(publicKey *ecdsa.Publ
 	if err != nil {
 		if err == ErrInvalidPushNotificationRegistrationVersion {
 			response.Error = protobuf.PushNotificationRegistrationResponse_VERSION_MISMATCH
+		} else if err == ErrUnsupportedPushNotificationRegistrationTokenType {
+			response.Error = protobuf.PushNotificationRegistrationResponse_UNSUPPORTED_TOKEN_TYPE
 		} else {
 			response.Error = protobuf.PushNotificationRegistrationResponse_MALFORMED_MESSAGE
 		}
@@ -513,6 +691,7 @@ func (s *Server) buildPushNotificationRegistrationResponse(publicKey *ecdsa.Publ
 
 	}
 	response.Success = true
+	s.recordStat(StatRegistrations, 1)
 
 	s.config.Logger.Debug("handled push notification registration successfully")
 
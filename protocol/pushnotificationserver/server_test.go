@@ -1,6 +1,7 @@
 package pushnotificationserver
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"testing"
@@ -926,3 +927,171 @@ func (s *ServerSuite) TestBuildPushNotificationReport() {
 		})
 	}
 }
+
+func (s *ServerSuite) TestPushNotificationRegistrationUnsupportedTokenType() {
+	payload, err := proto.Marshal(&protobuf.PushNotificationRegistration{
+		DeviceToken:    "abc",
+		AccessToken:    s.accessToken,
+		Grant:          s.grant,
+		TokenType:      protobuf.PushNotificationRegistration_TokenType(42),
+		InstallationId: s.installationID,
+		Version:        1,
+	})
+	s.Require().NoError(err)
+
+	cyphertext, err := common.Encrypt(payload, s.sharedKey, rand.Reader)
+	s.Require().NoError(err)
+	response := s.server.buildPushNotificationRegistrationResponse(&s.key.PublicKey, cyphertext)
+	s.Require().False(response.Success)
+	s.Require().Equal(protobuf.PushNotificationRegistrationResponse_UNSUPPORTED_TOKEN_TYPE, response.Error)
+
+	// UnifiedPush endpoints must be https urls
+	payload, err = proto.Marshal(&protobuf.PushNotificationRegistration{
+		DeviceToken:    "http://push.example.com/endpoint",
+		AccessToken:    s.accessToken,
+		Grant:          s.grant,
+		TokenType:      protobuf.PushNotificationRegistration_UNIFIED_PUSH_TOKEN,
+		InstallationId: s.installationID,
+		Version:        1,
+	})
+	s.Require().NoError(err)
+
+	cyphertext, err = common.Encrypt(payload, s.sharedKey, rand.Reader)
+	s.Require().NoError(err)
+	_, err = s.server.validateRegistration(&s.key.PublicKey, cyphertext)
+	s.Require().Equal(ErrMalformedPushNotificationRegistrationDeviceToken, err)
+}
+
+func (s *ServerSuite) TestUnregisterInvalidTokens() {
+	hashedPublicKey := common.HashPublicKey(&s.key.PublicKey)
+	registration := &protobuf.PushNotificationRegistration{
+		DeviceToken:    "invalid-token",
+		TokenType:      protobuf.PushNotificationRegistration_APN_TOKEN,
+		AccessToken:    s.accessToken,
+		InstallationId: s.installationID,
+		Version:        1,
+	}
+	s.Require().NoError(s.persistence.SavePushNotificationRegistration(hashedPublicKey, registration))
+
+	dispatcher := &invalidTokensDispatcher{}
+	s.server.config.Dispatchers = map[protobuf.PushNotificationRegistration_TokenType]Dispatcher{
+		protobuf.PushNotificationRegistration_APN_TOKEN: dispatcher,
+	}
+
+	request := &protobuf.PushNotification{
+		PublicKey:      hashedPublicKey,
+		InstallationId: s.installationID,
+		Type:           protobuf.PushNotification_MESSAGE,
+	}
+	failures := s.server.sendPushNotification([]*RequestAndRegistration{{
+		Request:      request,
+		Registration: registration,
+	}})
+	s.Require().Equal(protobuf.PushNotificationReport_NOT_REGISTERED, failures[request])
+	s.Require().Equal(1, dispatcher.calls)
+
+	retrievedRegistration, err := s.persistence.GetPushNotificationRegistrationByPublicKeyAndInstallationID(hashedPublicKey, s.installationID)
+	s.Require().NoError(err)
+	s.Require().Nil(retrievedRegistration)
+
+	// The version is kept, so that the client can register again
+	version, err := s.persistence.GetPushNotificationRegistrationVersion(hashedPublicKey, s.installationID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), version)
+}
+
+type invalidTokensDispatcher struct {
+	calls int
+}
+
+func (d *invalidTokensDispatcher) Name() string {
+	return "invalid-tokens"
+}
+
+func (d *invalidTokensDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	d.calls++
+	return &DispatchResult{InvalidTokens: notifications}
+}
+
+type rejectingDispatcher struct{}
+
+func (d *rejectingDispatcher) Name() string {
+	return "rejecting"
+}
+
+func (d *rejectingDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+	for _, notification := range notifications {
+		if notification.Registration.DeviceToken == "rejected-token" {
+			result.Rejected = append(result.Rejected, notification)
+		}
+	}
+	return result
+}
+
+func (s *ServerSuite) TestReportUndeliveredNotifications() {
+	s.server.config.Dispatchers = map[protobuf.PushNotificationRegistration_TokenType]Dispatcher{
+		protobuf.PushNotificationRegistration_APN_TOKEN: &rejectingDispatcher{},
+	}
+
+	delivered := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "token")
+	rejected := testNotification(protobuf.PushNotificationRegistration_APN_TOKEN, "rejected-token")
+	rejected.Request.InstallationId = "other-installation-id"
+
+	failures := s.server.sendPushNotification([]*RequestAndRegistration{delivered, rejected})
+	s.Require().Len(failures, 1)
+
+	response := &protobuf.PushNotificationResponse{
+		Reports: []*protobuf.PushNotificationReport{
+			{Success: true, PublicKey: delivered.Request.PublicKey, InstallationId: delivered.Request.InstallationId},
+			{Success: true, PublicKey: rejected.Request.PublicKey, InstallationId: rejected.Request.InstallationId},
+		},
+	}
+	reportFailures(response, failures)
+
+	// Only the rejected notification is reported as failed
+	s.Require().True(response.Reports[0].Success)
+	s.Require().False(response.Reports[1].Success)
+	s.Require().Equal(protobuf.PushNotificationReport_INTERNAL_ERROR, response.Reports[1].Error)
+}
+
+func (s *ServerSuite) TestBlockPublicKey() {
+	s.Require().False(s.server.abuseProtection.isBlocked(&s.key.PublicKey))
+
+	s.Require().NoError(s.server.BlockPublicKey(&s.key.PublicKey))
+	s.Require().True(s.server.abuseProtection.isBlocked(&s.key.PublicKey))
+
+	// Registrations of blocked keys are ignored
+	s.Require().NoError(s.server.HandlePushNotificationRegistration(&s.key.PublicKey, []byte("payload")))
+
+	// The blocked keys are loaded when the server is restarted
+	server := New(&Config{Identity: s.identity, Logger: s.server.config.Logger}, s.persistence, nil)
+	s.Require().NoError(server.Start())
+	s.Require().True(server.abuseProtection.isBlocked(&s.key.PublicKey))
+
+	blockedKeys, err := server.BlockedPublicKeys()
+	s.Require().NoError(err)
+	s.Require().Len(blockedKeys, 1)
+	s.Require().True(blockedKeys[0].Equal(&s.key.PublicKey))
+
+	s.Require().NoError(server.UnblockPublicKey(&s.key.PublicKey))
+	s.Require().False(server.abuseProtection.isBlocked(&s.key.PublicKey))
+
+	blockedKeys, err = server.BlockedPublicKeys()
+	s.Require().NoError(err)
+	s.Require().Empty(blockedKeys)
+
+	stats, err := server.Stats()
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), stats[StatBlocked])
+}
+
+func (s *ServerSuite) TestStats() {
+	s.server.recordStat(StatRequests, 1)
+	s.server.recordStat(StatRequests, 2)
+	s.server.recordStat(StatDeliveries, 0)
+
+	stats, err := s.server.Stats()
+	s.Require().NoError(err)
+	s.Require().Equal(map[string]int64{StatRequests: 3}, stats)
+}
@@ -0,0 +1,230 @@
+package pushnotificationserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var errPrivateUnifiedPushEndpoint = errors.New("unified push endpoint resolves to a private address")
+
+// WebhookConfig is the config of the generic webhook dispatcher
+type WebhookConfig struct {
+	// URL is the endpoint the notifications are posted to
+	URL string
+	// AuthorizationHeader is sent as is in the Authorization header, if set
+	AuthorizationHeader string
+}
+
+type WebhookNotification struct {
+	Token     string            `json:"token"`
+	TokenType string            `json:"tokenType"`
+	Topic     string            `json:"topic,omitempty"`
+	Message   string            `json:"message"`
+	Data      *NotificationData `json:"data"`
+}
+
+type WebhookRequest struct {
+	Notifications []*WebhookNotification `json:"notifications"`
+}
+
+// WebhookResponse is the optional body of the webhook response,
+// listing the tokens which should be unregistered
+type WebhookResponse struct {
+	InvalidTokens []string `json:"invalidTokens"`
+}
+
+// WebhookDispatcher posts the notifications to an operator provided endpoint, which delivers them
+type WebhookDispatcher struct {
+	config *WebhookConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+func NewWebhookDispatcher(config *WebhookConfig, logger *zap.Logger) *WebhookDispatcher {
+	return &WebhookDispatcher{config: config, client: http.DefaultClient, logger: logger}
+}
+
+func (d *WebhookDispatcher) Name() string {
+	return "webhook"
+}
+
+func (d *WebhookDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+
+	webhookRequest := &WebhookRequest{}
+	for _, notification := range notifications {
+		webhookRequest.Notifications = append(webhookRequest.Notifications, &WebhookNotification{
+			Token:     notification.Registration.DeviceToken,
+			TokenType: notification.Registration.TokenType.String(),
+			Topic:     notification.Registration.ApnTopic,
+			Message:   notificationText(notification.Request),
+			Data:      notificationData(notification.Request),
+		})
+	}
+
+	statusCode, body, err := d.post(ctx, webhookRequest)
+	if err != nil || !isSuccessStatus(statusCode) {
+		d.logger.Warn("webhook request failed", zap.Int("status", statusCode), zap.Error(err))
+		retryable := err != nil || isRetryableStatus(statusCode)
+		for _, notification := range notifications {
+			result.fail(notification, retryable)
+		}
+		return result
+	}
+
+	var webhookResponse WebhookResponse
+	if len(body) == 0 || json.Unmarshal(body, &webhookResponse) != nil {
+		return result
+	}
+
+	invalidTokens := make(map[string]bool)
+	for _, token := range webhookResponse.InvalidTokens {
+		invalidTokens[token] = true
+	}
+	for _, notification := range notifications {
+		if invalidTokens[notification.Registration.DeviceToken] {
+			result.InvalidTokens = append(result.InvalidTokens, notification)
+		}
+	}
+
+	return result
+}
+
+func (d *WebhookDispatcher) post(ctx context.Context, webhookRequest *WebhookRequest) (int, []byte, error) {
+	payload, err := json.Marshal(webhookRequest)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, d.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if d.config.AuthorizationHeader != "" {
+		request.Header.Set("Authorization", d.config.AuthorizationHeader)
+	}
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	return response.StatusCode, body, err
+}
+
+// UnifiedPushNotification is the message posted to the UnifiedPush endpoint of the device
+type UnifiedPushNotification struct {
+	Message string `json:"message"`
+	*NotificationData
+}
+
+// UnifiedPushDispatcher posts the notifications to the UnifiedPush endpoint registered by the device,
+// which is the registration device token
+type UnifiedPushDispatcher struct {
+	client *http.Client
+	logger *zap.Logger
+}
+
+func NewUnifiedPushDispatcher(logger *zap.Logger) *UnifiedPushDispatcher {
+	// Endpoints are provided by the users, make sure they can't reach the internal network
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+				return errPrivateUnifiedPushEndpoint
+			}
+			return nil
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &UnifiedPushDispatcher{
+		client: &http.Client{
+			Transport: transport,
+			// Redirects could point to the internal network as well
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		logger: logger,
+	}
+}
+
+func (d *UnifiedPushDispatcher) Name() string {
+	return "unifiedpush"
+}
+
+func (d *UnifiedPushDispatcher) Dispatch(ctx context.Context, notifications []*RequestAndRegistration) *DispatchResult {
+	result := &DispatchResult{}
+	for _, notification := range notifications {
+		d.send(ctx, notification, result)
+	}
+	return result
+}
+
+func (d *UnifiedPushDispatcher) send(ctx context.Context, notification *RequestAndRegistration, result *DispatchResult) {
+	payload, err := json.Marshal(&UnifiedPushNotification{
+		Message:          notificationText(notification.Request),
+		NotificationData: notificationData(notification.Request),
+	})
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.Registration.DeviceToken, bytes.NewReader(payload))
+	if err != nil {
+		result.fail(notification, false)
+		return
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("TTL", "86400")
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		d.logger.Warn("failed to send unified push notification", zap.Error(err))
+		result.fail(notification, !errors.Is(err, errPrivateUnifiedPushEndpoint))
+		return
+	}
+	defer response.Body.Close()
+
+	switch {
+	case isSuccessStatus(response.StatusCode):
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		result.InvalidTokens = append(result.InvalidTokens, notification)
+	default:
+		d.logger.Warn("unified push notification failed", zap.Int("status", response.StatusCode))
+		result.fail(notification, isRetryableStatus(response.StatusCode))
+	}
+}
+
+// validateUnifiedPushEndpoint checks that the device token of a UnifiedPush registration is an https url
+func validateUnifiedPushEndpoint(registration *protobuf.PushNotificationRegistration) error {
+	endpoint, err := url.Parse(registration.DeviceToken)
+	if err != nil || endpoint.Scheme != "https" || endpoint.Host == "" || endpoint.User != nil {
+		return ErrMalformedPushNotificationRegistrationDeviceToken
+	}
+	return nil
+}
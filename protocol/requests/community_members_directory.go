@@ -0,0 +1,35 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrCommunityMembersDirectoryInvalidCommunityID = errors.New("community members directory: invalid community id")
+var ErrCommunityMembersDirectoryInvalidJoinedRange = errors.New("community members directory: joinedAfter is after joinedBefore")
+
+type CommunityMembersDirectory struct {
+	CommunityID  types.HexBytes                   `json:"communityId"`
+	Cursor       string                           `json:"cursor"`
+	Limit        uint64                           `json:"limit"`
+	Search       string                           `json:"search"`
+	Roles        []protobuf.CommunityMember_Roles `json:"roles"`
+	OnlineOnly   bool                             `json:"onlineOnly"`
+	PermissionID string                           `json:"permissionId"`
+	JoinedAfter  uint64                           `json:"joinedAfter"`
+	JoinedBefore uint64                           `json:"joinedBefore"`
+}
+
+func (c *CommunityMembersDirectory) Validate() error {
+	if len(c.CommunityID) == 0 {
+		return ErrCommunityMembersDirectoryInvalidCommunityID
+	}
+
+	if c.JoinedBefore != 0 && c.JoinedAfter > c.JoinedBefore {
+		return ErrCommunityMembersDirectoryInvalidJoinedRange
+	}
+
+	return nil
+}
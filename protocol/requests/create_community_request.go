@@ -16,6 +16,8 @@ var (
 	ErrCreateCommunityInvalidOutroMessage = errors.New("create-community: invalid outro message")
 	ErrCreateCommunityInvalidMembership   = errors.New("create-community: invalid membership")
 	ErrCreateCommunityInvalidTags         = errors.New("create-community: invalid tags")
+
+	ErrCreateCommunityInvalidMembershipQuestions = errors.New("create-community: invalid membership questions")
 )
 
 const (
@@ -23,26 +25,31 @@ const (
 	maxDescriptionLength  = 140
 	maxIntroMessageLength = 1400
 	maxOutroMessageLength = 80
+
+	maxMembershipQuestions       = 10
+	maxMembershipQuestionLength  = 300
+	maxMembershipQuestionChoices = 20
 )
 
 type CreateCommunity struct {
-	Name                         string                               `json:"name"`
-	Description                  string                               `json:"description"`
-	IntroMessage                 string                               `json:"introMessage,omitempty"`
-	OutroMessage                 string                               `json:"outroMessage,omitempty"`
-	Color                        string                               `json:"color"`
-	Emoji                        string                               `json:"emoji"`
-	Membership                   protobuf.CommunityPermissions_Access `json:"membership"`
-	EnsOnly                      bool                                 `json:"ensOnly"`
-	Image                        string                               `json:"image"`
-	ImageAx                      int                                  `json:"imageAx"`
-	ImageAy                      int                                  `json:"imageAy"`
-	ImageBx                      int                                  `json:"imageBx"`
-	ImageBy                      int                                  `json:"imageBy"`
-	Banner                       images.CroppedImage                  `json:"banner"`
-	HistoryArchiveSupportEnabled bool                                 `json:"historyArchiveSupportEnabled,omitempty"`
-	PinMessageAllMembersEnabled  bool                                 `json:"pinMessageAllMembersEnabled,omitempty"`
-	Tags                         []string                             `json:"tags,omitempty"`
+	Name                         string                                  `json:"name"`
+	Description                  string                                  `json:"description"`
+	IntroMessage                 string                                  `json:"introMessage,omitempty"`
+	OutroMessage                 string                                  `json:"outroMessage,omitempty"`
+	Color                        string                                  `json:"color"`
+	Emoji                        string                                  `json:"emoji"`
+	Membership                   protobuf.CommunityPermissions_Access    `json:"membership"`
+	EnsOnly                      bool                                    `json:"ensOnly"`
+	Image                        string                                  `json:"image"`
+	ImageAx                      int                                     `json:"imageAx"`
+	ImageAy                      int                                     `json:"imageAy"`
+	ImageBx                      int                                     `json:"imageBx"`
+	ImageBy                      int                                     `json:"imageBy"`
+	Banner                       images.CroppedImage                     `json:"banner"`
+	HistoryArchiveSupportEnabled bool                                    `json:"historyArchiveSupportEnabled,omitempty"`
+	PinMessageAllMembersEnabled  bool                                    `json:"pinMessageAllMembersEnabled,omitempty"`
+	Tags                         []string                                `json:"tags,omitempty"`
+	MembershipQuestions          []*protobuf.CommunityMembershipQuestion `json:"membershipQuestions,omitempty"`
 }
 
 func adaptIdentityImageToProtobuf(img images.IdentityImage) *protobuf.IdentityImage {
@@ -82,9 +89,55 @@ func (c *CreateCommunity) Validate() error {
 		return ErrCreateCommunityInvalidTags
 	}
 
+	if !c.validateMembershipQuestions() {
+		return ErrCreateCommunityInvalidMembershipQuestions
+	}
+
 	return nil
 }
 
+func (c *CreateCommunity) validateMembershipQuestions() bool {
+	if len(c.MembershipQuestions) == 0 {
+		return true
+	}
+
+	if c.Membership != protobuf.CommunityPermissions_MANUAL_ACCEPT || len(c.MembershipQuestions) > maxMembershipQuestions {
+		return false
+	}
+
+	ids := make(map[string]struct{}, len(c.MembershipQuestions))
+	for _, question := range c.MembershipQuestions {
+		if question.Id == "" || question.Question == "" || len(question.Question) > maxMembershipQuestionLength {
+			return false
+		}
+
+		if _, ok := ids[question.Id]; ok {
+			return false
+		}
+		ids[question.Id] = struct{}{}
+
+		switch question.Type {
+		case protobuf.CommunityMembershipQuestion_FREE_TEXT, protobuf.CommunityMembershipQuestion_SOCIAL_LINK:
+			if len(question.Choices) != 0 {
+				return false
+			}
+		case protobuf.CommunityMembershipQuestion_MULTIPLE_CHOICE:
+			if len(question.Choices) < 2 || len(question.Choices) > maxMembershipQuestionChoices {
+				return false
+			}
+			for _, choice := range question.Choices {
+				if choice == "" || len(choice) > maxMembershipQuestionLength {
+					return false
+				}
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func (c *CreateCommunity) ToCommunityDescription() (*protobuf.CommunityDescription, error) {
 	ci := &protobuf.ChatIdentity{
 		DisplayName: c.Name,
@@ -120,8 +173,9 @@ func (c *CreateCommunity) ToCommunityDescription() (*protobuf.CommunityDescripti
 	description := &protobuf.CommunityDescription{
 		Identity: ci,
 		Permissions: &protobuf.CommunityPermissions{
-			Access:  c.Membership,
-			EnsOnly: c.EnsOnly,
+			Access:              c.Membership,
+			EnsOnly:             c.EnsOnly,
+			MembershipQuestions: c.MembershipQuestions,
 		},
 		AdminSettings: &protobuf.CommunityAdminSettings{
 			PinMessageAllMembersEnabled: c.PinMessageAllMembersEnabled,
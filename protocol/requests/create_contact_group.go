@@ -0,0 +1,21 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrCreateContactGroupInvalidName = errors.New("create-contact-group: invalid group name")
+
+type CreateContactGroup struct {
+	Name      string   `json:"name"`
+	Color     string   `json:"color"`
+	MemberIDs []string `json:"memberIds"`
+}
+
+func (c *CreateContactGroup) Validate() error {
+	if len(c.Name) == 0 {
+		return ErrCreateContactGroupInvalidName
+	}
+
+	return nil
+}
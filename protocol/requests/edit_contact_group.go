@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrEditContactGroupInvalidID = errors.New("edit-contact-group: invalid group id")
+var ErrEditContactGroupInvalidName = errors.New("edit-contact-group: invalid group name")
+
+type EditContactGroup struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Color     string   `json:"color"`
+	MemberIDs []string `json:"memberIds"`
+}
+
+func (e *EditContactGroup) Validate() error {
+	if len(e.ID) == 0 {
+		return ErrEditContactGroupInvalidID
+	}
+
+	if len(e.Name) == 0 {
+		return ErrEditContactGroupInvalidName
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrExportCommunityControlNodeBundleInvalidCommunityID = errors.New("export-community-control-node-bundle: invalid community id")
+var ErrExportCommunityControlNodeBundleInvalidPassword = errors.New("export-community-control-node-bundle: password must be at least 8 characters long")
+
+const minControlNodeBundlePasswordLength = 8
+
+type ExportCommunityControlNodeBundle struct {
+	CommunityID types.HexBytes `json:"communityId"`
+	Password    string         `json:"password"`
+}
+
+func (e *ExportCommunityControlNodeBundle) Validate() error {
+	if len(e.CommunityID) == 0 {
+		return ErrExportCommunityControlNodeBundleInvalidCommunityID
+	}
+
+	if len(e.Password) < minControlNodeBundlePasswordLength {
+		return ErrExportCommunityControlNodeBundleInvalidPassword
+	}
+
+	return nil
+}
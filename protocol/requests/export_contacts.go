@@ -0,0 +1,19 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrExportContactsNoContacts = errors.New("export-contacts: no contacts selected")
+
+type ExportContacts struct {
+	ContactIDs []string `json:"contactIds"`
+}
+
+func (e *ExportContacts) Validate() error {
+	if len(e.ContactIDs) == 0 {
+		return ErrExportContactsNoContacts
+	}
+
+	return nil
+}
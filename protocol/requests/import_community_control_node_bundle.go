@@ -0,0 +1,27 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrImportCommunityControlNodeBundleInvalidBundle = errors.New("import-community-control-node-bundle: invalid bundle")
+var ErrImportCommunityControlNodeBundleInvalidPassword = errors.New("import-community-control-node-bundle: invalid password")
+
+type ImportCommunityControlNodeBundle struct {
+	Bundle   types.HexBytes `json:"bundle"`
+	Password string         `json:"password"`
+}
+
+func (i *ImportCommunityControlNodeBundle) Validate() error {
+	if len(i.Bundle) == 0 {
+		return ErrImportCommunityControlNodeBundleInvalidBundle
+	}
+
+	if len(i.Password) == 0 {
+		return ErrImportCommunityControlNodeBundleInvalidPassword
+	}
+
+	return nil
+}
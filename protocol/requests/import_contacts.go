@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+var ErrImportContactsInvalidData = errors.New("import-contacts: invalid data")
+var ErrImportContactsInvalidMessage = errors.New("import-contacts: invalid contact request message")
+
+type ImportContacts struct {
+	Data types.HexBytes `json:"data"`
+	// SendContactRequests sends a contact request to each imported contact
+	SendContactRequests bool   `json:"sendContactRequests"`
+	Message             string `json:"message"`
+}
+
+func (i *ImportContacts) Validate() error {
+	if len(i.Data) == 0 {
+		return ErrImportContactsInvalidData
+	}
+
+	if i.SendContactRequests && len(i.Message) == 0 {
+		return ErrImportContactsInvalidMessage
+	}
+
+	return nil
+}
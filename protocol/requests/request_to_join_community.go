@@ -5,6 +5,7 @@ import (
 
 	"github.com/status-im/status-go/eth-node/crypto"
 	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
 )
 
 var ErrRequestToJoinCommunityInvalidCommunityID = errors.New("request-to-join-community: invalid community id")
@@ -15,12 +16,13 @@ var ErrRequestToJoinNoAirdropAddressAmongAddressesToReveal = errors.New("request
 var ErrRequestToJoinCommunityInvalidSignature = errors.New("request-to-join-community: invalid signature")
 
 type RequestToJoinCommunity struct {
-	CommunityID          types.HexBytes   `json:"communityId"`
-	ENSName              string           `json:"ensName"`
-	AddressesToReveal    []string         `json:"addressesToReveal"`
-	Signatures           []types.HexBytes `json:"signatures"` // the order of signatures should match the order of addresses
-	AirdropAddress       string           `json:"airdropAddress"`
-	ShareFutureAddresses bool             `json:"shareFutureAddresses"`
+	CommunityID          types.HexBytes                        `json:"communityId"`
+	ENSName              string                                `json:"ensName"`
+	AddressesToReveal    []string                              `json:"addressesToReveal"`
+	Signatures           []types.HexBytes                      `json:"signatures"` // the order of signatures should match the order of addresses
+	AirdropAddress       string                                `json:"airdropAddress"`
+	ShareFutureAddresses bool                                  `json:"shareFutureAddresses"`
+	MembershipAnswers    []*protobuf.CommunityMembershipAnswer `json:"membershipAnswers,omitempty"`
 }
 
 func (j *RequestToJoinCommunity) Validate() error {
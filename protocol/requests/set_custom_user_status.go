@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrSetCustomUserStatusInvalidStatusType = errors.New("set-custom-user-status: invalid status type")
+
+type SetCustomUserStatus struct {
+	StatusType protobuf.StatusUpdate_StatusType `json:"statusType"`
+	CustomText string                           `json:"text"`
+	Emoji      string                           `json:"emoji"`
+	// ExpiresAt is the Unix timestamp in seconds at which the previous status is restored,
+	// 0 if the status doesn't expire
+	ExpiresAt uint64 `json:"expiresAt"`
+}
+
+func (r *SetCustomUserStatus) Validate() error {
+	if r.StatusType == protobuf.StatusUpdate_UNKNOWN_STATUS_TYPE {
+		return ErrSetCustomUserStatusInvalidStatusType
+	}
+	if _, ok := protobuf.StatusUpdate_StatusType_name[int32(r.StatusType)]; !ok {
+		return ErrSetCustomUserStatusInvalidStatusType
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package requests
+
+import (
+	"errors"
+)
+
+var ErrSetIncomingMessagesRateLimitInvalidLimit = errors.New("set-incoming-messages-rate-limit: invalid limit")
+var ErrSetIncomingMessagesRateLimitInvalidScore = errors.New("set-incoming-messages-rate-limit: invalid quarantine score")
+
+type SetIncomingMessagesRateLimit struct {
+	// MaxPerSenderPerMinute is the number of messages handled per sender and minute, 0 disables the limit
+	MaxPerSenderPerMinute int `json:"maxPerSenderPerMinute"`
+	// QuarantineScore is the spam score above which a sender is quarantined, the default is used when 0
+	QuarantineScore int `json:"quarantineScore"`
+}
+
+func (r *SetIncomingMessagesRateLimit) Validate() error {
+	if r.MaxPerSenderPerMinute < 0 {
+		return ErrSetIncomingMessagesRateLimitInvalidLimit
+	}
+	if r.QuarantineScore < 0 {
+		return ErrSetIncomingMessagesRateLimitInvalidScore
+	}
+	return nil
+}
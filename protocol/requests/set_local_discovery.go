@@ -0,0 +1,5 @@
+package requests
+
+type SetLocalDiscovery struct {
+	Enabled bool `json:"enabled"`
+}
@@ -7,6 +7,11 @@ type UserStatus struct {
 	StatusType int    `json:"statusType"`
 	Clock      uint64 `json:"clock"`
 	CustomText string `json:"text"`
+	Emoji      string `json:"emoji,omitempty"`
+	// ExpiresAt is the Unix timestamp in seconds at which the status reverts, 0 if it doesn't expire
+	ExpiresAt uint64 `json:"expiresAt,omitempty"`
+	// Previous is the status restored on expiry, it's only kept locally
+	Previous *UserStatus `json:"previous,omitempty"`
 }
 
 func ToUserStatus(msg *protobuf.StatusUpdate) UserStatus {
@@ -14,5 +19,7 @@ func ToUserStatus(msg *protobuf.StatusUpdate) UserStatus {
 		StatusType: int(msg.StatusType),
 		Clock:      msg.Clock,
 		CustomText: msg.CustomText,
+		Emoji:      msg.Emoji,
+		ExpiresAt:  msg.ExpiresAt,
 	}
 }
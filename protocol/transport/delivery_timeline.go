@@ -0,0 +1,244 @@
+package transport
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+)
+
+// maxDeliveryEventsPerMessage bounds the timeline kept for a single message,
+// the oldest events are dropped first
+const maxDeliveryEventsPerMessage = 100
+
+// maxPendingDeliveryEvents bounds the recorded events waiting to be written,
+// the events recorded beyond are dropped
+const maxPendingDeliveryEvents = 10000
+
+// DeliveryEventType is a step in the delivery of a message
+type DeliveryEventType string
+
+const (
+	// DeliveryEventSegmentsCreated is recorded when the message is split in envelopes, details is the number of envelopes
+	DeliveryEventSegmentsCreated DeliveryEventType = "segments-created"
+	// DeliveryEventEnvelopePosted is recorded when an envelope is added to the local waku queue
+	DeliveryEventEnvelopePosted DeliveryEventType = "envelope-posted"
+	// DeliveryEventEnvelopePublished is recorded when an envelope is published, details is the publish method
+	DeliveryEventEnvelopePublished DeliveryEventType = "envelope-published"
+	// DeliveryEventEnvelopeAcknowledged is recorded when a peer acknowledges the batch of an envelope
+	DeliveryEventEnvelopeAcknowledged DeliveryEventType = "envelope-acknowledged"
+	// DeliveryEventEnvelopeStored is recorded when a store node confirms it has the envelope
+	DeliveryEventEnvelopeStored DeliveryEventType = "envelope-stored"
+	// DeliveryEventEnvelopeSent is recorded when an envelope is considered sent
+	DeliveryEventEnvelopeSent DeliveryEventType = "envelope-sent"
+	// DeliveryEventEnvelopeExpired is recorded when an envelope failed to be sent, details is the error
+	DeliveryEventEnvelopeExpired DeliveryEventType = "envelope-expired"
+	// DeliveryEventRetry is recorded when an envelope is posted again, details is the attempt
+	DeliveryEventRetry DeliveryEventType = "retry"
+	// DeliveryEventMessageSent is recorded when all the envelopes of the message are sent
+	DeliveryEventMessageSent DeliveryEventType = "message-sent"
+	// DeliveryEventMessageExpired is recorded when the message couldn't be sent after all attempts
+	DeliveryEventMessageExpired DeliveryEventType = "message-expired"
+	// DeliveryEventDatasyncAck is recorded when a recipient acknowledges the message
+	DeliveryEventDatasyncAck DeliveryEventType = "datasync-ack"
+)
+
+// DeliveryEvent is an entry of the delivery timeline of a message
+type DeliveryEvent struct {
+	MessageID    string            `json:"messageId"`
+	Event        DeliveryEventType `json:"event"`
+	EnvelopeHash string            `json:"envelopeHash,omitempty"`
+	Details      string            `json:"details,omitempty"`
+	// Timestamp in ms
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// DeliveryTimeline persists the delivery events of the messages we send.
+// Recorded events are buffered and written in batches by a background writer,
+// so that recording doesn't hit the disk on the send path
+type DeliveryTimeline struct {
+	db     *sql.DB
+	logger *zap.Logger
+
+	pendingMu sync.Mutex
+	pending   []*DeliveryEvent
+	// writeMu serializes the writes, so that reads see all the events recorded before them
+	writeMu    sync.Mutex
+	flushC     chan struct{}
+	writerOnce sync.Once
+	quit       chan struct{}
+	stopOnce   sync.Once
+}
+
+func NewDeliveryTimeline(db *sql.DB, logger *zap.Logger) *DeliveryTimeline {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &DeliveryTimeline{
+		db:     db,
+		logger: logger,
+		flushC: make(chan struct{}, 1),
+		quit:   make(chan struct{}),
+	}
+}
+
+// Add appends the event to the timeline of its message, dropping the oldest events
+// beyond maxDeliveryEventsPerMessage
+func (d *DeliveryTimeline) Add(event *DeliveryEvent) error {
+	return d.addBatch([]*DeliveryEvent{event})
+}
+
+// addBatch writes the events in a single transaction, trimming the timeline of each message once
+func (d *DeliveryTimeline) addBatch(events []*DeliveryEvent) (err error) {
+	var tx *sql.Tx
+	tx, err = d.db.Begin()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		// don't shadow original error
+		_ = tx.Rollback()
+	}()
+
+	messageIDs := make(map[string]struct{})
+	for _, event := range events {
+		if event.Timestamp == 0 {
+			event.Timestamp = uint64(time.Now().UnixMilli())
+		}
+
+		_, err = tx.Exec(`INSERT INTO message_delivery_timeline(message_id, event, envelope_hash, details, timestamp) VALUES (?, ?, ?, ?, ?)`,
+			event.MessageID, event.Event, event.EnvelopeHash, event.Details, event.Timestamp)
+		if err != nil {
+			return
+		}
+		messageIDs[event.MessageID] = struct{}{}
+	}
+
+	for messageID := range messageIDs {
+		_, err = tx.Exec(`DELETE FROM message_delivery_timeline WHERE message_id = ? AND id NOT IN
+			(SELECT id FROM message_delivery_timeline WHERE message_id = ? ORDER BY id DESC LIMIT ?)`,
+			messageID, messageID, maxDeliveryEventsPerMessage)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// Record queues the event for the timeline of each message, it doesn't block on the database.
+// Errors are logged
+func (d *DeliveryTimeline) Record(messageIDs [][]byte, event DeliveryEventType, envelopeHash types.Hash, details string) {
+	if d == nil || len(messageIDs) == 0 {
+		return
+	}
+
+	var hash string
+	if envelopeHash != (types.Hash{}) {
+		hash = envelopeHash.String()
+	}
+	timestamp := uint64(time.Now().UnixMilli())
+
+	d.pendingMu.Lock()
+	if len(d.pending)+len(messageIDs) > maxPendingDeliveryEvents {
+		d.pendingMu.Unlock()
+		d.logger.Warn("delivery timeline queue is full, dropping event", zap.String("event", string(event)))
+		return
+	}
+	for _, messageID := range messageIDs {
+		d.pending = append(d.pending, &DeliveryEvent{
+			MessageID:    types.HexBytes(messageID).String(),
+			Event:        event,
+			EnvelopeHash: hash,
+			Details:      details,
+			Timestamp:    timestamp,
+		})
+	}
+	d.pendingMu.Unlock()
+
+	d.writerOnce.Do(func() {
+		go d.writeLoop()
+	})
+
+	select {
+	case d.flushC <- struct{}{}:
+	default:
+	}
+}
+
+// writeLoop writes the recorded events until the timeline is stopped
+func (d *DeliveryTimeline) writeLoop() {
+	for {
+		select {
+		case <-d.quit:
+			return
+		case <-d.flushC:
+			d.flush()
+		}
+	}
+}
+
+// flush writes the events recorded so far
+func (d *DeliveryTimeline) flush() {
+	d.writeMu.Lock()
+	defer d.writeMu.Unlock()
+
+	d.pendingMu.Lock()
+	events := d.pending
+	d.pending = nil
+	d.pendingMu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+	if err := d.addBatch(events); err != nil {
+		d.logger.Warn("failed to record delivery events", zap.Int("count", len(events)), zap.Error(err))
+	}
+}
+
+// Stop writes the pending events and stops the background writer
+func (d *DeliveryTimeline) Stop() {
+	if d == nil {
+		return
+	}
+	d.stopOnce.Do(func() {
+		close(d.quit)
+		d.flush()
+	})
+}
+
+// Get returns the timeline of the message, oldest event first
+func (d *DeliveryTimeline) Get(messageID string) ([]*DeliveryEvent, error) {
+	d.flush()
+
+	rows, err := d.db.Query(`SELECT message_id, event, envelope_hash, details, timestamp FROM message_delivery_timeline WHERE message_id = ? ORDER BY id ASC`, messageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*DeliveryEvent
+	for rows.Next() {
+		event := &DeliveryEvent{}
+		err := rows.Scan(&event.MessageID, &event.Event, &event.EnvelopeHash, &event.Details, &event.Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	return result, rows.Err()
+}
+
+// Clean removes the events older than timestamp
+func (d *DeliveryTimeline) Clean(timestamp uint64) error {
+	d.flush()
+
+	_, err := d.db.Exec(`DELETE FROM message_delivery_timeline WHERE timestamp < ?`, timestamp)
+	return err
+}
@@ -0,0 +1,115 @@
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/appdatabase"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/sqlite"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/t/helpers"
+)
+
+func newTestDeliveryTimeline(t *testing.T) *DeliveryTimeline {
+	db, err := helpers.SetupTestMemorySQLDB(appdatabase.DbInitializer{})
+	require.NoError(t, err)
+	require.NoError(t, sqlite.Migrate(db))
+	return NewDeliveryTimeline(db, tt.MustCreateTestLogger())
+}
+
+func TestDeliveryTimelineBounded(t *testing.T) {
+	timeline := newTestDeliveryTimeline(t)
+	messageID := types.HexBytes(testIDs[0]).String()
+
+	for i := 0; i < maxDeliveryEventsPerMessage+5; i++ {
+		require.NoError(t, timeline.Add(&DeliveryEvent{MessageID: messageID, Event: DeliveryEventRetry, Timestamp: uint64(i + 1)}))
+	}
+	require.NoError(t, timeline.Add(&DeliveryEvent{MessageID: "other", Event: DeliveryEventRetry, Timestamp: 1}))
+
+	events, err := timeline.Get(messageID)
+	require.NoError(t, err)
+	require.Len(t, events, maxDeliveryEventsPerMessage)
+	// The oldest events are dropped
+	require.Equal(t, uint64(6), events[0].Timestamp)
+	require.Equal(t, uint64(maxDeliveryEventsPerMessage+5), events[len(events)-1].Timestamp)
+
+	require.NoError(t, timeline.Clean(50))
+	events, err = timeline.Get(messageID)
+	require.NoError(t, err)
+	require.Len(t, events, maxDeliveryEventsPerMessage+5-49)
+
+	events, err = timeline.Get("other")
+	require.NoError(t, err)
+	require.Len(t, events, 0)
+}
+
+func TestEnvelopesMonitorRecordsDeliveryTimeline(t *testing.T) {
+	timeline := newTestDeliveryTimeline(t)
+	monitor := NewEnvelopesMonitor(nil, EnvelopesMonitorConfig{
+		MaxAttempts:      6,
+		IsMailserver:     func(types.EnodeID) bool { return false },
+		DeliveryTimeline: timeline,
+	})
+
+	require.NoError(t, monitor.Add(testIDs, testHashes, []*types.NewMessage{{}}))
+	monitor.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopePublished, Hash: testHash, Data: "LightPush"})
+	monitor.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopeStored, Hash: testHash})
+	monitor.handleEvent(types.EnvelopeEvent{Event: types.EventEnvelopeSent, Hash: testHash})
+
+	events, err := timeline.Get(types.HexBytes(testIDs[0]).String())
+	require.NoError(t, err)
+
+	var eventTypes []DeliveryEventType
+	for _, event := range events {
+		eventTypes = append(eventTypes, event.Event)
+	}
+	require.Equal(t, []DeliveryEventType{
+		DeliveryEventSegmentsCreated,
+		DeliveryEventEnvelopePosted,
+		DeliveryEventEnvelopePublished,
+		DeliveryEventEnvelopeStored,
+		DeliveryEventEnvelopeSent,
+		DeliveryEventMessageSent,
+	}, eventTypes)
+	require.Equal(t, "1", events[0].Details)
+	require.Equal(t, testHash.String(), events[1].EnvelopeHash)
+	require.Equal(t, "LightPush", events[2].Details)
+}
+
+func TestDeliveryTimelineRecordsInBackground(t *testing.T) {
+	timeline := newTestDeliveryTimeline(t)
+	defer timeline.Stop()
+	messageIDs := [][]byte{[]byte("first"), []byte("second")}
+
+	timeline.Record(messageIDs, DeliveryEventEnvelopePosted, testHash, "")
+	timeline.Record(messageIDs, DeliveryEventEnvelopeSent, testHash, "")
+
+	// The writer stores the events without any read flushing them
+	countEvents := func() int {
+		var count int
+		require.NoError(t, timeline.db.QueryRow(`SELECT COUNT(*) FROM message_delivery_timeline`).Scan(&count))
+		return count
+	}
+	require.Eventually(t, func() bool { return countEvents() == 4 }, time.Second, 10*time.Millisecond)
+
+	events, err := timeline.Get(types.HexBytes(messageIDs[1]).String())
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, DeliveryEventEnvelopePosted, events[0].Event)
+	require.Equal(t, DeliveryEventEnvelopeSent, events[1].Event)
+	require.Equal(t, testHash.String(), events[1].EnvelopeHash)
+}
+
+func TestDeliveryTimelineStopWritesPendingEvents(t *testing.T) {
+	timeline := newTestDeliveryTimeline(t)
+
+	timeline.Record(testIDs, DeliveryEventRetry, types.Hash{}, "1")
+	timeline.Stop()
+
+	var count int
+	require.NoError(t, timeline.db.QueryRow(`SELECT COUNT(*) FROM message_delivery_timeline WHERE details = ?`, "1").Scan(&count))
+	require.Equal(t, 1, count)
+}
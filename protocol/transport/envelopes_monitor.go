@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"math"
+	"strconv"
 	"sync"
 	"time"
 
@@ -30,6 +31,8 @@ type EnvelopesMonitorConfig struct {
 	AwaitOnlyMailServerConfirmations bool
 	IsMailserver                     func(types.EnodeID) bool
 	Logger                           *zap.Logger
+	// DeliveryTimeline records the delivery events of the tracked messages, optional
+	DeliveryTimeline *DeliveryTimeline
 }
 
 // EnvelopeEventsHandler used for two different event types.
@@ -60,6 +63,7 @@ func NewEnvelopesMonitor(w types.Waku, config EnvelopesMonitorConfig) *Envelopes
 		awaitOnlyMailServerConfirmations: config.AwaitOnlyMailServerConfirmations,
 		maxAttempts:                      config.MaxAttempts,
 		isMailserver:                     config.IsMailserver,
+		timeline:                         config.DeliveryTimeline,
 		logger:                           logger.With(zap.Namespace("EnvelopesMonitor")),
 
 		// key is envelope hash (event.Hash)
@@ -101,6 +105,7 @@ type EnvelopesMonitor struct {
 	wg           sync.WaitGroup
 	quit         chan struct{}
 	isMailserver func(peer types.EnodeID) bool
+	timeline     *DeliveryTimeline
 
 	logger *zap.Logger
 }
@@ -139,7 +144,10 @@ func (m *EnvelopesMonitor) Add(messageIDs [][]byte, envelopeHashes []types.Hash,
 		m.messageEnvelopeHashes[types.HexBytes(messageID).String()] = envelopeHashes
 	}
 
+	m.timeline.Record(messageIDs, DeliveryEventSegmentsCreated, types.Hash{}, strconv.Itoa(len(envelopeHashes)))
+
 	for i, envelopeHash := range envelopeHashes {
+		m.timeline.Record(messageIDs, DeliveryEventEnvelopePosted, envelopeHash, "")
 		if _, ok := m.envelopes[envelopeHash]; !ok {
 			m.envelopes[envelopeHash] = &monitoredEnvelope{
 				envelopeHashID:  envelopeHash,
@@ -192,6 +200,8 @@ func (m *EnvelopesMonitor) handleEvent(event types.EnvelopeEvent) {
 		types.EventEnvelopeExpired:   m.handleEventEnvelopeExpired,
 		types.EventBatchAcknowledged: m.handleAcknowledgedBatch,
 		types.EventEnvelopeReceived:  m.handleEventEnvelopeReceived,
+		types.EventEnvelopePublished: m.handleEventEnvelopePublished,
+		types.EventEnvelopeStored:    m.handleEventEnvelopeStored,
 	}
 	if handler, ok := handlers[event.Event]; ok {
 		handler(event)
@@ -234,10 +244,34 @@ func (m *EnvelopesMonitor) handleEventEnvelopeSent(event types.EnvelopeEvent) {
 	} else {
 		m.logger.Debug("confirmation not expected, marking as sent")
 		envelope.state = EnvelopeSent
+		m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopeSent, event.Hash, "")
 		m.processMessageIDs(envelope.messageIDs)
 	}
 }
 
+func (m *EnvelopesMonitor) handleEventEnvelopePublished(event types.EnvelopeEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	envelope, ok := m.envelopes[event.Hash]
+	if !ok {
+		return
+	}
+	publishMethod, _ := event.Data.(string)
+	m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopePublished, event.Hash, publishMethod)
+}
+
+func (m *EnvelopesMonitor) handleEventEnvelopeStored(event types.EnvelopeEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	envelope, ok := m.envelopes[event.Hash]
+	if !ok {
+		return
+	}
+	m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopeStored, event.Hash, "")
+}
+
 func (m *EnvelopesMonitor) handleAcknowledgedBatch(event types.EnvelopeEvent) {
 
 	if m.awaitOnlyMailServerConfirmations && !m.isMailserver(event.Peer) {
@@ -281,6 +315,7 @@ func (m *EnvelopesMonitor) handleAcknowledgedBatch(event types.EnvelopeEvent) {
 			continue
 		}
 		envelope.state = EnvelopeSent
+		m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopeAcknowledged, hash, event.Peer.String())
 		m.processMessageIDs(envelope.messageIDs)
 	}
 	delete(m.batches, event.Batch)
@@ -300,11 +335,17 @@ func (m *EnvelopesMonitor) handleEnvelopeFailure(hash types.Hash, err error) {
 		if envelope.state == EnvelopeSent {
 			return
 		}
+		var details string
+		if err != nil {
+			details = err.Error()
+		}
+		m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopeExpired, hash, details)
 		if envelope.attempts < m.maxAttempts {
 			m.retryQueue = append(m.retryQueue, envelope)
 		} else {
 			m.logger.Debug("envelope expired", zap.String("hash", hash.String()))
 			m.removeFromRetryQueue(hash)
+			m.timeline.Record(envelope.messageIDs, DeliveryEventMessageExpired, types.Hash{}, details)
 			if m.handler != nil {
 				m.handler.EnvelopeExpired(envelope.messageIDs, err)
 			}
@@ -353,12 +394,14 @@ func (m *EnvelopesMonitor) retryOnce() {
 			hex, err := m.api.Post(context.TODO(), *envelope.message)
 			if err != nil {
 				m.logger.Error("failed to retry sending message", zap.String("hash", envelope.envelopeHashID.String()), zap.Int("attempt", envelope.attempts+1), zap.Error(err))
+				m.timeline.Record(envelope.messageIDs, DeliveryEventMessageExpired, types.Hash{}, err.Error())
 				if m.handler != nil {
 					m.handler.EnvelopeExpired(envelope.messageIDs, err)
 				}
 			} else {
 				m.removeFromRetryQueue(envelope.envelopeHashID)
 				envelope.envelopeHashID = types.BytesToHash(hex)
+				m.timeline.Record(envelope.messageIDs, DeliveryEventRetry, envelope.envelopeHashID, strconv.Itoa(envelope.attempts+1))
 			}
 			envelope.state = EnvelopePosted
 			envelope.attempts++
@@ -391,6 +434,7 @@ func (m *EnvelopesMonitor) handleEventEnvelopeReceived(event types.EnvelopeEvent
 	}
 	m.logger.Debug("expected envelope received", zap.String("hash", event.Hash.String()), zap.String("peer", event.Peer.String()))
 	envelope.state = EnvelopeSent
+	m.timeline.Record(envelope.messageIDs, DeliveryEventEnvelopeSent, event.Hash, event.Peer.String())
 	m.processMessageIDs(envelope.messageIDs)
 }
 
@@ -417,6 +461,10 @@ func (m *EnvelopesMonitor) processMessageIDs(messageIDs [][]byte) {
 		}
 	}
 
+	if len(sentMessageIDs) > 0 {
+		m.timeline.Record(sentMessageIDs, DeliveryEventMessageSent, types.Hash{}, "")
+	}
+
 	if len(sentMessageIDs) > 0 && m.handler != nil {
 		m.handler.EnvelopeSent(sentMessageIDs)
 	}
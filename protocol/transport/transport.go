@@ -77,6 +77,7 @@ type Transport struct {
 	filters     *FiltersManager
 	logger      *zap.Logger
 	cache       *ProcessedMessageIDsCache
+	timeline    *DeliveryTimeline
 
 	mailservers      []string
 	envelopesMonitor *EnvelopesMonitor
@@ -103,9 +104,15 @@ func NewTransport(
 		return nil, err
 	}
 
+	timeline := NewDeliveryTimeline(db, logger)
+
 	var envelopesMonitor *EnvelopesMonitor
 	if envelopesMonitorConfig != nil {
-		envelopesMonitor = NewEnvelopesMonitor(waku, *envelopesMonitorConfig)
+		config := *envelopesMonitorConfig
+		if config.DeliveryTimeline == nil {
+			config.DeliveryTimeline = timeline
+		}
+		envelopesMonitor = NewEnvelopesMonitor(waku, config)
 		envelopesMonitor.Start()
 	}
 
@@ -117,6 +124,7 @@ func NewTransport(
 		waku:             waku,
 		api:              api,
 		cache:            NewProcessedMessageIDsCache(db),
+		timeline:         timeline,
 		envelopesMonitor: envelopesMonitor,
 		quit:             make(chan struct{}),
 		keysManager: &transportKeysManager{
@@ -423,6 +431,7 @@ func (t *Transport) Stop() error {
 	if t.envelopesMonitor != nil {
 		t.envelopesMonitor.Stop()
 	}
+	t.timeline.Stop()
 	return nil
 }
 
@@ -619,6 +628,16 @@ func (t *Transport) CleanMessagesProcessed(timestamp uint64) error {
 	return t.cache.Clean(timestamp)
 }
 
+// DeliveryTimeline returns the delivery events recorded for the message
+func (t *Transport) DeliveryTimeline(messageID string) ([]*DeliveryEvent, error) {
+	return t.timeline.Get(messageID)
+}
+
+// CleanDeliveryTimeline removes the delivery events older than timestamp
+func (t *Transport) CleanDeliveryTimeline(timestamp uint64) error {
+	return t.timeline.Clean(timestamp)
+}
+
 func (t *Transport) SetEnvelopeEventsHandler(handler EnvelopeEventsHandler) error {
 	if t.envelopesMonitor == nil {
 		return errors.New("Current transport has no envelopes monitor")
@@ -729,6 +748,11 @@ func (t *Transport) RemovePubsubTopicKey(topic string) error {
 }
 
 func (t *Transport) ConfirmMessageDelivered(messageID string) {
+	err := t.timeline.Add(&DeliveryEvent{MessageID: messageID, Event: DeliveryEventDatasyncAck})
+	if err != nil {
+		t.logger.Warn("failed to record delivery event", zap.Error(err))
+	}
+
 	if t.envelopesMonitor == nil {
 		return
 	}
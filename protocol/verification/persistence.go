@@ -2,6 +2,7 @@ package verification
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 )
@@ -275,3 +276,111 @@ func (p *Persistence) GetAllTrustStatus() (map[string]TrustStatus, error) {
 
 	return result, nil
 }
+
+// SafetyNumberVerification is the verification of a contact's safety number,
+// Fingerprint is the fingerprint of the contact at the time it was verified,
+// derived from InstallationKeys
+type SafetyNumberVerification struct {
+	ContactID        string
+	Fingerprint      []byte
+	InstallationKeys [][]byte
+	Verified         bool
+	Changed          bool
+	Clock            uint64
+}
+
+func (p *Persistence) GetSafetyNumberVerification(contactID string) (*SafetyNumberVerification, error) {
+	v := &SafetyNumberVerification{ContactID: contactID}
+	var installationKeys []byte
+	err := p.db.QueryRow(`SELECT fingerprint, installation_keys, verified, changed, clock FROM safety_number_verifications WHERE contact_id = ?`, contactID).Scan(
+		&v.Fingerprint,
+		&installationKeys,
+		&v.Verified,
+		&v.Changed,
+		&v.Clock,
+	)
+
+	switch err {
+	case sql.ErrNoRows:
+		return nil, nil
+	case nil:
+		return v, unmarshalInstallationKeys(installationKeys, v)
+	default:
+		return nil, err
+	}
+}
+
+func (p *Persistence) GetSafetyNumberVerifications() ([]*SafetyNumberVerification, error) {
+	rows, err := p.db.Query(`SELECT contact_id, fingerprint, installation_keys, verified, changed, clock FROM safety_number_verifications`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []*SafetyNumberVerification
+	for rows.Next() {
+		v := &SafetyNumberVerification{}
+		var installationKeys []byte
+		err = rows.Scan(&v.ContactID, &v.Fingerprint, &installationKeys, &v.Verified, &v.Changed, &v.Clock)
+		if err != nil {
+			return nil, err
+		}
+		err = unmarshalInstallationKeys(installationKeys, v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+func unmarshalInstallationKeys(data []byte, v *SafetyNumberVerification) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, &v.InstallationKeys)
+}
+
+// UpsertSafetyNumberVerification saves the verification if it's newer than the stored one
+func (p *Persistence) UpsertSafetyNumberVerification(v *SafetyNumberVerification) (updated bool, err error) {
+	var clock uint64
+	err = p.db.QueryRow(`SELECT clock FROM safety_number_verifications WHERE contact_id = ?`, v.ContactID).Scan(&clock)
+	if err != nil && err != sql.ErrNoRows {
+		return false, err
+	}
+
+	if err == nil && v.Clock <= clock {
+		return false, nil
+	}
+
+	var installationKeys []byte
+	if len(v.InstallationKeys) > 0 {
+		installationKeys, err = json.Marshal(v.InstallationKeys)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	_, err = p.db.Exec(`INSERT INTO safety_number_verifications (contact_id, fingerprint, installation_keys, verified, changed, clock) VALUES (?, ?, ?, ?, ?, ?)`, v.ContactID, v.Fingerprint, installationKeys, v.Verified, v.Changed, v.Clock)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SetSafetyNumberChanged flags a verified safety number as changed,
+// it returns true if it wasn't flagged already
+func (p *Persistence) SetSafetyNumberChanged(contactID string) (bool, error) {
+	result, err := p.db.Exec(`UPDATE safety_number_verifications SET changed = 1 WHERE contact_id = ? AND verified AND NOT changed`, contactID)
+	if err != nil {
+		return false, err
+	}
+
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return numRows > 0, nil
+}
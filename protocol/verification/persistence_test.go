@@ -147,3 +147,57 @@ func (s *PersistenceSuite) TestTrustStatus() {
 	s.NoError(err)
 	s.Equal(TrustStatusUNKNOWN, trustStatus)
 }
+
+func (s *PersistenceSuite) TestSafetyNumberVerification() {
+	v, err := s.db.GetSafetyNumberVerification("0x01")
+	s.NoError(err)
+	s.Nil(v)
+
+	// A safety number which isn't verified can't change
+	changed, err := s.db.SetSafetyNumberChanged("0x01")
+	s.NoError(err)
+	s.False(changed)
+
+	verified := &SafetyNumberVerification{
+		ContactID:        "0x01",
+		Fingerprint:      []byte{0x01, 0x02},
+		InstallationKeys: [][]byte{{0x04}, {0x05}},
+		Verified:         true,
+		Clock:            1000,
+	}
+	updated, err := s.db.UpsertSafetyNumberVerification(verified)
+	s.NoError(err)
+	s.True(updated)
+
+	v, err = s.db.GetSafetyNumberVerification("0x01")
+	s.NoError(err)
+	s.Equal(verified, v)
+
+	// Older verifications are ignored
+	updated, err = s.db.UpsertSafetyNumberVerification(&SafetyNumberVerification{ContactID: "0x01", Fingerprint: []byte{0x03}, Clock: 500})
+	s.NoError(err)
+	s.False(updated)
+
+	changed, err = s.db.SetSafetyNumberChanged("0x01")
+	s.NoError(err)
+	s.True(changed)
+
+	changed, err = s.db.SetSafetyNumberChanged("0x01")
+	s.NoError(err)
+	s.False(changed)
+
+	v, err = s.db.GetSafetyNumberVerification("0x01")
+	s.NoError(err)
+	s.True(v.Changed)
+
+	// Verifying it again resets the change
+	verified.Clock = 1500
+	updated, err = s.db.UpsertSafetyNumberVerification(verified)
+	s.NoError(err)
+	s.True(updated)
+
+	verifications, err := s.db.GetSafetyNumberVerifications()
+	s.NoError(err)
+	s.Require().Len(verifications, 1)
+	s.Equal(verified, verifications[0])
+}
@@ -0,0 +1,133 @@
+package verification
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	safetyNumberVersion = 0
+	// safetyNumberIterations is the number of times the fingerprint is hashed,
+	// to make it expensive to find keys with a matching safety number
+	safetyNumberIterations = 5200
+	// safetyNumberFingerprintLength is the number of bytes of the fingerprint used for the safety number
+	safetyNumberFingerprintLength = 30
+	// safetyNumberChunkLength is the number of bytes converted into a group of 5 digits
+	safetyNumberChunkLength = 5
+)
+
+var (
+	ErrSafetyNumberMismatch      = errors.New("safety number mismatch")
+	ErrInvalidSafetyNumberQRCode = errors.New("invalid safety number qr code")
+)
+
+// SafetyNumber allows two users to check, either by comparing the numeric form or
+// by scanning the QR code, that they see the same identity and installation keys
+type SafetyNumber struct {
+	// Numeric is made of 60 digits, the same on both sides
+	Numeric string `json:"numeric"`
+	// QRCode is the payload of the QR code to be scanned by the contact
+	QRCode string `json:"qrCode"`
+	// Verified is true if the safety number has been verified for the current installations of the contact
+	Verified bool `json:"verified"`
+	// Changed is true if the installations of the contact changed since the safety number was verified
+	Changed bool `json:"changed"`
+}
+
+// Fingerprint derives the fingerprint of an identity key, in its compressed form,
+// and of the keys of the installations it uses
+func Fingerprint(identity []byte, installationKeys [][]byte) []byte {
+	sortedKeys := make([][]byte, len(installationKeys))
+	copy(sortedKeys, installationKeys)
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		return bytes.Compare(sortedKeys[i], sortedKeys[j]) < 0
+	})
+
+	payload := bytes.NewBuffer(nil)
+	_ = binary.Write(payload, binary.BigEndian, uint16(safetyNumberVersion))
+	payload.Write(identity)
+	for _, key := range sortedKeys {
+		payload.Write(key)
+	}
+
+	hash := sha512.Sum512(payload.Bytes())
+	for i := 0; i < safetyNumberIterations; i++ {
+		hash = sha512.Sum512(append(hash[:], identity...))
+	}
+
+	return hash[:safetyNumberFingerprintLength]
+}
+
+// fingerprintDigits converts a fingerprint into 30 digits
+func fingerprintDigits(fingerprint []byte) string {
+	var digits strings.Builder
+	for i := 0; i+safetyNumberChunkLength <= len(fingerprint); i += safetyNumberChunkLength {
+		var chunk uint64
+		for _, b := range fingerprint[i : i+safetyNumberChunkLength] {
+			chunk = chunk<<8 | uint64(b)
+		}
+		digits.WriteString(fmt.Sprintf("%05d", chunk%100000))
+	}
+	return digits.String()
+}
+
+// NewSafetyNumber builds the safety number from our fingerprint and the one of the contact
+func NewSafetyNumber(ourFingerprint []byte, theirFingerprint []byte) *SafetyNumber {
+	ourDigits := fingerprintDigits(ourFingerprint)
+	theirDigits := fingerprintDigits(theirFingerprint)
+
+	// Both sides must display the same number, the lowest fingerprint goes first
+	numeric := ourDigits + theirDigits
+	if theirDigits < ourDigits {
+		numeric = theirDigits + ourDigits
+	}
+
+	return &SafetyNumber{
+		Numeric: numeric,
+		QRCode:  fmt.Sprintf("%d:%s:%s", safetyNumberVersion, hex.EncodeToString(ourFingerprint), hex.EncodeToString(theirFingerprint)),
+	}
+}
+
+// MatchNumeric compares the safety number with the one given by the user, ignoring whitespaces
+func (s *SafetyNumber) MatchNumeric(numeric string) error {
+	if strings.Join(strings.Fields(numeric), "") != s.Numeric {
+		return ErrSafetyNumberMismatch
+	}
+	return nil
+}
+
+// VerifySafetyNumberQRCode checks the QR code scanned from the contact's device,
+// which contains their fingerprint followed by ours
+func VerifySafetyNumberQRCode(ourFingerprint []byte, theirFingerprint []byte, qrCode string) error {
+	parts := strings.Split(qrCode, ":")
+	if len(parts) != 3 {
+		return ErrInvalidSafetyNumberQRCode
+	}
+
+	version, err := strconv.Atoi(parts[0])
+	if err != nil || version != safetyNumberVersion {
+		return ErrInvalidSafetyNumberQRCode
+	}
+
+	scannedTheirs, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidSafetyNumberQRCode
+	}
+	scannedOurs, err := hex.DecodeString(parts[2])
+	if err != nil {
+		return ErrInvalidSafetyNumberQRCode
+	}
+
+	if !bytes.Equal(scannedTheirs, theirFingerprint) || !bytes.Equal(scannedOurs, ourFingerprint) {
+		return ErrSafetyNumberMismatch
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package verification
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/status-im/status-go/eth-node/crypto"
+)
+
+func TestSafetyNumber(t *testing.T) {
+	aliceKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	bobKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	installationKey := func() []byte {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return crypto.CompressPubkey(&key.PublicKey)
+	}
+	alice1, alice2, bob1 := installationKey(), installationKey(), installationKey()
+
+	alice := Fingerprint(crypto.CompressPubkey(&aliceKey.PublicKey), [][]byte{alice1, alice2})
+	bob := Fingerprint(crypto.CompressPubkey(&bobKey.PublicKey), [][]byte{bob1})
+	require.Len(t, alice, safetyNumberFingerprintLength)
+
+	// The order of the installations doesn't matter
+	require.Equal(t, alice, Fingerprint(crypto.CompressPubkey(&aliceKey.PublicKey), [][]byte{alice2, alice1}))
+	// A new installation changes the fingerprint
+	require.NotEqual(t, bob, Fingerprint(crypto.CompressPubkey(&bobKey.PublicKey), [][]byte{bob1, installationKey()}))
+	// A new key for an installation changes the fingerprint
+	require.NotEqual(t, bob, Fingerprint(crypto.CompressPubkey(&bobKey.PublicKey), [][]byte{installationKey()}))
+
+	aliceSafetyNumber := NewSafetyNumber(alice, bob)
+	bobSafetyNumber := NewSafetyNumber(bob, alice)
+
+	require.Len(t, aliceSafetyNumber.Numeric, 60)
+	require.Equal(t, aliceSafetyNumber.Numeric, bobSafetyNumber.Numeric)
+	require.NotEqual(t, aliceSafetyNumber.QRCode, bobSafetyNumber.QRCode)
+
+	spaced := ""
+	for i := 0; i < len(bobSafetyNumber.Numeric); i += 5 {
+		spaced += fmt.Sprintf("%s ", bobSafetyNumber.Numeric[i:i+5])
+	}
+	require.NoError(t, aliceSafetyNumber.MatchNumeric(spaced))
+	require.ErrorIs(t, aliceSafetyNumber.MatchNumeric("12345"), ErrSafetyNumberMismatch)
+
+	// Alice scans Bob's QR code
+	require.NoError(t, VerifySafetyNumberQRCode(alice, bob, bobSafetyNumber.QRCode))
+	require.ErrorIs(t, VerifySafetyNumberQRCode(alice, bob, aliceSafetyNumber.QRCode), ErrSafetyNumberMismatch)
+	require.ErrorIs(t, VerifySafetyNumberQRCode(alice, bob, "invalid"), ErrInvalidSafetyNumberQRCode)
+	require.ErrorIs(t, VerifySafetyNumberQRCode(alice, bob, "1:00:00"), ErrInvalidSafetyNumberQRCode)
+}
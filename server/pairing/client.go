@@ -1,11 +1,11 @@
 package pairing
 
 import (
-	"bytes"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
 	"net"
@@ -32,12 +32,20 @@ import (
 |
 */
 
+// ErrNoReachableAddresses is returned when none of the addresses of the connection string can be reached,
+// e.g. when the devices aren't on the same network
+var ErrNoReachableAddresses = errors.New("no reachable addresses found")
+
 // BaseClient is responsible for lower level pairing.Client functionality common to dependent Client types
 type BaseClient struct {
 	*http.Client
 	serverCert     *x509.Certificate
 	baseAddress    *url.URL
 	challengeTaker *ChallengeTaker
+	// chunkedTransfer is set when the server accepts payloads in chunks
+	chunkedTransfer bool
+	// session is the transfer session of the pairing run by the client
+	session *TransferSession
 }
 
 func findServerCert(c *ConnectionParams, reachableIPs []net.IP) (*url.URL, *x509.Certificate, error) {
@@ -103,8 +111,8 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 	// if client and server aren't on the same network, netIPs maybe empty, we should check it before invoking findServerCert
 	if len(netIPs) == 0 {
 		logger.Error("[local pair client] no reachable addresses found")
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: "no reachable addresses found", Action: ActionConnect})
-		return nil, fmt.Errorf("no reachable addresses found")
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: ErrNoReachableAddresses.Error(), Action: ActionConnect})
+		return nil, ErrNoReachableAddresses
 	}
 
 	maxRetries := 3
@@ -119,7 +127,7 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 	}
 
 	if serverCert == nil {
-		certErrs = fmt.Errorf("failed to connect to any of given addresses. %w", certErrs)
+		certErrs = fmt.Errorf("%w: %w", ErrNoReachableAddresses, certErrs)
 		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: certErrs.Error(), Action: ActionConnect})
 		return nil, certErrs
 	}
@@ -161,6 +169,7 @@ func NewBaseClient(c *ConnectionParams, logger *zap.Logger) (*BaseClient, error)
 		serverCert:     serverCert,
 		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(c.aesKey)),
 		baseAddress:    baseAddress,
+		session:        startTransferSession(),
 	}, nil
 }
 
@@ -172,10 +181,13 @@ func (c *BaseClient) getChallenge() error {
 		return err
 	}
 
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("[client] status not ok when getting challenge, received '%s'", resp.Status)
 	}
 
+	c.chunkedTransfer = resp.Header.Get(headerChunkedTransfer) != ""
 	return c.challengeTaker.SetChallenge(resp)
 }
 
@@ -225,19 +237,12 @@ func (c *SenderClient) sendAccountData() error {
 		return err
 	}
 
-	c.baseAddress.Path = pairingReceiveAccount
-	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.accountMounter.ToSend()))
+	err = c.uploadPayload(pairingReceiveAccount, c.accountMounter.ToSend(), ActionPairingAccount)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when sending account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
 
 	c.accountMounter.LockPayload()
@@ -250,56 +255,27 @@ func (c *SenderClient) sendSyncDeviceData() error {
 		return err
 	}
 
-	c.baseAddress.Path = pairingReceiveSyncDevice
-	resp, err := c.Post(c.baseAddress.String(), "application/octet-stream", bytes.NewBuffer(c.rawMessageMounter.ToSend()))
+	err = c.uploadPayload(pairingReceiveSyncDevice, c.rawMessageMounter.ToSend(), ActionSyncDevice)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not okay when sending sync device data, status: %s", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
 	return nil
 }
 
 func (c *SenderClient) receiveInstallationData() error {
-	c.baseAddress.Path = pairingSendInstallation
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving installation data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.downloadPayload(pairingSendInstallation, ActionPairingInstallation)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
 		return err
 	}
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingInstallation})
 
-	err = c.installationMounter.Receive(payload)
+	err = c.session.Guard(func() error {
+		return c.installationMounter.Receive(payload)
+	})
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingInstallation})
 		return err
@@ -308,35 +284,50 @@ func (c *SenderClient) receiveInstallationData() error {
 	return nil
 }
 
-// setupSendingClient creates a new SenderClient after parsing string inputs
-func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
+// parseSendingClientInputs parses and validates the string inputs of a sending client
+func parseSendingClientInputs(backend *api.GethStatusBackend, cs, configJSON string) (*ConnectionParams, *SenderClientConfig, error) {
 	ccp := new(ConnectionParams)
 	err := ccp.FromString(cs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf := NewSenderClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	err = validateAndVerifyPassword(conf, conf.SenderConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf.SenderConfig.DB = backend.GetMultiaccountDB()
 
+	return ccp, conf, nil
+}
+
+// setupSendingClient creates a new SenderClient after parsing string inputs
+func setupSendingClient(backend *api.GethStatusBackend, cs, configJSON string) (*SenderClient, error) {
+	ccp, conf, err := parseSendingClientInputs(backend, cs, configJSON)
+	if err != nil {
+		return nil, err
+	}
+
 	return NewSenderClient(backend, ccp, conf)
 }
 
-// StartUpSendingClient creates a SenderClient and triggers all `send` calls in sequence to the ReceiverServer
+// StartUpSendingClient creates a SenderClient and triggers all `send` calls in sequence to the ReceiverServer.
+// If the ReceiverServer can't be reached, the pairing is relayed over Waku when available.
 func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
 	c, err := setupSendingClient(backend, cs, configJSON)
+	if errors.Is(err, ErrNoReachableAddresses) && pairingWaku(backend) != nil {
+		return StartUpWakuSendingClient(backend, cs, configJSON)
+	}
 	if err != nil {
 		return err
 	}
+	defer c.session.end()
 	err = c.sendAccountData()
 	if err != nil {
 		return err
@@ -345,10 +336,6 @@ func StartUpSendingClient(backend *api.GethStatusBackend, cs, configJSON string)
 	if err != nil {
 		return err
 	}
-	err = c.getChallenge()
-	if err != nil {
-		return err
-	}
 	return c.receiveInstallationData()
 }
 
@@ -381,6 +368,7 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 
 	pe := NewPayloadEncryptor(c.aesKey)
 
+	config.ReceiverConfig.session = bc.session
 	ar, rmr, imr, err := NewPayloadReceivers(logger, pe, backend, config.ReceiverConfig)
 	if err != nil {
 		return nil, err
@@ -395,38 +383,16 @@ func NewReceiverClient(backend *api.GethStatusBackend, c *ConnectionParams, conf
 }
 
 func (c *ReceiverClient) receiveAccountData() error {
-	c.baseAddress.Path = pairingSendAccount
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving account data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.downloadPayload(pairingSendAccount, ActionPairingAccount)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
 	}
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
 
-	err = c.accountReceiver.Receive(payload)
+	err = c.session.Guard(func() error {
+		return c.accountReceiver.Receive(payload)
+	})
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingAccount})
 		return err
@@ -436,38 +402,16 @@ func (c *ReceiverClient) receiveAccountData() error {
 }
 
 func (c *ReceiverClient) receiveSyncDeviceData() error {
-	c.baseAddress.Path = pairingSendSyncDevice
-	req, err := http.NewRequest(http.MethodGet, c.baseAddress.String(), nil)
-	if err != nil {
-		return err
-	}
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	resp, err := c.Do(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not ok when receiving sync device data, received '%s'", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-		return err
-	}
-
-	payload, err := io.ReadAll(resp.Body)
+	payload, err := c.downloadPayload(pairingSendSyncDevice, ActionSyncDevice)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
 		return err
 	}
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
 
-	err = c.rawMessageReceiver.Receive(payload)
+	err = c.session.Guard(func() error {
+		return c.rawMessageReceiver.Receive(payload)
+	})
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionSyncDevice})
 		return err
@@ -482,47 +426,28 @@ func (c *ReceiverClient) sendInstallationData() error {
 		return err
 	}
 
-	c.baseAddress.Path = pairingReceiveInstallation
-	req, err := http.NewRequest(http.MethodPost, c.baseAddress.String(), bytes.NewBuffer(c.installationReceiver.ToSend()))
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Content-Type", "application/octet-stream")
-
-	err = c.challengeTaker.DoChallenge(req)
-	if err != nil {
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
-	resp, err := c.Do(req)
+	err = c.uploadPayload(pairingReceiveInstallation, c.installationReceiver.ToSend(), ActionPairingInstallation)
 	if err != nil {
 		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
 		return err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err = fmt.Errorf("[client] status not okay when sending installation data, status: %s", resp.Status)
-		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-		return err
-	}
-
 	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingInstallation})
 	return nil
 }
 
-// setupReceivingClient creates a new ReceiverClient after parsing string inputs
-func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) (*ReceiverClient, error) {
+// parseReceivingClientInputs parses and validates the string inputs of a receiving client
+func parseReceivingClientInputs(backend *api.GethStatusBackend, cs, configJSON string) (*ConnectionParams, *ReceiverClientConfig, error) {
 	ccp := new(ConnectionParams)
 	err := ccp.FromString(cs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	conf := NewReceiverClientConfig()
 	err = json.Unmarshal([]byte(configJSON), conf)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// This is a temporal solution to allow clients not to pass DeviceType.
@@ -531,7 +456,7 @@ func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string)
 
 	err = validateReceiverConfig(conf, conf.ReceiverConfig)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// ignore err because we allow no active account here
@@ -542,26 +467,32 @@ func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string)
 
 	conf.ReceiverConfig.DB = backend.GetMultiaccountDB()
 
-	return NewReceiverClient(backend, ccp, conf)
+	return ccp, conf, nil
 }
 
-// StartUpReceivingClient creates a ReceiverClient and triggers all `receive` calls in sequence to the SenderServer
-func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
-	c, err := setupReceivingClient(backend, cs, configJSON)
+// setupReceivingClient creates a new ReceiverClient after parsing string inputs
+func setupReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) (*ReceiverClient, error) {
+	ccp, conf, err := parseReceivingClientInputs(backend, cs, configJSON)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = c.getChallenge()
-	if err != nil {
-		return err
+	return NewReceiverClient(backend, ccp, conf)
+}
+
+// StartUpReceivingClient creates a ReceiverClient and triggers all `receive` calls in sequence to the SenderServer.
+// If the SenderServer can't be reached, the pairing is relayed over Waku when available.
+func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
+	c, err := setupReceivingClient(backend, cs, configJSON)
+	if errors.Is(err, ErrNoReachableAddresses) && pairingWaku(backend) != nil {
+		return StartUpWakuReceivingClient(backend, cs, configJSON)
 	}
-	err = c.receiveAccountData()
 	if err != nil {
 		return err
 	}
+	defer c.session.end()
 
-	err = c.getChallenge()
+	err = c.receiveAccountData()
 	if err != nil {
 		return err
 	}
@@ -569,11 +500,6 @@ func StartUpReceivingClient(backend *api.GethStatusBackend, cs, configJSON strin
 	if err != nil {
 		return err
 	}
-
-	err = c.getChallenge()
-	if err != nil {
-		return err
-	}
 	return c.sendInstallationData()
 }
 
@@ -676,6 +602,7 @@ func StartUpKeystoreFilesReceivingClient(backend *api.GethStatusBackend, cs, con
 	if err != nil {
 		return err
 	}
+	defer c.session.end()
 
 	err = c.getChallenge()
 	if err != nil {
@@ -33,6 +33,17 @@ type ReceiverConfig struct {
 
 	DB             *multiaccounts.Database `json:"-"`
 	LoggedInKeyUID string                  `json:"-"`
+
+	// session is the transfer session of the pairing, the received account is rolled back if it's cancelled
+	session *TransferSession
+}
+
+// transferSession returns the session of the pairing, or a new one if the receiver isn't part of a pairing
+func (c *ReceiverConfig) transferSession() *TransferSession {
+	if c.session == nil {
+		c.session = newTransferSession()
+	}
+	return c.session
 }
 
 type KeystoreFilesConfig struct {
@@ -13,7 +13,10 @@ const (
 	EventConnectionSuccess    EventType = "connection-success"
 	EventTransferError        EventType = "transfer-error"
 	EventTransferSuccess      EventType = "transfer-success"
+	EventTransferProgress     EventType = "transfer-progress"
+	EventTransferCancelled    EventType = "transfer-cancelled"
 	EventReceivedInstallation EventType = "received-installation"
+	EventShortCodeSAS         EventType = "short-code-sas"
 
 	// Only Receiver side
 
@@ -40,6 +43,7 @@ const (
 	ActionPairingInstallation
 	ActionPeerDiscovery
 	ActionKeystoreFilesTransfer
+	ActionShortCodePairing
 )
 
 type AccountData struct {
@@ -47,3 +51,11 @@ type AccountData struct {
 	Password string                 `json:"password,omitempty"`
 	ChatKey  string                 `json:"chatKey,omitempty"`
 }
+
+// TransferProgress is the Data of EventTransferProgress events
+type TransferProgress struct {
+	BytesTransferred int `json:"bytesTransferred"`
+	BytesTotal       int `json:"bytesTotal"`
+	ItemsProcessed   int `json:"itemsProcessed,omitempty"`
+	ItemsTotal       int `json:"itemsTotal,omitempty"`
+}
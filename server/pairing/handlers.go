@@ -1,12 +1,9 @@
 package pairing
 
 import (
-	"io"
 	"net/http"
 
 	"go.uber.org/zap"
-
-	"github.com/status-im/status-go/signal"
 )
 
 const (
@@ -23,161 +20,62 @@ const (
 
 // Account handling
 
-func handleReceiveAccount(logger *zap.Logger, pr PayloadReceiver) http.HandlerFunc {
+func handleReceiveAccount(logger *zap.Logger, session *TransferSession, pr PayloadReceiver) http.HandlerFunc {
+	buffer := new(chunkedPayloadBuffer)
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingAccount})
-		payload, err := io.ReadAll(r.Body)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleReceiveAccount io.ReadAll(r.Body)", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
-		err = pr.Receive(payload)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleReceiveAccount pr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionPairingAccount})
+		receivePayload(w, r, logger.Named("handleReceiveAccount"), session, buffer, pr, ActionPairingAccount)
 	}
 }
 
-func handleSendAccount(logger *zap.Logger, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
+func handleSendAccount(logger *zap.Logger, session *TransferSession, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingAccount})
-		w.Header().Set("Content-Type", "application/octet-stream")
-		err := pm.Mount()
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		beforeSending()
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingAccount})
-			logger.Error("handleSendAccount w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingAccount})
-
-		pm.LockPayload()
+		servePayload(w, r, logger.Named("handleSendAccount"), session, pm, beforeSending, ActionPairingAccount)
 	}
 }
 
 // Device sync handling
 
-func handleParingSyncDeviceReceive(logger *zap.Logger, pr PayloadReceiver) http.HandlerFunc {
+func handleParingSyncDeviceReceive(logger *zap.Logger, session *TransferSession, pr PayloadReceiver) http.HandlerFunc {
+	buffer := new(chunkedPayloadBuffer)
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionSyncDevice})
-		payload, err := io.ReadAll(r.Body)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handleParingSyncDeviceReceive io.ReadAll(r.Body)", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-
-		err = pr.Receive(payload)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handleParingSyncDeviceReceive pr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionSyncDevice})
+		receivePayload(w, r, logger.Named("handleParingSyncDeviceReceive"), session, buffer, pr, ActionSyncDevice)
 	}
 }
 
-func handlePairingSyncDeviceSend(logger *zap.Logger, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
+func handlePairingSyncDeviceSend(logger *zap.Logger, session *TransferSession, pm PayloadMounter, beforeSending func()) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionSyncDevice})
-		w.Header().Set("Content-Type", "application/octet-stream")
-
-		err := pm.Mount()
-		if err != nil {
-			// maybe better to use a new event type here instead of EventTransferError?
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend pm.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-
-		beforeSending()
-		_, err = w.Write(pm.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionSyncDevice})
-			logger.Error("handlePairingSyncDeviceSend w.Write(pm.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionSyncDevice})
-
-		pm.LockPayload()
+		servePayload(w, r, logger.Named("handlePairingSyncDeviceSend"), session, pm, beforeSending, ActionSyncDevice)
 	}
 }
 
 // Installation data handling
 
-func handleReceiveInstallation(logger *zap.Logger, pmr PayloadMounterReceiver) http.HandlerFunc {
+func handleReceiveInstallation(logger *zap.Logger, session *TransferSession, pmr PayloadMounterReceiver) http.HandlerFunc {
+	buffer := new(chunkedPayloadBuffer)
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingInstallation})
-		payload, err := io.ReadAll(r.Body)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleReceiveInstallation io.ReadAll(r.Body)", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingInstallation})
-
-		err = pmr.Receive(payload)
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleReceiveInstallation pmr.Receive(payload)", zap.Error(err), zap.Binary("payload", payload))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
-		signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: ActionPairingInstallation})
+		receivePayload(w, r, logger.Named("handleReceiveInstallation"), session, buffer, pmr, ActionPairingInstallation)
 	}
 }
 
-func handleSendInstallation(logger *zap.Logger, pmr PayloadMounterReceiver, beforeSending func()) http.HandlerFunc {
+func handleSendInstallation(logger *zap.Logger, session *TransferSession, pmr PayloadMounterReceiver, beforeSending func()) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionPairingInstallation})
-		w.Header().Set("Content-Type", "application/octet-stream")
-		err := pmr.Mount()
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleSendInstallation pmr.Mount()", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
-			return
-		}
+		servePayload(w, r, logger.Named("handleSendInstallation"), session, pmr, beforeSending, ActionPairingInstallation)
+	}
+}
 
-		beforeSending()
-		_, err = w.Write(pmr.ToSend())
-		if err != nil {
-			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: ActionPairingInstallation})
-			logger.Error("handleSendInstallation w.Write(pmr.ToSend())", zap.Error(err))
-			http.Error(w, "error", http.StatusInternalServerError)
+// Challenge middleware and handling
+
+func middlewareTransport(s *BaseServer, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !s.selectHTTPTransport() {
+			http.Error(w, "pairing happens over waku", http.StatusConflict)
 			return
 		}
-		signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: ActionPairingInstallation})
 
-		pmr.LockPayload()
+		next(w, r)
 	}
 }
 
-// Challenge middleware and handling
-
 func middlewareChallenge(cg *ChallengeGiver, next http.Handler) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		err := cg.checkChallengeResponse(w, r)
@@ -209,6 +107,8 @@ func handlePairingChallenge(cg *ChallengeGiver) http.HandlerFunc {
 		}
 
 		w.Header().Set("Content-Type", "application/octet-stream")
+		// let the clients know that payloads can be transferred in chunks
+		w.Header().Set(headerChunkedTransfer, "true")
 		_, err = w.Write(challenge)
 		if err != nil {
 			cg.logger.Error("failed to Write(challenge) in handlePairingChallenge", zap.Error(err))
@@ -320,6 +320,44 @@ func (pms *PayloadMarshallerSuite) TestPayloadMarshaller_StorePayloads() {
 	pms.Require().ErrorIs(err, ErrKeyFileAlreadyExists)
 }
 
+func (pms *PayloadMarshallerSuite) TestPayloadMarshaller_StorePayloadsRollback() {
+	pp := new(AccountPayload)
+	ppr, err := NewAccountPayloadLoader(pp, pms.config1)
+	pms.Require().NoError(err)
+	err = ppr.Load()
+	pms.Require().NoError(err)
+
+	pb, err := NewPairingPayloadMarshaller(pp, pms.Logger).MarshalProtobuf()
+	pms.Require().NoError(err)
+
+	pp2 := new(AccountPayload)
+	err = NewPairingPayloadMarshaller(pp2, pms.Logger).UnmarshalProtobuf(pb)
+	pms.Require().NoError(err)
+
+	session := startTransferSession()
+	pms.config2.session = session
+	ppr2, err := NewAccountPayloadStorer(pp2, pms.config2)
+	pms.Require().NoError(err)
+	err = ppr2.Store()
+	pms.Require().NoError(err)
+
+	keyStorePath := filepath.Join(pms.config2.AbsoluteKeystorePath(), keyUID)
+	pms.Require().Len(getFiles(pms.T(), keyStorePath), 2)
+
+	// Cancelling the pairing removes the partially received account
+	pms.Require().NoError(CancelPairing())
+
+	_, err = os.Stat(keyStorePath)
+	pms.Require().True(os.IsNotExist(err))
+	acc, err := pms.config2.DB.GetAccount(keyUID)
+	pms.Require().NoError(err)
+	pms.Require().Empty(acc.KeyUID)
+
+	// Storing isn't possible anymore once cancelled
+	err = session.Guard(ppr2.Store)
+	pms.Require().ErrorIs(err, ErrPairingCancelled)
+}
+
 func (pms *PayloadMarshallerSuite) TestPayloadMarshaller_LockPayload() {
 	AESKey := make([]byte, 32)
 	_, err := rand.Read(AESKey)
@@ -115,6 +115,7 @@ type AccountPayloadStorer struct {
 	keystorePath   string
 	kdfIterations  int
 	loggedInKeyUID string
+	session        *TransferSession
 }
 
 func NewAccountPayloadStorer(p *AccountPayload, config *ReceiverConfig) (*AccountPayloadStorer, error) {
@@ -123,6 +124,7 @@ func NewAccountPayloadStorer(p *AccountPayload, config *ReceiverConfig) (*Accoun
 	}
 
 	if config == nil {
+		ppr.session = newTransferSession()
 		return ppr, nil
 	}
 
@@ -133,6 +135,7 @@ func NewAccountPayloadStorer(p *AccountPayload, config *ReceiverConfig) (*Accoun
 
 	ppr.multiaccountsDB = config.DB
 	ppr.loggedInKeyUID = config.LoggedInKeyUID
+	ppr.session = config.transferSession()
 	return ppr, nil
 }
 
@@ -176,6 +179,7 @@ func (aps *AccountPayloadStorer) storeKeys(keyStorePath string) error {
 
 	// If lastDir == keystoreDir we presume we need to create the rest of the keystore path
 	// else we presume the provided keystore is valid
+	createdDir := false
 	if lastDir == api.DefaultKeystoreRelativePath {
 		if aps.multiaccount == nil || aps.multiaccount.KeyUID == "" {
 			return fmt.Errorf("no known Key UID")
@@ -187,6 +191,7 @@ func (aps *AccountPayloadStorer) storeKeys(keyStorePath string) error {
 			if err != nil {
 				return err
 			}
+			createdDir = true
 		} else if err != nil {
 			return err
 		} else {
@@ -194,6 +199,21 @@ func (aps *AccountPayloadStorer) storeKeys(keyStorePath string) error {
 		}
 	}
 
+	// If the pairing is cancelled, the written keys are removed
+	aps.session.AddRollback(func() error {
+		if createdDir {
+			return os.RemoveAll(keyStorePath)
+		}
+		var err error
+		for name := range aps.keys {
+			rmErr := os.Remove(filepath.Join(keyStorePath, name))
+			if rmErr != nil && !os.IsNotExist(rmErr) {
+				err = multierr.Append(err, rmErr)
+			}
+		}
+		return err
+	})
+
 	for name, data := range aps.keys {
 		err := ioutil.WriteFile(filepath.Join(keyStorePath, name), data, 0600)
 		if err != nil {
@@ -213,7 +233,16 @@ func (aps *AccountPayloadStorer) storeKeys(keyStorePath string) error {
 
 func (aps *AccountPayloadStorer) storeMultiAccount() error {
 	aps.multiaccount.KDFIterations = aps.kdfIterations
-	return aps.multiaccountsDB.SaveAccount(*aps.multiaccount)
+	err := aps.multiaccountsDB.SaveAccount(*aps.multiaccount)
+	if err != nil {
+		return err
+	}
+
+	keyUID := aps.multiaccount.KeyUID
+	aps.session.AddRollback(func() error {
+		return aps.multiaccountsDB.DeleteAccount(keyUID)
+	})
+	return nil
 }
 
 /*
@@ -242,6 +271,7 @@ type RawMessageStorer struct {
 	accountPayload        *AccountPayload
 	createAccount         *requests.CreateAccount
 	deviceType            string
+	session               *TransferSession
 }
 
 func NewRawMessageStorer(backend *api.GethStatusBackend, payload *RawMessagesPayload, accountPayload *AccountPayload, config *ReceiverConfig) *RawMessageStorer {
@@ -251,6 +281,7 @@ func NewRawMessageStorer(backend *api.GethStatusBackend, payload *RawMessagesPay
 		accountPayload:        accountPayload,
 		deviceType:            config.DeviceType,
 		createAccount:         config.CreateAccount,
+		session:               config.transferSession(),
 	}
 }
 
@@ -258,7 +289,14 @@ func (r *RawMessageStorer) Store() error {
 	if r.accountPayload == nil || r.accountPayload.multiaccount == nil {
 		return fmt.Errorf("no known multiaccount when storing raw messages")
 	}
-	return r.syncRawMessageHandler.HandleRawMessage(r.accountPayload, r.createAccount, r.deviceType, r.payload)
+	err := r.syncRawMessageHandler.HandleRawMessage(r.accountPayload, r.createAccount, r.deviceType, r.payload)
+	if err != nil {
+		return err
+	}
+
+	// the account is fully received, it's not rolled back anymore if the pairing is cancelled
+	r.session.Complete()
+	return nil
 }
 
 /*
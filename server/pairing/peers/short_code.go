@@ -0,0 +1,81 @@
+package peers
+
+import (
+	"bytes"
+	"errors"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	udpp2p "github.com/schollz/peerdiscovery"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+var ErrShortCodePeerNotFound = errors.New("no device found for the short code")
+
+// AnnounceShortCode broadcasts the port of the short code handshake listener with the given rendezvous,
+// until stop receives or the search limit is reached
+func AnnounceShortCode(rendezvous []byte, port int, stop chan struct{}, logger *zap.Logger) error {
+	payload, err := proto.Marshal(&protobuf.LocalPairingShortCodeHello{
+		Rendezvous: rendezvous,
+		Port:       uint32(port),
+	})
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		_, err := udpp2p.Discover(udpp2p.Settings{
+			Payload:   payload,
+			Delay:     pingInterval,
+			TimeLimit: searchLimit,
+			StopChan:  stop,
+		})
+		if err != nil {
+			logger.Error("error while announcing short code", zap.Error(err))
+		}
+	}()
+	return nil
+}
+
+// FindShortCode listens for the announcement of the given rendezvous and returns
+// the address of the short code handshake listener
+func FindShortCode(rendezvous []byte, timeout time.Duration) (string, error) {
+	found := make(chan string, 1)
+	stop := make(chan struct{}, 1)
+
+	notify := func(d udpp2p.Discovered) {
+		hello := new(protobuf.LocalPairingShortCodeHello)
+		err := proto.Unmarshal(d.Payload, hello)
+		if err != nil || !bytes.Equal(hello.Rendezvous, rendezvous) {
+			return
+		}
+
+		select {
+		case found <- net.JoinHostPort(d.Address, strconv.Itoa(int(hello.Port))):
+			stop <- struct{}{}
+		default:
+		}
+	}
+
+	_, err := udpp2p.Discover(udpp2p.Settings{
+		Delay:            pingInterval,
+		TimeLimit:        timeout,
+		StopChan:         stop,
+		DisableBroadcast: true,
+		Notify:           notify,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	select {
+	case address := <-found:
+		return address, nil
+	default:
+		return "", ErrShortCodePeerNotFound
+	}
+}
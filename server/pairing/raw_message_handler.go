@@ -17,6 +17,9 @@ import (
 	"github.com/status-im/status-go/signal"
 )
 
+// rawMessagesBatchSize is the number of sync raw messages handled between progress reports
+const rawMessagesBatchSize = 100
+
 type SyncRawMessageHandler struct {
 	backend *api.GethStatusBackend
 }
@@ -112,10 +115,18 @@ func (s *SyncRawMessageHandler) HandleRawMessage(
 
 	installations := GetMessengerInstallationsMap(messenger)
 
-	err = messenger.HandleSyncRawMessages(rmp.rawMessages)
-
-	if err != nil {
-		return err
+	// handle the raw messages in batches, reporting the progress after each of them
+	total := len(rmp.rawMessages)
+	for start := 0; start < total; start += rawMessagesBatchSize {
+		end := start + rawMessagesBatchSize
+		if end > total {
+			end = total
+		}
+		err = messenger.HandleSyncRawMessages(rmp.rawMessages[start:end])
+		if err != nil {
+			return err
+		}
+		sendItemsProgress(ActionSyncDevice, end, total)
 	}
 
 	if newInstallation := FindNewInstallations(messenger, installations); newInstallation != nil {
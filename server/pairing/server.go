@@ -1,12 +1,14 @@
 package pairing
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/json"
 	"net"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
@@ -34,8 +36,23 @@ type BaseServer struct {
 	challengeGiver *ChallengeGiver
 
 	config ServerConfig
+	// session is the transfer session of the pairing served by the server
+	session *TransferSession
+
+	// transportMu guards the transport used by the client, HTTP and Waku are mutually exclusive
+	transportMu sync.Mutex
+	transport   pairingTransport
+	wakuCancel  context.CancelFunc
 }
 
+type pairingTransport int
+
+const (
+	pairingTransportNone pairingTransport = iota
+	pairingTransportHTTP
+	pairingTransportWaku
+)
+
 // NewBaseServer returns a *BaseServer init from the given *SenderServerConfig
 func NewBaseServer(logger *zap.Logger, e *PayloadEncryptor, config *ServerConfig) (*BaseServer, error) {
 	cg, err := NewChallengeGiver(e, logger)
@@ -52,11 +69,92 @@ func NewBaseServer(logger *zap.Logger, e *PayloadEncryptor, config *ServerConfig
 		),
 		challengeGiver: cg,
 		config:         *config,
+		session:        startTransferSession(),
 	}
 	bs.SetTimeout(config.Timeout)
+	bs.session.OnCancel(bs.stopOnCancel)
 	return bs, nil
 }
 
+// Stop stops the server and ends the transfer session of its pairing
+func (s *BaseServer) Stop() error {
+	s.session.end()
+	return s.Server.Stop()
+}
+
+// stopOnCancel stops the server when the pairing is cancelled
+func (s *BaseServer) stopOnCancel() {
+	err := s.Stop()
+	if err != nil {
+		s.GetLogger().Error("failed to stop the server of the cancelled pairing", zap.Error(err))
+	}
+}
+
+// selectHTTPTransport is called when the client connects over HTTP, it stops listening over Waku.
+// It returns false if the pairing already happens over Waku
+func (s *BaseServer) selectHTTPTransport() bool {
+	s.transportMu.Lock()
+	defer s.transportMu.Unlock()
+
+	switch s.transport {
+	case pairingTransportWaku:
+		return false
+	case pairingTransportNone:
+		s.transport = pairingTransportHTTP
+		if s.wakuCancel != nil {
+			s.wakuCancel()
+		}
+	}
+	return true
+}
+
+// selectWakuTransport is called when the client asks to pair over Waku, it stops the HTTP server.
+// It returns false if the pairing already happens over HTTP
+func (s *BaseServer) selectWakuTransport() bool {
+	s.transportMu.Lock()
+	if s.transport == pairingTransportHTTP {
+		s.transportMu.Unlock()
+		return false
+	}
+	s.transport = pairingTransportWaku
+	s.transportMu.Unlock()
+
+	// the session goes on over Waku, only the HTTP server is stopped
+	err := s.Server.Stop()
+	if err != nil {
+		s.GetLogger().Error("failed to stop the server of the pairing over waku", zap.Error(err))
+	}
+	return true
+}
+
+// pairingOverWaku returns true if the client pairs with the server over Waku
+func (s *BaseServer) pairingOverWaku() bool {
+	s.transportMu.Lock()
+	defer s.transportMu.Unlock()
+	return s.transport == pairingTransportWaku
+}
+
+// setWakuCancel keeps the cancel func of the pairing over Waku.
+// It returns false if the pairing already happens over HTTP
+func (s *BaseServer) setWakuCancel(cancel context.CancelFunc) bool {
+	s.transportMu.Lock()
+	defer s.transportMu.Unlock()
+
+	if s.transport == pairingTransportHTTP {
+		return false
+	}
+	s.wakuCancel = cancel
+	return true
+}
+
+// setHandlers sets the handlers of the server, they are only served while the pairing doesn't happen over Waku
+func (s *BaseServer) setHandlers(handlers server.HandlerPatternMap) {
+	for pattern, handler := range handlers {
+		handlers[pattern] = middlewareTransport(s, handler)
+	}
+	s.SetHandlers(handlers)
+}
+
 // MakeConnectionParams generates a *ConnectionParams based on the Server's current state
 func (s *BaseServer) MakeConnectionParams() (*ConnectionParams, error) {
 	return NewConnectionParams(s.config.IPAddresses, s.MustGetPort(), s.config.PK, s.config.EK, s.config.InstallationID, s.config.KeyUID), nil
@@ -146,15 +244,15 @@ func (s *SenderServer) startSendingData() error {
 			}
 		}
 	}
-	s.SetHandlers(server.HandlerPatternMap{
+	s.setHandlers(server.HandlerPatternMap{
 		pairingChallenge:      handlePairingChallenge(s.challengeGiver),
-		pairingSendAccount:    middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.accountMounter, beforeSending)),
-		pairingSendSyncDevice: middlewareChallenge(s.challengeGiver, handlePairingSyncDeviceSend(logger, s.rawMessageMounter, beforeSending)),
+		pairingSendAccount:    middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.session, s.accountMounter, beforeSending)),
+		pairingSendSyncDevice: middlewareChallenge(s.challengeGiver, handlePairingSyncDeviceSend(logger, s.session, s.rawMessageMounter, beforeSending)),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// receive installation data from receiver
-		pairingReceiveInstallation: middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.installationMounter)),
+		pairingReceiveInstallation: middlewareChallenge(s.challengeGiver, handleReceiveInstallation(s.GetLogger(), s.session, s.installationMounter)),
 	})
 	return s.Start()
 }
@@ -202,6 +300,10 @@ func StartUpSenderServer(backend *api.GethStatusBackend, configJSON string) (str
 		return "", err
 	}
 
+	if w := pairingWaku(backend); w != nil {
+		ps.relayOverWaku(w, cp)
+	}
+
 	return cp.ToString(), nil
 }
 
@@ -232,6 +334,7 @@ func NewReceiverServer(backend *api.GethStatusBackend, config *ReceiverServerCon
 		return nil, err
 	}
 
+	config.ReceiverConfig.session = bs.session
 	ar, rmr, imr, err := NewPayloadReceivers(logger, e, backend, config.ReceiverConfig)
 	if err != nil {
 		return nil, err
@@ -256,15 +359,15 @@ func (s *ReceiverServer) startReceivingData() error {
 			}
 		}
 	}
-	s.SetHandlers(server.HandlerPatternMap{
+	s.setHandlers(server.HandlerPatternMap{
 		pairingChallenge:         handlePairingChallenge(s.challengeGiver),
-		pairingReceiveAccount:    handleReceiveAccount(logger, s.accountReceiver),
-		pairingReceiveSyncDevice: handleParingSyncDeviceReceive(logger, s.rawMessageReceiver),
+		pairingReceiveAccount:    handleReceiveAccount(logger, s.session, s.accountReceiver),
+		pairingReceiveSyncDevice: handleParingSyncDeviceReceive(logger, s.session, s.rawMessageReceiver),
 		// TODO implement refactor of installation data exchange to follow the send/receive pattern of
 		//  the other handlers.
 		//  https://github.com/status-im/status-go/issues/3304
 		// send installation data back to sender
-		pairingSendInstallation: middlewareChallenge(s.challengeGiver, handleSendInstallation(logger, s.installationReceiver, beforeSending)),
+		pairingSendInstallation: middlewareChallenge(s.challengeGiver, handleSendInstallation(logger, s.session, s.installationReceiver, beforeSending)),
 	})
 	return s.Start()
 }
@@ -322,6 +425,10 @@ func StartUpReceiverServer(backend *api.GethStatusBackend, configJSON string) (s
 		return "", err
 	}
 
+	if w := pairingWaku(backend); w != nil {
+		ps.relayOverWaku(w, cp)
+	}
+
 	return cp.ToString(), nil
 }
 
@@ -370,7 +477,7 @@ func (s *KeystoreFilesSenderServer) startSendingData() error {
 	}
 	s.SetHandlers(server.HandlerPatternMap{
 		pairingChallenge:   handlePairingChallenge(s.challengeGiver),
-		pairingSendAccount: middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.keystoreFilesMounter, beforeSending)),
+		pairingSendAccount: middlewareChallenge(s.challengeGiver, handleSendAccount(logger, s.session, s.keystoreFilesMounter, beforeSending)),
 	})
 	return s.Start()
 }
@@ -0,0 +1,490 @@
+package pairing
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/logutils"
+	"github.com/status-im/status-go/protocol/common"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/server/pairing/peers"
+	"github.com/status-im/status-go/signal"
+)
+
+const (
+	shortCodeDigits    = 8
+	minShortCodeDigits = 6
+	sasDigits          = 6
+
+	shortCodeRendezvousInfo   = "status-local-pairing-short-code-rendezvous"
+	shortCodeKeyInfo          = "status-local-pairing-short-code-key"
+	shortCodeSASInfo          = "status-local-pairing-short-code-sas"
+	shortCodeInitiatorConfirm = "status-local-pairing-short-code-initiator"
+	shortCodeResponderConfirm = "status-local-pairing-short-code-responder"
+
+	// shortCodeTimeout bounds the discovery of the counterpart and the confirmation by the users
+	shortCodeTimeout          = 2 * time.Minute
+	shortCodeDiscoveryTimeout = 30 * time.Second
+	maxShortCodeFrameSize     = 64 * 1024
+)
+
+var (
+	ErrInvalidShortCode              = errors.New("short code must be 6 to 8 digits")
+	ErrShortCodeCommitmentMismatch   = errors.New("short code handshake commitment mismatch")
+	ErrShortCodeConfirmationMismatch = errors.New("short code handshake confirmation mismatch")
+	ErrShortCodeRejected             = errors.New("short authentication string rejected")
+	ErrNoShortCodeHandshake          = errors.New("no short code handshake waiting for confirmation")
+)
+
+// ShortCodeSAS is the Data of EventShortCodeSAS events
+type ShortCodeSAS struct {
+	SAS string `json:"sas"`
+}
+
+// GenerateShortCode returns a random numeric pairing short code
+func GenerateShortCode() (string, error) {
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(shortCodeDigits), nil)
+	n, err := rand.Int(rand.Reader, max)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%0*d", shortCodeDigits, n), nil
+}
+
+// ValidateShortCode checks that the code is made of 6 to 8 digits
+func ValidateShortCode(code string) error {
+	if len(code) < minShortCodeDigits || len(code) > shortCodeDigits {
+		return ErrInvalidShortCode
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return ErrInvalidShortCode
+		}
+	}
+	return nil
+}
+
+// shortCodeRendezvous identifies the announcement of the device displaying the code.
+// The code is too short to be a secret, the devices are authenticated by the short authentication string
+func shortCodeRendezvous(code string) []byte {
+	h := sha256.Sum256([]byte(shortCodeRendezvousInfo + code))
+	return h[:8]
+}
+
+/*
+|--------------------------------------------------------------------------
+| ShortCodeHandshake
+|--------------------------------------------------------------------------
+|
+| The initiator (entering the code) commits to its public key before receiving the public key of the
+| responder (displaying the code), so that a man in the middle can't choose its keys to obtain the same
+| short authentication string with both devices. Once both users confirmed the short authentication string,
+| the responder sends the connection string encrypted with the agreed key.
+|
+*/
+
+// ShortCodeHandshake is one side of the short code pairing handshake
+type ShortCodeHandshake struct {
+	conn      net.Conn
+	code      string
+	initiator bool
+
+	privateKey   *ecdh.PrivateKey
+	initiatorKey []byte
+	responderKey []byte
+	key          []byte
+	sas          string
+
+	confirmed chan bool
+}
+
+var (
+	shortCodeHandshakeLock   sync.Mutex
+	activeShortCodeHandshake *ShortCodeHandshake
+)
+
+func newShortCodeHandshake(conn net.Conn, code string, initiator bool) (*ShortCodeHandshake, error) {
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ShortCodeHandshake{
+		conn:       conn,
+		code:       code,
+		initiator:  initiator,
+		privateKey: privateKey,
+		confirmed:  make(chan bool, 1),
+	}, nil
+}
+
+func (h *ShortCodeHandshake) write(messageType protobuf.LocalPairingShortCodeHandshake_Type, payload []byte) error {
+	frame, err := proto.Marshal(&protobuf.LocalPairingShortCodeHandshake{Type: messageType, Payload: payload})
+	if err != nil {
+		return err
+	}
+
+	size := make([]byte, 4)
+	binary.BigEndian.PutUint32(size, uint32(len(frame)))
+	_, err = h.conn.Write(append(size, frame...))
+	return err
+}
+
+func (h *ShortCodeHandshake) read(messageType protobuf.LocalPairingShortCodeHandshake_Type) ([]byte, error) {
+	size := make([]byte, 4)
+	_, err := io.ReadFull(h.conn, size)
+	if err != nil {
+		return nil, err
+	}
+	if binary.BigEndian.Uint32(size) > maxShortCodeFrameSize {
+		return nil, fmt.Errorf("short code handshake message too large: %d bytes", binary.BigEndian.Uint32(size))
+	}
+
+	frame := make([]byte, binary.BigEndian.Uint32(size))
+	_, err = io.ReadFull(h.conn, frame)
+	if err != nil {
+		return nil, err
+	}
+
+	m := new(protobuf.LocalPairingShortCodeHandshake)
+	err = proto.Unmarshal(frame, m)
+	if err != nil {
+		return nil, err
+	}
+	if m.Type == protobuf.LocalPairingShortCodeHandshake_REJECTION {
+		return nil, ErrShortCodeRejected
+	}
+	if m.Type != messageType {
+		return nil, fmt.Errorf("unexpected short code handshake message '%s', expected '%s'", m.Type, messageType)
+	}
+	return m.Payload, nil
+}
+
+// agree runs the commit-reveal key agreement and derives the key and the short authentication string
+func (h *ShortCodeHandshake) agree() error {
+	publicKey := h.privateKey.PublicKey().Bytes()
+
+	var peerKey []byte
+	if h.initiator {
+		commitment := sha256.Sum256(publicKey)
+		err := h.write(protobuf.LocalPairingShortCodeHandshake_COMMITMENT, commitment[:])
+		if err != nil {
+			return err
+		}
+		peerKey, err = h.read(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY)
+		if err != nil {
+			return err
+		}
+		err = h.write(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY, publicKey)
+		if err != nil {
+			return err
+		}
+		h.initiatorKey, h.responderKey = publicKey, peerKey
+	} else {
+		commitment, err := h.read(protobuf.LocalPairingShortCodeHandshake_COMMITMENT)
+		if err != nil {
+			return err
+		}
+		err = h.write(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY, publicKey)
+		if err != nil {
+			return err
+		}
+		peerKey, err = h.read(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY)
+		if err != nil {
+			return err
+		}
+		revealed := sha256.Sum256(peerKey)
+		if !hmac.Equal(commitment, revealed[:]) {
+			return ErrShortCodeCommitmentMismatch
+		}
+		h.initiatorKey, h.responderKey = peerKey, publicKey
+	}
+
+	remote, err := ecdh.X25519().NewPublicKey(peerKey)
+	if err != nil {
+		return err
+	}
+	shared, err := h.privateKey.ECDH(remote)
+	if err != nil {
+		return err
+	}
+
+	key := sha256.New()
+	for _, b := range [][]byte{[]byte(shortCodeKeyInfo), shared, []byte(h.code), h.initiatorKey, h.responderKey} {
+		key.Write(b)
+	}
+	h.key = key.Sum(nil)
+
+	sas := sha256.Sum256(append(append([]byte(shortCodeSASInfo+h.code), h.initiatorKey...), h.responderKey...))
+	max := new(big.Int).Exp(big.NewInt(10), big.NewInt(sasDigits), nil)
+	h.sas = fmt.Sprintf("%0*d", sasDigits, new(big.Int).Mod(new(big.Int).SetBytes(sas[:]), max))
+	return nil
+}
+
+// SAS returns the short authentication string both users have to confirm
+func (h *ShortCodeHandshake) SAS() string {
+	return h.sas
+}
+
+// Confirm records whether the user confirmed the short authentication string
+func (h *ShortCodeHandshake) Confirm(confirmed bool) {
+	select {
+	case h.confirmed <- confirmed:
+	default:
+	}
+}
+
+func (h *ShortCodeHandshake) confirmation(info string) []byte {
+	mac := hmac.New(sha256.New, h.key)
+	mac.Write([]byte(info))
+	mac.Write(h.initiatorKey)
+	mac.Write(h.responderKey)
+	return mac.Sum(nil)
+}
+
+// waitConfirmation waits for the user to confirm the short authentication string,
+// the counterpart is notified if it's rejected
+func (h *ShortCodeHandshake) waitConfirmation(ctx context.Context) error {
+	setActiveShortCodeHandshake(h)
+	signal.SendLocalPairingEvent(Event{Type: EventShortCodeSAS, Action: ActionShortCodePairing, Data: ShortCodeSAS{SAS: h.sas}})
+
+	var confirmed bool
+	select {
+	case confirmed = <-h.confirmed:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if confirmed {
+		return nil
+	}
+
+	err := h.write(protobuf.LocalPairingShortCodeHandshake_REJECTION, nil)
+	if err != nil {
+		return err
+	}
+	return ErrShortCodeRejected
+}
+
+// respond runs the responder side of the handshake and sends the connection string once confirmed
+func (h *ShortCodeHandshake) respond(ctx context.Context, cs string) error {
+	err := h.agree()
+	if err != nil {
+		return err
+	}
+
+	err = h.waitConfirmation(ctx)
+	if err != nil {
+		return err
+	}
+
+	mac, err := h.read(protobuf.LocalPairingShortCodeHandshake_CONFIRMATION)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal(mac, h.confirmation(shortCodeInitiatorConfirm)) {
+		return ErrShortCodeConfirmationMismatch
+	}
+
+	err = h.write(protobuf.LocalPairingShortCodeHandshake_CONFIRMATION, h.confirmation(shortCodeResponderConfirm))
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := common.Encrypt([]byte(cs), h.key, rand.Reader)
+	if err != nil {
+		return err
+	}
+	return h.write(protobuf.LocalPairingShortCodeHandshake_CONNECTION_STRING, encrypted)
+}
+
+// initiate runs the initiator side of the handshake and returns the received connection string
+func (h *ShortCodeHandshake) initiate(ctx context.Context) (string, error) {
+	err := h.agree()
+	if err != nil {
+		return "", err
+	}
+
+	err = h.waitConfirmation(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	err = h.write(protobuf.LocalPairingShortCodeHandshake_CONFIRMATION, h.confirmation(shortCodeInitiatorConfirm))
+	if err != nil {
+		return "", err
+	}
+
+	mac, err := h.read(protobuf.LocalPairingShortCodeHandshake_CONFIRMATION)
+	if err != nil {
+		return "", err
+	}
+	if !hmac.Equal(mac, h.confirmation(shortCodeResponderConfirm)) {
+		return "", ErrShortCodeConfirmationMismatch
+	}
+
+	encrypted, err := h.read(protobuf.LocalPairingShortCodeHandshake_CONNECTION_STRING)
+	if err != nil {
+		return "", err
+	}
+	cs, err := common.Decrypt(encrypted, h.key)
+	if err != nil {
+		return "", err
+	}
+	return string(cs), ValidateConnectionString(string(cs))
+}
+
+func setActiveShortCodeHandshake(h *ShortCodeHandshake) {
+	shortCodeHandshakeLock.Lock()
+	defer shortCodeHandshakeLock.Unlock()
+	activeShortCodeHandshake = h
+}
+
+// ConfirmShortCodePairing records the user confirmation of the short authentication string
+// displayed by the short code handshake in progress
+func ConfirmShortCodePairing(confirmed bool) error {
+	shortCodeHandshakeLock.Lock()
+	defer shortCodeHandshakeLock.Unlock()
+	if activeShortCodeHandshake == nil {
+		return ErrNoShortCodeHandshake
+	}
+	activeShortCodeHandshake.Confirm(confirmed)
+	return nil
+}
+
+func runShortCodeHandshake(ctx context.Context, conn net.Conn, code string, initiator bool, run func(h *ShortCodeHandshake) error) error {
+	defer conn.Close()
+
+	deadline, ok := ctx.Deadline()
+	if ok {
+		err := conn.SetDeadline(deadline)
+		if err != nil {
+			return err
+		}
+	}
+
+	h, err := newShortCodeHandshake(conn, code, initiator)
+	if err != nil {
+		return err
+	}
+	defer setActiveShortCodeHandshake(nil)
+
+	return run(h)
+}
+
+// serveShortCode accepts the first device connecting to the listener and sends it the connection string
+// once the short authentication string is confirmed
+func serveShortCode(ctx context.Context, listener net.Listener, code string, cs string) error {
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	conn, err := listener.Accept()
+	if err != nil {
+		return err
+	}
+	listener.Close()
+
+	return runShortCodeHandshake(ctx, conn, code, false, func(h *ShortCodeHandshake) error {
+		return h.respond(ctx, cs)
+	})
+}
+
+// connectShortCode connects to the device displaying the code and returns its connection string
+// once the short authentication string is confirmed
+func connectShortCode(ctx context.Context, address string, code string) (string, error) {
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return "", err
+	}
+
+	var cs string
+	err = runShortCodeHandshake(ctx, conn, code, true, func(h *ShortCodeHandshake) error {
+		cs, err = h.initiate(ctx)
+		return err
+	})
+	return cs, err
+}
+
+// StartUpShortCodeResponder generates a short code for the given connection string and announces it
+// on the local network. The connection string is sent to the device the code is entered on,
+// once both users confirmed the short authentication string.
+func StartUpShortCodeResponder(cs string) (string, error) {
+	err := ValidateConnectionString(cs)
+	if err != nil {
+		return "", err
+	}
+
+	code, err := GenerateShortCode()
+	if err != nil {
+		return "", err
+	}
+
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return "", err
+	}
+
+	logger := logutils.ZapLogger().Named("ShortCodeResponder")
+	stop := make(chan struct{}, 1)
+	err = peers.AnnounceShortCode(shortCodeRendezvous(code), listener.Addr().(*net.TCPAddr).Port, stop, logger)
+	if err != nil {
+		listener.Close()
+		return "", err
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shortCodeTimeout)
+		defer cancel()
+		err := serveShortCode(ctx, listener, code, cs)
+		stop <- struct{}{}
+		if err != nil {
+			logger.Error("short code handshake failed", zap.Error(err))
+			signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionShortCodePairing})
+			return
+		}
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionShortCodePairing})
+	}()
+
+	return code, nil
+}
+
+// ConnectWithShortCode finds the device displaying the short code on the local network and returns
+// its connection string, once both users confirmed the short authentication string.
+func ConnectWithShortCode(code string) (string, error) {
+	err := ValidateShortCode(code)
+	if err != nil {
+		return "", err
+	}
+
+	address, err := peers.FindShortCode(shortCodeRendezvous(code), shortCodeDiscoveryTimeout)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionShortCodePairing})
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortCodeTimeout)
+	defer cancel()
+	cs, err := connectShortCode(ctx, address, code)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionError, Error: err.Error(), Action: ActionShortCodePairing})
+		return "", err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionShortCodePairing})
+	return cs, nil
+}
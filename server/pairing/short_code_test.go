@@ -0,0 +1,143 @@
+package pairing
+
+import (
+	"context"
+	"crypto/sha256"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+func TestShortCodeSuite(t *testing.T) {
+	suite.Run(t, new(ShortCodeSuite))
+}
+
+type ShortCodeSuite struct {
+	suite.Suite
+}
+
+// handshakes returns the responder and initiator sides of a handshake over a local connection
+func (s *ShortCodeSuite) handshakes(responderCode, initiatorCode string) (*ShortCodeHandshake, *ShortCodeHandshake) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	defer listener.Close()
+
+	accepted := make(chan net.Conn, 1)
+	go func() {
+		conn, err := listener.Accept()
+		s.Require().NoError(err)
+		accepted <- conn
+	}()
+
+	initiatorConn, err := net.Dial("tcp", listener.Addr().String())
+	s.Require().NoError(err)
+	responderConn := <-accepted
+	s.T().Cleanup(func() {
+		initiatorConn.Close()
+		responderConn.Close()
+	})
+	deadline := time.Now().Add(10 * time.Second)
+	s.Require().NoError(initiatorConn.SetDeadline(deadline))
+	s.Require().NoError(responderConn.SetDeadline(deadline))
+
+	responder, err := newShortCodeHandshake(responderConn, responderCode, false)
+	s.Require().NoError(err)
+	initiator, err := newShortCodeHandshake(initiatorConn, initiatorCode, true)
+	s.Require().NoError(err)
+	return responder, initiator
+}
+
+// run runs both sides of the handshake and returns their errors and the received connection string
+func (s *ShortCodeSuite) run(responder, initiator *ShortCodeHandshake) (error, error, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	responderErr := make(chan error, 1)
+	go func() {
+		err := responder.respond(ctx, connectionString)
+		// as runShortCodeHandshake, the connection is closed once the handshake is over
+		responder.conn.Close()
+		responderErr <- err
+	}()
+
+	cs, initiatorErr := initiator.initiate(ctx)
+	return <-responderErr, initiatorErr, cs
+}
+
+func (s *ShortCodeSuite) TestShortCode() {
+	code, err := GenerateShortCode()
+	s.Require().NoError(err)
+	s.Require().Len(code, shortCodeDigits)
+	s.Require().NoError(ValidateShortCode(code))
+
+	s.Require().NoError(ValidateShortCode("123456"))
+	s.Require().ErrorIs(ValidateShortCode("12345"), ErrInvalidShortCode)
+	s.Require().ErrorIs(ValidateShortCode("123456789"), ErrInvalidShortCode)
+	s.Require().ErrorIs(ValidateShortCode("1234a678"), ErrInvalidShortCode)
+
+	s.Require().Equal(shortCodeRendezvous(code), shortCodeRendezvous(code))
+	s.Require().NotEqual(shortCodeRendezvous("12345678"), shortCodeRendezvous("12345679"))
+}
+
+func (s *ShortCodeSuite) TestHandshake() {
+	responder, initiator := s.handshakes("12345678", "12345678")
+	responder.Confirm(true)
+	initiator.Confirm(true)
+
+	responderErr, initiatorErr, cs := s.run(responder, initiator)
+	s.Require().NoError(responderErr)
+	s.Require().NoError(initiatorErr)
+	s.Require().Equal(connectionString, cs)
+
+	s.Require().Len(responder.SAS(), sasDigits)
+	s.Require().Equal(responder.SAS(), initiator.SAS())
+	s.Require().Equal(responder.key, initiator.key)
+}
+
+func (s *ShortCodeSuite) TestHandshakeRejected() {
+	responder, initiator := s.handshakes("12345678", "12345678")
+	responder.Confirm(false)
+	initiator.Confirm(true)
+
+	responderErr, initiatorErr, cs := s.run(responder, initiator)
+	s.Require().ErrorIs(responderErr, ErrShortCodeRejected)
+	s.Require().ErrorIs(initiatorErr, ErrShortCodeRejected)
+	s.Require().Empty(cs)
+}
+
+func (s *ShortCodeSuite) TestHandshakeWithOtherCode() {
+	responder, initiator := s.handshakes("12345678", "87654321")
+	responder.Confirm(true)
+	initiator.Confirm(true)
+
+	// The users would see different short authentication strings
+	responderErr, initiatorErr, cs := s.run(responder, initiator)
+	s.Require().NotEqual(responder.SAS(), initiator.SAS())
+	s.Require().ErrorIs(responderErr, ErrShortCodeConfirmationMismatch)
+	s.Require().Error(initiatorErr)
+	s.Require().Empty(cs)
+}
+
+func (s *ShortCodeSuite) TestHandshakeCommitmentMismatch() {
+	responder, initiator := s.handshakes("12345678", "12345678")
+
+	responderErr := make(chan error, 1)
+	go func() {
+		responderErr <- responder.agree()
+	}()
+
+	// The initiator reveals another key than the one it committed to
+	other, err := newShortCodeHandshake(nil, "12345678", true)
+	s.Require().NoError(err)
+	commitment := sha256.Sum256(initiator.privateKey.PublicKey().Bytes())
+	s.Require().NoError(initiator.write(protobuf.LocalPairingShortCodeHandshake_COMMITMENT, commitment[:]))
+	_, err = initiator.read(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY)
+	s.Require().NoError(err)
+	s.Require().NoError(initiator.write(protobuf.LocalPairingShortCodeHandshake_PUBLIC_KEY, other.privateKey.PublicKey().Bytes()))
+
+	s.Require().ErrorIs(<-responderErr, ErrShortCodeCommitmentMismatch)
+}
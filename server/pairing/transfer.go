@@ -0,0 +1,535 @@
+package pairing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/signal"
+)
+
+const (
+	// payloadChunkSize is the size of the chunks the pairing payloads are transferred in,
+	// so that a transfer can be resumed from the last received chunk
+	payloadChunkSize = 256 * 1024
+	// maxChunkAttempts is the number of times the transfer of a chunk is attempted
+	maxChunkAttempts = 5
+	chunkRetryDelay  = 500 * time.Millisecond
+	// maxPairingPayloadSize bounds the size announced for a chunked payload,
+	// so that a peer can't make us allocate an arbitrary amount of memory
+	maxPairingPayloadSize = 512 * 1024 * 1024
+
+	// headerChunkedTransfer is set by servers accepting payloads in chunks
+	headerChunkedTransfer = "X-Pairing-Chunked"
+	headerPayloadSize     = "X-Pairing-Payload-Size"
+	headerPayloadChecksum = "X-Pairing-Payload-Checksum"
+	headerPayloadOffset   = "X-Pairing-Payload-Offset"
+	queryPayloadOffset    = "offset"
+)
+
+var (
+	ErrPayloadChecksumMismatch = errors.New("pairing payload checksum mismatch")
+	ErrPayloadNotMounted       = errors.New("pairing payload not mounted")
+	ErrUnexpectedPayloadOffset = errors.New("unexpected pairing payload offset")
+	ErrPayloadTooLarge         = errors.New("pairing payload larger than announced")
+	ErrInvalidPayloadSize      = errors.New("invalid pairing payload size")
+)
+
+func payloadChecksum(payload []byte) string {
+	checksum := sha256.Sum256(payload)
+	return hex.EncodeToString(checksum[:])
+}
+
+func sendBytesProgress(action Action, transferred int, total int) {
+	signal.SendLocalPairingEvent(Event{
+		Type:   EventTransferProgress,
+		Action: action,
+		Data:   TransferProgress{BytesTransferred: transferred, BytesTotal: total},
+	})
+}
+
+func sendItemsProgress(action Action, processed int, total int) {
+	signal.SendLocalPairingEvent(Event{
+		Type:   EventTransferProgress,
+		Action: action,
+		Data:   TransferProgress{ItemsProcessed: processed, ItemsTotal: total},
+	})
+}
+
+/*
+|--------------------------------------------------------------------------
+| Server side
+|--------------------------------------------------------------------------
+*/
+
+// chunkedPayloadBuffer assembles a payload received in chunks
+type chunkedPayloadBuffer struct {
+	mutex    sync.Mutex
+	size     int
+	checksum string
+	data     []byte
+	// processed is set once the payload has been handed over, so that a resent last chunk
+	// doesn't process the payload again
+	processed bool
+}
+
+// write stores the chunk at the given offset and returns the number of bytes received so far.
+// A chunk of another payload starts a new payload, chunks already received are overwritten
+// so that chunks can be resent
+func (b *chunkedPayloadBuffer) write(offset int, size int, checksum string, chunk []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if size != b.size || checksum != b.checksum {
+		b.size = size
+		b.checksum = checksum
+		// the buffer grows with the received chunks rather than with the announced size
+		b.data = make([]byte, 0, min(size, payloadChunkSize))
+		b.processed = false
+	}
+
+	if offset > len(b.data) {
+		return len(b.data), ErrUnexpectedPayloadOffset
+	}
+	if offset+len(chunk) > b.size {
+		return len(b.data), ErrPayloadTooLarge
+	}
+
+	b.data = append(b.data[:offset], chunk...)
+	return len(b.data), nil
+}
+
+// payload returns the payload once fully received and verified, only once per payload
+func (b *chunkedPayloadBuffer) payload() ([]byte, bool, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.processed || len(b.data) < b.size {
+		return nil, false, nil
+	}
+	if payloadChecksum(b.data) != b.checksum {
+		return nil, false, ErrPayloadChecksumMismatch
+	}
+	b.processed = true
+	return b.data, true, nil
+}
+
+// requestedOffset returns the offset requested by chunked transfer clients
+func requestedOffset(r *http.Request) (int, bool, error) {
+	o := r.URL.Query().Get(queryPayloadOffset)
+	if o == "" {
+		return 0, false, nil
+	}
+	offset, err := strconv.Atoi(o)
+	if err != nil || offset < 0 {
+		return 0, true, ErrUnexpectedPayloadOffset
+	}
+	return offset, true, nil
+}
+
+// servePayload writes the payload of the PayloadMounter, in chunks to the clients requesting an offset.
+// The payload is mounted at the beginning of the transfer and locked once fully sent, chunked transfers
+// are acknowledged by requesting the offset at the end of the payload so that the last chunk can be resent
+func servePayload(w http.ResponseWriter, r *http.Request, logger *zap.Logger, session *TransferSession, pm PayloadMounter, beforeSending func(), action Action) {
+	w.Header().Set("Content-Type", "application/octet-stream")
+
+	offset, chunked, err := requestedOffset(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if offset == 0 {
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: action})
+	}
+
+	if session.Cancelled() {
+		http.Error(w, ErrPairingCancelled.Error(), http.StatusGone)
+		return
+	}
+
+	if offset == 0 {
+		err = pm.Mount()
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			logger.Error("servePayload pm.Mount()", zap.Error(err))
+			http.Error(w, "error", http.StatusInternalServerError)
+			return
+		}
+		beforeSending()
+	}
+
+	payload := pm.ToSend()
+	if payload == nil {
+		http.Error(w, ErrPayloadNotMounted.Error(), http.StatusConflict)
+		return
+	}
+
+	end := len(payload)
+	if chunked {
+		if offset > 0 && offset == len(payload) {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+			pm.LockPayload()
+			return
+		}
+		if offset > len(payload) {
+			http.Error(w, ErrUnexpectedPayloadOffset.Error(), http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		if offset+payloadChunkSize < end {
+			end = offset + payloadChunkSize
+		}
+		w.Header().Set(headerPayloadSize, strconv.Itoa(len(payload)))
+		w.Header().Set(headerPayloadChecksum, payloadChecksum(payload))
+	}
+
+	_, err = w.Write(payload[offset:end])
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		logger.Error("servePayload w.Write(pm.ToSend())", zap.Error(err))
+		http.Error(w, "error", http.StatusInternalServerError)
+		return
+	}
+	sendBytesProgress(action, end, len(payload))
+
+	if chunked {
+		return
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+
+	pm.LockPayload()
+}
+
+// receivePayload reads the payload sent to the PayloadReceiver, in chunks for the clients sending an offset.
+// The payload is received once fully transferred and verified
+func receivePayload(w http.ResponseWriter, r *http.Request, logger *zap.Logger, session *TransferSession, buffer *chunkedPayloadBuffer, pr PayloadReceiver, action Action) {
+	offset, chunked, err := requestedOffset(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if offset == 0 {
+		signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: action})
+	}
+
+	if session.Cancelled() {
+		http.Error(w, ErrPairingCancelled.Error(), http.StatusGone)
+		return
+	}
+
+	chunk, err := io.ReadAll(r.Body)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		logger.Error("receivePayload io.ReadAll(r.Body)", zap.Error(err))
+		http.Error(w, "error", http.StatusInternalServerError)
+		return
+	}
+
+	payload := chunk
+	if chunked {
+		size, err := strconv.Atoi(r.Header.Get(headerPayloadSize))
+		if err == nil && (size < 0 || size > maxPairingPayloadSize || offset > size) {
+			err = ErrInvalidPayloadSize
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		received, err := buffer.write(offset, size, r.Header.Get(headerPayloadChecksum), chunk)
+		w.Header().Set(headerPayloadOffset, strconv.Itoa(received))
+		if err != nil {
+			logger.Warn("receivePayload buffer.write()", zap.Error(err), zap.Int("offset", offset), zap.Int("received", received))
+			http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		sendBytesProgress(action, received, size)
+
+		var complete bool
+		payload, complete, err = buffer.payload()
+		if err != nil {
+			signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if !complete {
+			return
+		}
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+
+	// the session can't be cancelled while the payload is stored, its rollbacks would run too early
+	err = session.Guard(func() error {
+		return pr.Receive(payload)
+	})
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: action})
+		logger.Error("receivePayload pr.Receive(payload)", zap.Error(err))
+		http.Error(w, "error", http.StatusUnprocessableEntity)
+		return
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: action})
+}
+
+/*
+|--------------------------------------------------------------------------
+| Client side
+|--------------------------------------------------------------------------
+*/
+
+// chunkError is returned for failed chunk requests, which can be retried unless
+// the server rejected the request
+type chunkError struct {
+	err       error
+	retryable bool
+}
+
+func (e *chunkError) Error() string {
+	return e.err.Error()
+}
+
+func (e *chunkError) Unwrap() error {
+	return e.err
+}
+
+func statusError(resp *http.Response) error {
+	return &chunkError{
+		err: fmt.Errorf("[client] status not ok, received '%s'", resp.Status),
+		// the server may be temporarily unavailable, other errors are final
+		retryable: resp.StatusCode >= http.StatusInternalServerError,
+	}
+}
+
+// retryChunk attempts f up to maxChunkAttempts times, unless the session is cancelled or the error is final
+func (c *BaseClient) retryChunk(f func() error) error {
+	var err error
+	for attempt := 1; attempt <= maxChunkAttempts; attempt++ {
+		if c.session.Cancelled() {
+			return ErrPairingCancelled
+		}
+
+		err = f()
+		if err == nil {
+			return nil
+		}
+
+		var cErr *chunkError
+		if errors.As(err, &cErr) && !cErr.retryable {
+			return err
+		}
+		time.Sleep(time.Duration(attempt) * chunkRetryDelay)
+	}
+	return err
+}
+
+// newRequest returns a request for the chunk at the given offset, answering the last challenge of the server
+func (c *BaseClient) newRequest(method string, path string, offset int, body []byte) (*http.Request, error) {
+	u := *c.baseAddress
+	u.Path = path
+	if c.chunkedTransfer {
+		q := u.Query()
+		q.Set(queryPayloadOffset, strconv.Itoa(offset))
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(c.session.Context(), method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	err = c.challengeTaker.DoChallenge(req)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// takeChallenge gets a new challenge before each chunk, as challenges are single use
+func (c *BaseClient) takeChallenge() error {
+	err := c.getChallenge()
+	if err != nil {
+		return &chunkError{err: err, retryable: true}
+	}
+	return nil
+}
+
+// downloadPayload receives the payload served at the given path, in chunks when the server supports it.
+// A failed chunk is requested again, resuming the transfer from the last received offset
+func (c *BaseClient) downloadPayload(path string, action Action) ([]byte, error) {
+	var payload []byte
+	size := -1
+	var checksum string
+
+	for size < 0 || len(payload) < size {
+		received := len(payload)
+		err := c.retryChunk(func() error {
+			err := c.takeChallenge()
+			if err != nil {
+				return err
+			}
+
+			req, err := c.newRequest(http.MethodGet, path, len(payload), nil)
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Do(req)
+			if err != nil {
+				return &chunkError{err: err, retryable: true}
+			}
+			defer resp.Body.Close()
+
+			if resp.StatusCode != http.StatusOK {
+				return statusError(resp)
+			}
+
+			chunk, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return &chunkError{err: err, retryable: true}
+			}
+
+			announcedSize := resp.Header.Get(headerPayloadSize)
+			if announcedSize == "" {
+				// the server sent the whole payload at once
+				payload = chunk
+				size = len(chunk)
+				checksum = payloadChecksum(chunk)
+				return nil
+			}
+
+			s, err := strconv.Atoi(announcedSize)
+			if err != nil {
+				return err
+			}
+			if s < 0 || s > maxPairingPayloadSize {
+				return ErrInvalidPayloadSize
+			}
+			chunkChecksum := resp.Header.Get(headerPayloadChecksum)
+			if size >= 0 && (s != size || chunkChecksum != checksum) {
+				return ErrPayloadChecksumMismatch
+			}
+			size = s
+			checksum = chunkChecksum
+			payload = append(payload, chunk...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(payload) == received && len(payload) < size {
+			return nil, ErrPayloadNotMounted
+		}
+		sendBytesProgress(action, len(payload), size)
+	}
+
+	if payloadChecksum(payload) != checksum {
+		return nil, ErrPayloadChecksumMismatch
+	}
+	if !c.chunkedTransfer || len(payload) == 0 {
+		return payload, nil
+	}
+	return payload, c.acknowledgePayload(path, len(payload))
+}
+
+// acknowledgePayload lets the server know that the whole payload has been received
+func (c *BaseClient) acknowledgePayload(path string, size int) error {
+	return c.retryChunk(func() error {
+		err := c.takeChallenge()
+		if err != nil {
+			return err
+		}
+
+		req, err := c.newRequest(http.MethodGet, path, size, nil)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.Do(req)
+		if err != nil {
+			return &chunkError{err: err, retryable: true}
+		}
+		defer resp.Body.Close()
+
+		// the payload is already locked if a previous acknowledgement went through
+		if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusConflict {
+			return statusError(resp)
+		}
+		return nil
+	})
+}
+
+// uploadPayload sends the payload to the given path, in chunks when the server supports it.
+// The server acknowledges the received bytes, a failed chunk is sent again from the acknowledged offset
+func (c *BaseClient) uploadPayload(path string, payload []byte, action Action) error {
+	checksum := payloadChecksum(payload)
+	offset := 0
+
+	for {
+		err := c.retryChunk(func() error {
+			err := c.takeChallenge()
+			if err != nil {
+				return err
+			}
+
+			end := len(payload)
+			if c.chunkedTransfer && offset+payloadChunkSize < end {
+				end = offset + payloadChunkSize
+			}
+
+			req, err := c.newRequest(http.MethodPost, path, offset, payload[offset:end])
+			if err != nil {
+				return err
+			}
+			req.Header.Set(headerPayloadSize, strconv.Itoa(len(payload)))
+			req.Header.Set(headerPayloadChecksum, checksum)
+
+			resp, err := c.Do(req)
+			if err != nil {
+				// without chunks, the server may have received the payload already
+				return &chunkError{err: err, retryable: c.chunkedTransfer}
+			}
+			defer resp.Body.Close()
+
+			if !c.chunkedTransfer {
+				if resp.StatusCode != http.StatusOK {
+					return statusError(resp)
+				}
+				offset = len(payload)
+				return nil
+			}
+
+			received, err := strconv.Atoi(resp.Header.Get(headerPayloadOffset))
+			if err != nil {
+				return statusError(resp)
+			}
+			offset = received
+			if resp.StatusCode == http.StatusRequestedRangeNotSatisfiable {
+				// resume from the offset acknowledged by the server
+				return &chunkError{err: ErrUnexpectedPayloadOffset, retryable: true}
+			}
+			if resp.StatusCode != http.StatusOK {
+				return statusError(resp)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		sendBytesProgress(action, offset, len(payload))
+
+		if offset >= len(payload) {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package pairing
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/multierr"
+
+	"github.com/status-im/status-go/signal"
+)
+
+var ErrPairingCancelled = errors.New("local pairing cancelled")
+
+// TransferSession tracks the local pairing transfer in progress, so that it can be cancelled
+// and the partially received account rolled back
+type TransferSession struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// guard is held while received data is stored, and while it's rolled back
+	guard     sync.Mutex
+	mutex     sync.Mutex
+	cancelled bool
+	onCancel  []func()
+	rollbacks []func() error
+}
+
+var (
+	transferSessionsLock sync.Mutex
+	// transferSessions are the sessions of the pairings in progress, cancelled by CancelPairing
+	transferSessions = make(map[*TransferSession]struct{})
+)
+
+func newTransferSession() *TransferSession {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TransferSession{ctx: ctx, cancel: cancel}
+}
+
+// startTransferSession returns the session of a new pairing, held by its server or client
+func startTransferSession() *TransferSession {
+	transferSessionsLock.Lock()
+	defer transferSessionsLock.Unlock()
+	s := newTransferSession()
+	transferSessions[s] = struct{}{}
+	return s
+}
+
+// end removes the session from the pairings in progress, once its server or client is done
+func (s *TransferSession) end() {
+	transferSessionsLock.Lock()
+	defer transferSessionsLock.Unlock()
+	delete(transferSessions, s)
+}
+
+// Context is cancelled when the session is cancelled
+func (s *TransferSession) Context() context.Context {
+	return s.ctx
+}
+
+// Cancelled returns true if the session has been cancelled
+func (s *TransferSession) Cancelled() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.cancelled
+}
+
+// OnCancel registers a function called when the session is cancelled, e.g. to stop a server
+func (s *TransferSession) OnCancel(f func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.onCancel = append(s.onCancel, f)
+}
+
+// AddRollback registers a function reverting received data, called if the session is cancelled
+// before being completed
+func (s *TransferSession) AddRollback(f func() error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rollbacks = append(s.rollbacks, f)
+}
+
+// Guard runs f unless the session is cancelled, the session can't be cancelled while f is running
+func (s *TransferSession) Guard(f func() error) error {
+	s.guard.Lock()
+	defer s.guard.Unlock()
+	if s.Cancelled() {
+		return ErrPairingCancelled
+	}
+	return f()
+}
+
+// Complete marks the received account as fully stored, it won't be rolled back anymore
+func (s *TransferSession) Complete() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rollbacks = nil
+}
+
+// Cancel stops the transfer and rolls back the received data, in the reverse order it was stored
+func (s *TransferSession) Cancel() error {
+	s.guard.Lock()
+	s.mutex.Lock()
+	if s.cancelled {
+		s.mutex.Unlock()
+		s.guard.Unlock()
+		return nil
+	}
+	s.cancelled = true
+	s.end()
+	rollbacks := s.rollbacks
+	s.rollbacks = nil
+	onCancel := s.onCancel
+	s.mutex.Unlock()
+
+	var err error
+	for i := len(rollbacks) - 1; i >= 0; i-- {
+		err = multierr.Append(err, rollbacks[i]())
+	}
+	s.guard.Unlock()
+
+	// Stopping the servers waits for the requests in progress, which may be guarded
+	s.cancel()
+	for _, f := range onCancel {
+		f()
+	}
+
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferCancelled, Error: err.Error()})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferCancelled})
+	return nil
+}
+
+// CancelPairing cancels the local pairings in progress. If the account was partially received,
+// the stored keys and multiaccount are removed
+func CancelPairing() error {
+	transferSessionsLock.Lock()
+	sessions := make([]*TransferSession, 0, len(transferSessions))
+	for s := range transferSessions {
+		sessions = append(sessions, s)
+	}
+	transferSessionsLock.Unlock()
+
+	var err error
+	for _, s := range sessions {
+		err = multierr.Append(err, s.Cancel())
+	}
+	return err
+}
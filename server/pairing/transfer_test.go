@@ -0,0 +1,283 @@
+package pairing
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/signal"
+)
+
+func TestTransferSuite(t *testing.T) {
+	suite.Run(t, new(TransferSuite))
+}
+
+type TransferSuite struct {
+	suite.Suite
+
+	logger *zap.Logger
+	aesKey []byte
+
+	eventsLock sync.Mutex
+	events     []Event
+
+	session *TransferSession
+}
+
+// dropResponsesTransport loses the response of every other payload request, after the server handled it
+type dropResponsesTransport struct {
+	mutex    sync.Mutex
+	requests int
+}
+
+func (t *dropResponsesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || req.URL.Path == pairingChallenge {
+		return resp, err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.requests++
+	if t.requests%2 == 0 {
+		resp.Body.Close()
+		return nil, errors.New("connection reset")
+	}
+	return resp, nil
+}
+
+func (s *TransferSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+	s.aesKey = make([]byte, 32)
+	_, err := rand.Read(s.aesKey)
+	s.Require().NoError(err)
+
+	s.events = nil
+	signal.SetMobileSignalHandler(func(data []byte) {
+		envelope := struct {
+			Event Event `json:"event"`
+		}{}
+		s.Require().NoError(json.Unmarshal(data, &envelope))
+
+		s.eventsLock.Lock()
+		defer s.eventsLock.Unlock()
+		s.events = append(s.events, envelope.Event)
+	})
+	s.session = startTransferSession()
+}
+
+func (s *TransferSuite) TearDownTest() {
+	signal.SetMobileSignalHandler(nil)
+	s.session.end()
+}
+
+func (s *TransferSuite) newServer(pm PayloadMounter, pr PayloadReceiver) *httptest.Server {
+	cg, err := NewChallengeGiver(NewPayloadEncryptor(s.aesKey), s.logger)
+	s.Require().NoError(err)
+
+	mux := http.NewServeMux()
+	mux.Handle(pairingChallenge, handlePairingChallenge(cg))
+	mux.Handle(pairingSendAccount, middlewareChallenge(cg, handleSendAccount(s.logger, s.session, pm, func() {})))
+	mux.Handle(pairingReceiveAccount, handleReceiveAccount(s.logger, s.session, pr))
+	return httptest.NewServer(mux)
+}
+
+func (s *TransferSuite) newClient(server *httptest.Server, transport http.RoundTripper) *BaseClient {
+	u, err := url.Parse(server.URL)
+	s.Require().NoError(err)
+	jar, err := cookiejar.New(nil)
+	s.Require().NoError(err)
+
+	return &BaseClient{
+		Client:         &http.Client{Transport: transport, Jar: jar},
+		baseAddress:    u,
+		challengeTaker: NewChallengeTaker(NewPayloadEncryptor(s.aesKey)),
+		session:        s.session,
+	}
+}
+
+func (s *TransferSuite) randomPayload(size int) []byte {
+	payload := make([]byte, size)
+	_, err := rand.Read(payload)
+	s.Require().NoError(err)
+	return payload
+}
+
+// progress returns the transfer progress events of the given action
+func (s *TransferSuite) progress(action Action) []TransferProgress {
+	s.eventsLock.Lock()
+	defer s.eventsLock.Unlock()
+
+	var progress []TransferProgress
+	for _, e := range s.events {
+		if e.Type != EventTransferProgress || e.Action != action {
+			continue
+		}
+		data, err := json.Marshal(e.Data)
+		s.Require().NoError(err)
+		p := TransferProgress{}
+		s.Require().NoError(json.Unmarshal(data, &p))
+		progress = append(progress, p)
+	}
+	return progress
+}
+
+func (s *TransferSuite) TestChunkedPayloadBuffer() {
+	payload := s.randomPayload(10)
+	checksum := payloadChecksum(payload)
+	buffer := new(chunkedPayloadBuffer)
+
+	received, err := buffer.write(0, len(payload), checksum, payload[:4])
+	s.Require().NoError(err)
+	s.Require().Equal(4, received)
+
+	// A chunk can't be received before the previous ones
+	received, err = buffer.write(8, len(payload), checksum, payload[8:])
+	s.Require().ErrorIs(err, ErrUnexpectedPayloadOffset)
+	s.Require().Equal(4, received)
+
+	// Resent chunks overwrite the received ones
+	_, err = buffer.write(0, len(payload), checksum, payload[:4])
+	s.Require().NoError(err)
+	received, err = buffer.write(4, len(payload), checksum, payload[4:])
+	s.Require().NoError(err)
+	s.Require().Equal(len(payload), received)
+
+	p, complete, err := buffer.payload()
+	s.Require().NoError(err)
+	s.Require().True(complete)
+	s.Require().Equal(payload, p)
+
+	// The payload is handed over once
+	_, complete, err = buffer.payload()
+	s.Require().NoError(err)
+	s.Require().False(complete)
+
+	// A corrupted payload isn't handed over
+	_, err = buffer.write(0, len(payload), payloadChecksum([]byte("other")), payload)
+	s.Require().NoError(err)
+	_, _, err = buffer.payload()
+	s.Require().ErrorIs(err, ErrPayloadChecksumMismatch)
+}
+
+func (s *TransferSuite) TestDownloadPayloadResumes() {
+	payload := s.randomPayload(3*payloadChunkSize + payloadChunkSize/2)
+	pm := &testPayloadMounter{payload: payload}
+	server := s.newServer(pm, &testPayloadReceiver{})
+	defer server.Close()
+
+	c := s.newClient(server, &dropResponsesTransport{})
+	received, err := c.downloadPayload(pairingSendAccount, ActionPairingAccount)
+	s.Require().NoError(err)
+	s.Require().Equal(payload, received)
+	s.Require().True(c.chunkedTransfer)
+	s.Require().True(pm.locked)
+
+	progress := s.progress(ActionPairingAccount)
+	s.Require().NotEmpty(progress)
+	last := progress[len(progress)-1]
+	s.Require().Equal(len(payload), last.BytesTransferred)
+	s.Require().Equal(len(payload), last.BytesTotal)
+}
+
+func (s *TransferSuite) TestUploadPayloadResumes() {
+	payload := s.randomPayload(2*payloadChunkSize + 1)
+	pr := &testPayloadReceiver{}
+	server := s.newServer(&testPayloadMounter{}, pr)
+	defer server.Close()
+
+	c := s.newClient(server, &dropResponsesTransport{})
+	err := c.uploadPayload(pairingReceiveAccount, payload, ActionPairingAccount)
+	s.Require().NoError(err)
+	s.Require().Equal(payload, pr.Received())
+}
+
+func (s *TransferSuite) TestCancelledTransfer() {
+	server := s.newServer(&testPayloadMounter{payload: s.randomPayload(payloadChunkSize)}, &testPayloadReceiver{})
+	defer server.Close()
+
+	rolledBack := false
+	s.session.AddRollback(func() error {
+		rolledBack = true
+		return nil
+	})
+	s.Require().NoError(CancelPairing())
+	s.Require().True(rolledBack)
+
+	c := s.newClient(server, http.DefaultTransport)
+	_, err := c.downloadPayload(pairingSendAccount, ActionPairingAccount)
+	s.Require().ErrorIs(err, ErrPairingCancelled)
+
+	// Completed sessions aren't rolled back
+	session := startTransferSession()
+	session.AddRollback(func() error {
+		s.Fail("completed session rolled back")
+		return nil
+	})
+	session.Complete()
+	s.Require().NoError(CancelPairing())
+}
+
+func (s *TransferSuite) TestConcurrentTransferSessions() {
+	other := startTransferSession()
+
+	// A pairing doesn't replace the session of another one
+	s.Require().NoError(s.session.Cancel())
+	s.Require().True(s.session.Cancelled())
+	s.Require().False(other.Cancelled())
+
+	// Ended pairings aren't cancelled anymore
+	other.end()
+	s.Require().NoError(CancelPairing())
+	s.Require().False(other.Cancelled())
+}
+
+func (s *TransferSuite) TestNewRequestKeepsBaseAddress() {
+	server := s.newServer(&testPayloadMounter{}, &testPayloadReceiver{})
+	defer server.Close()
+
+	c := s.newClient(server, http.DefaultTransport)
+	base := c.baseAddress.String()
+
+	req, err := c.newRequest(http.MethodGet, pairingSendAccount, 0, nil)
+	s.Require().NoError(err)
+	s.Require().Equal(pairingSendAccount, req.URL.Path)
+	s.Require().Equal(base, c.baseAddress.String())
+}
+
+func (s *TransferSuite) TestReceivePayloadRejectsInvalidSize() {
+	server := s.newServer(&testPayloadMounter{}, &testPayloadReceiver{})
+	defer server.Close()
+
+	for _, test := range []struct {
+		offset int
+		size   string
+	}{
+		{0, "-1"},
+		{0, strconv.Itoa(maxPairingPayloadSize + 1)},
+		{0, "not a size"},
+		{8, "4"},
+	} {
+		req, err := http.NewRequest(http.MethodPost, server.URL+pairingReceiveAccount+"?"+queryPayloadOffset+"="+strconv.Itoa(test.offset), bytes.NewReader([]byte("chunk")))
+		s.Require().NoError(err)
+		req.Header.Set(headerPayloadSize, test.size)
+		req.Header.Set(headerPayloadChecksum, payloadChecksum([]byte("chunk")))
+
+		resp, err := http.DefaultClient.Do(req)
+		s.Require().NoError(err)
+		resp.Body.Close()
+		s.Require().Equal(http.StatusBadRequest, resp.StatusCode, test.size)
+	}
+}
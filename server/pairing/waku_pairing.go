@@ -0,0 +1,348 @@
+package pairing
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/api"
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/logutils"
+	"github.com/status-im/status-go/protocol/protobuf"
+	"github.com/status-im/status-go/signal"
+)
+
+var (
+	ErrWakuUnavailable       = errors.New("waku is not available for pairing")
+	ErrPairingTransportInUse = errors.New("the pairing already happens over another transport")
+)
+
+// pairingWaku returns the Waku node of the backend, or nil if it isn't running
+func pairingWaku(backend *api.GethStatusBackend) types.Waku {
+	if backend == nil || backend.StatusNode() == nil {
+		return nil
+	}
+	w := backend.StatusNode().WakuV2Service()
+	if w == nil {
+		return nil
+	}
+	return gethbridge.NewGethWakuV2Wrapper(w)
+}
+
+// wakuPairingContext returns the context bounding a pairing over Waku, timeout is in milliseconds
+func wakuPairingContext(timeout uint) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithTimeout(context.Background(), wakuPairingTimeout)
+	}
+	return context.WithTimeout(context.Background(), time.Duration(timeout)*time.Millisecond)
+}
+
+func sendWakuPayload(ctx context.Context, t *WakuTransport, messageType protobuf.LocalPairingWakuMessage_Type, pm PayloadMounter, action Action) error {
+	err := pm.Mount()
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		return err
+	}
+
+	err = t.send(ctx, messageType, pm.ToSend())
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+	return nil
+}
+
+func receiveWakuPayload(ctx context.Context, t *WakuTransport, messageType protobuf.LocalPairingWakuMessage_Type, pr PayloadReceiver, action Action, whileWaiting func() error) error {
+	payload, err := t.receive(ctx, messageType, whileWaiting)
+	if ctx.Err() != nil {
+		// the pairing timed out or happened over another transport
+		return err
+	}
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventTransferError, Error: err.Error(), Action: action})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventTransferSuccess, Action: action})
+
+	err = pr.Receive(payload)
+	if err != nil {
+		signal.SendLocalPairingEvent(Event{Type: EventProcessError, Error: err.Error(), Action: action})
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventProcessSuccess, Action: action})
+	return nil
+}
+
+/*
+|--------------------------------------------------------------------------
+| WakuSender
+|--------------------------------------------------------------------------
+|
+| With AccountPayloadMounter, RawMessagePayloadMounter and InstallationPayloadMounterReceiver
+|
+*/
+
+// WakuSender is responsible for sending pairing data to a WakuReceiver over Waku
+type WakuSender struct {
+	transport           *WakuTransport
+	accountMounter      PayloadMounter
+	rawMessageMounter   PayloadMounter
+	installationMounter PayloadMounterReceiver
+	beforeSending       func()
+	// request is set when the WakuSender is a client, it then asks the receiving server
+	// to pair over Waku until it's ready
+	request bool
+	// onConnected is called once the WakuReceiver is ready, the pairing stops if it returns false
+	onConnected func() bool
+}
+
+// NewWakuSender returns a *WakuSender relaying the pairing over the given Waku node
+func NewWakuSender(w types.Waku, c *ConnectionParams, logger *zap.Logger, am PayloadMounter, rmm PayloadMounter, imr PayloadMounterReceiver) *WakuSender {
+	return &WakuSender{
+		transport:           NewWakuTransport(w, c, logger),
+		accountMounter:      am,
+		rawMessageMounter:   rmm,
+		installationMounter: imr,
+		beforeSending:       func() {},
+		onConnected:         func() bool { return true },
+	}
+}
+
+// Run waits for the WakuReceiver to be ready, sends the account and sync device data
+// and receives the installation of the receiving device
+func (s *WakuSender) Run(ctx context.Context) error {
+	err := s.transport.Start()
+	if err != nil {
+		return err
+	}
+	defer s.transport.Stop()
+
+	var requestPairing func() error
+	if s.request {
+		requestPairing = func() error {
+			return s.transport.send(ctx, protobuf.LocalPairingWakuMessage_REQUEST, nil)
+		}
+	}
+	_, err = s.transport.receive(ctx, protobuf.LocalPairingWakuMessage_READY, requestPairing)
+	if err != nil {
+		return err
+	}
+	if !s.onConnected() {
+		return ErrPairingTransportInUse
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionConnect})
+
+	s.beforeSending()
+	err = sendWakuPayload(ctx, s.transport, protobuf.LocalPairingWakuMessage_ACCOUNT, s.accountMounter, ActionPairingAccount)
+	if err != nil {
+		return err
+	}
+	s.accountMounter.LockPayload()
+
+	err = sendWakuPayload(ctx, s.transport, protobuf.LocalPairingWakuMessage_SYNC_DEVICE, s.rawMessageMounter, ActionSyncDevice)
+	if err != nil {
+		return err
+	}
+
+	return receiveWakuPayload(ctx, s.transport, protobuf.LocalPairingWakuMessage_INSTALLATION, s.installationMounter, ActionPairingInstallation, nil)
+}
+
+// StartUpWakuSendingClient creates a WakuSender and runs the pairing over the Waku node of the backend
+func StartUpWakuSendingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
+	w := pairingWaku(backend)
+	if w == nil {
+		return ErrWakuUnavailable
+	}
+
+	ccp, conf, err := parseSendingClientInputs(backend, cs, configJSON)
+	if err != nil {
+		return err
+	}
+
+	logger := logutils.ZapLogger().Named("WakuSender")
+	am, rmm, imr, err := NewPayloadMounters(logger, NewPayloadEncryptor(ccp.aesKey), backend, conf.SenderConfig)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("relaying pairing over waku")
+	ctx, cancel := wakuPairingContext(0)
+	defer cancel()
+	session := startTransferSession()
+	defer session.end()
+	session.OnCancel(cancel)
+	ws := NewWakuSender(w, ccp, logger, am, rmm, imr)
+	ws.request = true
+	return ws.Run(ctx)
+}
+
+/*
+|--------------------------------------------------------------------------
+| WakuReceiver
+|--------------------------------------------------------------------------
+|
+| With AccountPayloadReceiver, RawMessagePayloadReceiver, InstallationPayloadMounterReceiver
+|
+*/
+
+// WakuReceiver is responsible for accepting pairing data from a WakuSender over Waku
+type WakuReceiver struct {
+	transport            *WakuTransport
+	accountReceiver      PayloadReceiver
+	rawMessageReceiver   PayloadReceiver
+	installationReceiver PayloadMounterReceiver
+	beforeSending        func()
+	// awaitRequest is set when the WakuReceiver is a server, it then waits for the sending
+	// client to ask for the pairing over Waku before announcing it's ready
+	awaitRequest bool
+	// onConnected is called once the WakuSender asked for the pairing, it stops if it returns false
+	onConnected func() bool
+}
+
+// NewWakuReceiver returns a *WakuReceiver relaying the pairing over the given Waku node
+func NewWakuReceiver(w types.Waku, c *ConnectionParams, logger *zap.Logger, ar PayloadReceiver, rmr PayloadReceiver, imr PayloadMounterReceiver) *WakuReceiver {
+	return &WakuReceiver{
+		transport:            NewWakuTransport(w, c, logger),
+		accountReceiver:      ar,
+		rawMessageReceiver:   rmr,
+		installationReceiver: imr,
+		beforeSending:        func() {},
+		onConnected:          func() bool { return true },
+	}
+}
+
+// Run announces that the WakuReceiver is ready until the account data is received,
+// then receives the sync device data and sends the installation back
+func (r *WakuReceiver) Run(ctx context.Context) error {
+	err := r.transport.Start()
+	if err != nil {
+		return err
+	}
+	defer r.transport.Stop()
+
+	if r.awaitRequest {
+		_, err = r.transport.receive(ctx, protobuf.LocalPairingWakuMessage_REQUEST, nil)
+		if err != nil {
+			return err
+		}
+		if !r.onConnected() {
+			return ErrPairingTransportInUse
+		}
+	}
+
+	announceReady := func() error {
+		return r.transport.send(ctx, protobuf.LocalPairingWakuMessage_READY, nil)
+	}
+	err = receiveWakuPayload(ctx, r.transport, protobuf.LocalPairingWakuMessage_ACCOUNT, r.accountReceiver, ActionPairingAccount, announceReady)
+	if err != nil {
+		return err
+	}
+	signal.SendLocalPairingEvent(Event{Type: EventConnectionSuccess, Action: ActionConnect})
+
+	err = receiveWakuPayload(ctx, r.transport, protobuf.LocalPairingWakuMessage_SYNC_DEVICE, r.rawMessageReceiver, ActionSyncDevice, nil)
+	if err != nil {
+		return err
+	}
+
+	r.beforeSending()
+	return sendWakuPayload(ctx, r.transport, protobuf.LocalPairingWakuMessage_INSTALLATION, r.installationReceiver, ActionPairingInstallation)
+}
+
+// StartUpWakuReceivingClient creates a WakuReceiver and runs the pairing over the Waku node of the backend
+func StartUpWakuReceivingClient(backend *api.GethStatusBackend, cs, configJSON string) error {
+	w := pairingWaku(backend)
+	if w == nil {
+		return ErrWakuUnavailable
+	}
+
+	ccp, conf, err := parseReceivingClientInputs(backend, cs, configJSON)
+	if err != nil {
+		return err
+	}
+
+	session := startTransferSession()
+	defer session.end()
+
+	logger := logutils.ZapLogger().Named("WakuReceiver")
+	conf.ReceiverConfig.session = session
+	ar, rmr, imr, err := NewPayloadReceivers(logger, NewPayloadEncryptor(ccp.aesKey), backend, conf.ReceiverConfig)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("relaying pairing over waku")
+	ctx, cancel := wakuPairingContext(0)
+	defer cancel()
+	session.OnCancel(cancel)
+	return NewWakuReceiver(w, ccp, logger, ar, rmr, imr).Run(ctx)
+}
+
+/*
+|--------------------------------------------------------------------------
+| Servers relaying over Waku
+|--------------------------------------------------------------------------
+|
+| The servers also listen for clients asking to pair over Waku because they can't reach them.
+| The servers only answer such a request, and the HTTP and Waku transports are mutually exclusive:
+| the first one the client uses is kept and the other one is stopped.
+|
+*/
+
+func (s *SenderServer) relayOverWaku(w types.Waku, cp *ConnectionParams) {
+	logger := s.GetLogger()
+	ws := NewWakuSender(w, cp, logger, s.accountMounter, s.rawMessageMounter, s.installationMounter)
+	ws.beforeSending = func() {
+		if s.backend != nil {
+			err := s.backend.LocalPairingStarted()
+			if err != nil {
+				logger.Error("relayOverWaku backend.LocalPairingStarted()", zap.Error(err))
+			}
+		}
+	}
+	ws.onConnected = s.selectWakuTransport
+
+	s.listenOverWaku(ws.Run)
+}
+
+func (s *ReceiverServer) relayOverWaku(w types.Waku, cp *ConnectionParams) {
+	logger := s.GetLogger()
+	wr := NewWakuReceiver(w, cp, logger, s.accountReceiver, s.rawMessageReceiver, s.installationReceiver)
+	wr.beforeSending = func() {
+		if s.backend != nil {
+			err := s.backend.LocalPairingStarted()
+			if err != nil {
+				logger.Error("relayOverWaku backend.LocalPairingStarted()", zap.Error(err))
+			}
+		}
+	}
+	wr.awaitRequest = true
+	wr.onConnected = s.selectWakuTransport
+
+	s.listenOverWaku(wr.Run)
+}
+
+// listenOverWaku runs the pairing over Waku in the background, until the client
+// connects over HTTP, the pairing is cancelled or it times out
+func (s *BaseServer) listenOverWaku(run func(ctx context.Context) error) {
+	ctx, cancel := wakuPairingContext(s.config.Timeout)
+	if !s.setWakuCancel(cancel) {
+		cancel()
+		return
+	}
+	s.session.OnCancel(cancel)
+
+	go func() {
+		defer cancel()
+		err := run(ctx)
+		if s.pairingOverWaku() {
+			s.session.end()
+		}
+		if err != nil {
+			s.GetLogger().Debug("pairing over waku not completed", zap.Error(err))
+		}
+	}()
+}
@@ -0,0 +1,229 @@
+package pairing
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/status-im/status-go/eth-node/types"
+	"github.com/status-im/status-go/protocol/protobuf"
+)
+
+const (
+	wakuPairingSymKeyInfo = "status-local-pairing-waku-key"
+	wakuPairingTopicInfo  = "status-local-pairing-waku-topic"
+
+	wakuPairingPollInterval  = 200 * time.Millisecond
+	wakuPairingReadyInterval = 2 * time.Second
+	// wakuPairingTimeout applies to the whole pairing over Waku when no timeout is configured
+	wakuPairingTimeout = 5 * time.Minute
+)
+
+// deriveWakuPairingKeys derives the symmetric key and the content topic used to relay
+// the pairing over Waku from the AES key shared in the connection string,
+// so that the topic is ephemeral and messages can only be read by the two devices
+func deriveWakuPairingKeys(aesKey []byte) ([]byte, types.TopicType) {
+	symKey := sha256.Sum256(append([]byte(wakuPairingSymKeyInfo), aesKey...))
+	topicHash := sha256.Sum256(append([]byte(wakuPairingTopicInfo), aesKey...))
+	return symKey[:], types.BytesToTopic(topicHash[:types.TopicLength])
+}
+
+// WakuTransport exchanges pairing payloads as end-to-end encrypted Waku messages
+// on an ephemeral content topic, it's used when the devices can't reach each other
+// over the local network
+type WakuTransport struct {
+	waku   types.Waku
+	api    types.PublicWakuAPI
+	logger *zap.Logger
+
+	symKey   []byte
+	symKeyID string
+	topic    types.TopicType
+	filterID string
+
+	// chunks holds the received chunks of each message type, indexed by chunk
+	chunks map[protobuf.LocalPairingWakuMessage_Type]map[uint32][]byte
+	// received holds the fully received payloads of each message type
+	received map[protobuf.LocalPairingWakuMessage_Type][]byte
+}
+
+// NewWakuTransport returns a *WakuTransport using the key material of the given connection params
+func NewWakuTransport(w types.Waku, c *ConnectionParams, logger *zap.Logger) *WakuTransport {
+	symKey, topic := deriveWakuPairingKeys(c.aesKey)
+	return &WakuTransport{
+		waku:     w,
+		api:      w.PublicWakuAPI(),
+		logger:   logger.Named("WakuTransport"),
+		symKey:   symKey,
+		topic:    topic,
+		chunks:   make(map[protobuf.LocalPairingWakuMessage_Type]map[uint32][]byte),
+		received: make(map[protobuf.LocalPairingWakuMessage_Type][]byte),
+	}
+}
+
+// Start subscribes to the pairing content topic
+func (t *WakuTransport) Start() error {
+	symKeyID, err := t.waku.AddSymKeyDirect(t.symKey)
+	if err != nil {
+		return err
+	}
+
+	filterID, err := t.api.NewMessageFilter(types.Criteria{
+		SymKeyID: symKeyID,
+		Topics:   []types.TopicType{t.topic},
+	})
+	if err != nil {
+		t.waku.DeleteSymKey(symKeyID)
+		return err
+	}
+
+	t.symKeyID = symKeyID
+	t.filterID = filterID
+	return nil
+}
+
+// Stop unsubscribes from the pairing content topic and forgets the symmetric key
+func (t *WakuTransport) Stop() {
+	if t.filterID != "" {
+		err := t.waku.Unsubscribe(context.Background(), t.filterID)
+		if err != nil {
+			t.logger.Warn("failed to unsubscribe", zap.Error(err))
+		}
+		t.filterID = ""
+	}
+	if t.symKeyID != "" {
+		t.waku.DeleteSymKey(t.symKeyID)
+		t.symKeyID = ""
+	}
+}
+
+func (t *WakuTransport) chunkSize() int {
+	// leave room for the encryption and the protobuf envelope
+	return int(t.waku.MaxMessageSize() / 2)
+}
+
+// send posts the payload, split into as many messages as needed
+func (t *WakuTransport) send(ctx context.Context, messageType protobuf.LocalPairingWakuMessage_Type, payload []byte) error {
+	chunkSize := t.chunkSize()
+	chunksCount := (len(payload) + chunkSize - 1) / chunkSize
+	if chunksCount == 0 {
+		chunksCount = 1
+	}
+
+	for i := 0; i < chunksCount; i++ {
+		end := (i + 1) * chunkSize
+		if end > len(payload) {
+			end = len(payload)
+		}
+
+		encoded, err := proto.Marshal(&protobuf.LocalPairingWakuMessage{
+			Type:        messageType,
+			ChunkIndex:  uint32(i),
+			ChunksCount: uint32(chunksCount),
+			Payload:     payload[i*chunkSize : end],
+		})
+		if err != nil {
+			return err
+		}
+
+		// Pairing messages carry the keystore and the account data, store nodes must not persist them
+		_, err = t.api.Post(ctx, types.NewMessage{
+			SymKeyID:  t.symKeyID,
+			Topic:     t.topic,
+			Payload:   encoded,
+			TTL:       uint32(wakuPairingTimeout.Seconds()),
+			Ephemeral: true,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	t.logger.Debug("sent pairing message", zap.Stringer("type", messageType), zap.Int("chunks", chunksCount))
+	return nil
+}
+
+// poll collects the messages received since the last call
+func (t *WakuTransport) poll() error {
+	messages, err := t.api.GetFilterMessages(t.filterID)
+	if err != nil {
+		return err
+	}
+
+	for _, message := range messages {
+		m := &protobuf.LocalPairingWakuMessage{}
+		err = proto.Unmarshal(message.Payload, m)
+		if err != nil {
+			t.logger.Warn("failed to unmarshal pairing message", zap.Error(err))
+			continue
+		}
+		if m.ChunksCount == 0 || m.ChunkIndex >= m.ChunksCount {
+			t.logger.Warn("invalid pairing message chunk", zap.Uint32("index", m.ChunkIndex), zap.Uint32("count", m.ChunksCount))
+			continue
+		}
+
+		chunks, ok := t.chunks[m.Type]
+		if !ok {
+			chunks = make(map[uint32][]byte)
+			t.chunks[m.Type] = chunks
+		}
+		chunks[m.ChunkIndex] = m.Payload
+
+		if uint32(len(chunks)) != m.ChunksCount {
+			continue
+		}
+
+		var payload []byte
+		for i := uint32(0); i < m.ChunksCount; i++ {
+			payload = append(payload, chunks[i]...)
+		}
+		t.received[m.Type] = payload
+		delete(t.chunks, m.Type)
+	}
+
+	return nil
+}
+
+// receive waits until a message of the given type is fully received.
+// If whileWaiting is set, it's called at every wakuPairingReadyInterval
+func (t *WakuTransport) receive(ctx context.Context, messageType protobuf.LocalPairingWakuMessage_Type, whileWaiting func() error) ([]byte, error) {
+	pollTicker := time.NewTicker(wakuPairingPollInterval)
+	defer pollTicker.Stop()
+	waitingTicker := time.NewTicker(wakuPairingReadyInterval)
+	defer waitingTicker.Stop()
+
+	if whileWaiting != nil {
+		err := whileWaiting()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for pairing message '%s': %w", messageType, ctx.Err())
+		case <-waitingTicker.C:
+			if whileWaiting != nil {
+				err := whileWaiting()
+				if err != nil {
+					return nil, err
+				}
+			}
+		case <-pollTicker.C:
+			err := t.poll()
+			if err != nil {
+				return nil, err
+			}
+			if payload, ok := t.received[messageType]; ok {
+				delete(t.received, messageType)
+				t.logger.Debug("received pairing message", zap.Stringer("type", messageType))
+				return payload, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package pairing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/zap"
+
+	gethbridge "github.com/status-im/status-go/eth-node/bridge/geth"
+	"github.com/status-im/status-go/protocol/tt"
+	"github.com/status-im/status-go/wakuv2"
+)
+
+func TestWakuTransportSuite(t *testing.T) {
+	suite.Run(t, new(WakuTransportSuite))
+}
+
+type WakuTransportSuite struct {
+	suite.Suite
+
+	logger *zap.Logger
+	w1     *wakuv2.Waku
+	w2     *wakuv2.Waku
+	cp     *ConnectionParams
+}
+
+// testPayloadMounter mounts a fixed payload
+type testPayloadMounter struct {
+	payload []byte
+	locked  bool
+}
+
+func (m *testPayloadMounter) Mount() error   { return nil }
+func (m *testPayloadMounter) ToSend() []byte { return m.payload }
+func (m *testPayloadMounter) LockPayload()   { m.locked = true }
+
+// testPayloadReceiver records the received payload
+type testPayloadReceiver struct {
+	received []byte
+}
+
+func (r *testPayloadReceiver) Receive(data []byte) error { r.received = data; return nil }
+func (r *testPayloadReceiver) Received() []byte          { return r.received }
+func (r *testPayloadReceiver) LockPayload()              {}
+
+type testPayloadMounterReceiver struct {
+	testPayloadMounter
+	testPayloadReceiver
+}
+
+func (mr *testPayloadMounterReceiver) LockPayload() {}
+
+func (s *WakuTransportSuite) newWakuNode() *wakuv2.Waku {
+	config := &wakuv2.Config{
+		Port:         0,
+		ClusterID:    16,
+		EnableDiscV5: false,
+	}
+	w, err := wakuv2.New(nil, "", config, s.logger, nil, nil, nil, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Start())
+	return w
+}
+
+func (s *WakuTransportSuite) SetupTest() {
+	s.logger = tt.MustCreateTestLogger()
+
+	// Two in-process nodes connected directly, as the devices would be through the fleet
+	s.w1 = s.newWakuNode()
+	s.w2 = s.newWakuNode()
+
+	w2Addresses, err := gethbridge.NewGethWakuV2Wrapper(s.w2).ListenAddresses()
+	s.Require().NoError(err)
+	s.Require().NoError(gethbridge.NewGethWakuV2Wrapper(s.w1).DialPeer(w2Addresses[0]))
+
+	err = tt.RetryWithBackOff(func() error {
+		if s.w1.PeerCount() == 0 || s.w2.PeerCount() == 0 {
+			return ErrWakuUnavailable
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+
+	aesKey := make([]byte, 32)
+	_, err = rand.Read(aesKey)
+	s.Require().NoError(err)
+	s.cp = NewConnectionParams(nil, 0, nil, aesKey, "", "")
+}
+
+func (s *WakuTransportSuite) TearDownTest() {
+	s.Require().NoError(s.w1.Stop())
+	s.Require().NoError(s.w2.Stop())
+}
+
+func (s *WakuTransportSuite) TestDeriveWakuPairingKeys() {
+	symKey, topic := deriveWakuPairingKeys(s.cp.aesKey)
+	s.Require().Len(symKey, 32)
+	s.Require().NotEqual(s.cp.aesKey, symKey)
+
+	sameSymKey, sameTopic := deriveWakuPairingKeys(s.cp.aesKey)
+	s.Require().Equal(symKey, sameSymKey)
+	s.Require().Equal(topic, sameTopic)
+
+	otherSymKey, otherTopic := deriveWakuPairingKeys(bytes.Repeat([]byte{1}, 32))
+	s.Require().NotEqual(symKey, otherSymKey)
+	s.Require().NotEqual(topic, otherTopic)
+}
+
+func (s *WakuTransportSuite) TestPairingOverWaku() {
+	// The sync device payload doesn't fit in a single waku message
+	rawMessages := make([]byte, 3*wakuv2.DefaultConfig.MaxMessageSize/2)
+	_, err := rand.Read(rawMessages)
+	s.Require().NoError(err)
+
+	account := &testPayloadMounter{payload: []byte("account")}
+	installation := &testPayloadMounterReceiver{}
+	sender := NewWakuSender(gethbridge.NewGethWakuV2Wrapper(s.w1), s.cp, s.logger,
+		account, &testPayloadMounter{payload: rawMessages}, installation)
+
+	accountReceiver := &testPayloadReceiver{}
+	rawMessagesReceiver := &testPayloadReceiver{}
+	installationMounter := &testPayloadMounterReceiver{testPayloadMounter: testPayloadMounter{payload: []byte("installation")}}
+	receiver := NewWakuReceiver(gethbridge.NewGethWakuV2Wrapper(s.w2), s.cp, s.logger,
+		accountReceiver, rawMessagesReceiver, installationMounter)
+
+	// The sending client asks the receiving server to pair over Waku
+	sender.request = true
+	receiver.awaitRequest = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	receiverErr := make(chan error, 1)
+	go func() {
+		receiverErr <- receiver.Run(ctx)
+	}()
+
+	s.Require().NoError(sender.Run(ctx))
+	s.Require().NoError(<-receiverErr)
+
+	s.Require().Equal([]byte("account"), accountReceiver.Received())
+	s.Require().True(account.locked)
+	s.Require().Equal(rawMessages, rawMessagesReceiver.Received())
+	s.Require().Equal([]byte("installation"), installation.Received())
+}
+
+func (s *WakuTransportSuite) TestPairingOverWakuWithOtherKey() {
+	otherCp := NewConnectionParams(nil, 0, nil, bytes.Repeat([]byte{1}, 32), "", "")
+
+	sender := NewWakuSender(gethbridge.NewGethWakuV2Wrapper(s.w1), otherCp, s.logger,
+		&testPayloadMounter{}, &testPayloadMounter{}, &testPayloadMounterReceiver{})
+	receiver := NewWakuReceiver(gethbridge.NewGethWakuV2Wrapper(s.w2), s.cp, s.logger,
+		&testPayloadReceiver{}, &testPayloadReceiver{}, &testPayloadMounterReceiver{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*wakuPairingReadyInterval)
+	defer cancel()
+
+	receiverErr := make(chan error, 1)
+	go func() {
+		receiverErr <- receiver.Run(ctx)
+	}()
+
+	// The sender never sees the receiver, as it listens on another topic
+	s.Require().ErrorIs(sender.Run(ctx), context.DeadlineExceeded)
+	s.Require().ErrorIs(<-receiverErr, context.DeadlineExceeded)
+}
+
+func (s *WakuTransportSuite) TestServerOnlyAnswersRequest() {
+	sender := NewWakuSender(gethbridge.NewGethWakuV2Wrapper(s.w1), s.cp, s.logger,
+		&testPayloadMounter{}, &testPayloadMounter{}, &testPayloadMounterReceiver{})
+	receiver := NewWakuReceiver(gethbridge.NewGethWakuV2Wrapper(s.w2), s.cp, s.logger,
+		&testPayloadReceiver{}, &testPayloadReceiver{}, &testPayloadMounterReceiver{})
+	receiver.awaitRequest = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*wakuPairingReadyInterval)
+	defer cancel()
+
+	receiverErr := make(chan error, 1)
+	go func() {
+		receiverErr <- receiver.Run(ctx)
+	}()
+
+	// The sender doesn't ask for the pairing, so the receiving server never announces it's ready
+	s.Require().ErrorIs(sender.Run(ctx), context.DeadlineExceeded)
+	s.Require().ErrorIs(<-receiverErr, context.DeadlineExceeded)
+}
+
+func (s *WakuTransportSuite) TestPairingOverHTTPStopsWaku() {
+	sender := NewWakuSender(gethbridge.NewGethWakuV2Wrapper(s.w1), s.cp, s.logger,
+		&testPayloadMounter{}, &testPayloadMounter{}, &testPayloadMounterReceiver{})
+	sender.request = true
+	receiver := NewWakuReceiver(gethbridge.NewGethWakuV2Wrapper(s.w2), s.cp, s.logger,
+		&testPayloadReceiver{}, &testPayloadReceiver{}, &testPayloadMounterReceiver{})
+	receiver.awaitRequest = true
+
+	// The client already connected to the server over HTTP
+	bs := &BaseServer{}
+	s.Require().True(bs.selectHTTPTransport())
+	receiver.onConnected = bs.selectWakuTransport
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*wakuPairingReadyInterval)
+	defer cancel()
+
+	senderErr := make(chan error, 1)
+	go func() {
+		senderErr <- sender.Run(ctx)
+	}()
+
+	// The receiving server sees the request but doesn't answer it
+	s.Require().ErrorIs(receiver.Run(ctx), ErrPairingTransportInUse)
+	s.Require().ErrorIs(<-senderErr, context.DeadlineExceeded)
+	s.Require().False(bs.setWakuCancel(cancel))
+}
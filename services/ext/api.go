@@ -223,6 +223,11 @@ func (api *PublicAPI) CreateGroupChatWithMembers(ctx Context, name string, membe
 	return api.service.messenger.CreateGroupChatWithMembers(ctx, name, members)
 }
 
+// CreateGroupChatWithContactGroup creates a group chat with the mutual contacts of the contact group
+func (api *PublicAPI) CreateGroupChatWithContactGroup(ctx Context, name string, groupID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateGroupChatWithContactGroup(ctx, name, groupID)
+}
+
 func (api *PublicAPI) CreateGroupChatFromInvitation(name string, chatID string, adminPK string) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.CreateGroupChatFromInvitation(name, chatID, adminPK)
 }
@@ -364,6 +369,49 @@ func (api *PublicAPI) GetContactByID(parent context.Context, id string) *protoco
 	return api.service.messenger.GetContactByID(id)
 }
 
+func (api *PublicAPI) ContactsByGroup(parent context.Context, groupID string) ([]*protocol.Contact, error) {
+	return api.service.messenger.ContactsByGroup(groupID)
+}
+
+func (api *PublicAPI) ContactGroups(parent context.Context) ([]*protocol.ContactGroup, error) {
+	return api.service.messenger.ContactGroups()
+}
+
+func (api *PublicAPI) CreateContactGroup(ctx context.Context, request *requests.CreateContactGroup) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.CreateContactGroup(ctx, request)
+}
+
+func (api *PublicAPI) EditContactGroup(ctx context.Context, request *requests.EditContactGroup) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.EditContactGroup(ctx, request)
+}
+
+func (api *PublicAPI) DeleteContactGroup(ctx context.Context, groupID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.DeleteContactGroup(ctx, groupID)
+}
+
+func (api *PublicAPI) AddContactsToGroup(ctx context.Context, groupID string, contactIDs []string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.AddContactsToGroup(ctx, groupID, contactIDs)
+}
+
+func (api *PublicAPI) RemoveContactsFromGroup(ctx context.Context, groupID string, contactIDs []string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RemoveContactsFromGroup(ctx, groupID, contactIDs)
+}
+
+// MuteContactGroup toggles the notifications of messages sent by the members of the contact group
+func (api *PublicAPI) MuteContactGroup(ctx context.Context, groupID string, muted bool) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.MuteContactGroup(ctx, groupID, muted)
+}
+
+// ExportContacts exports the selected contacts to a file signed by the identity of the user
+func (api *PublicAPI) ExportContacts(request *requests.ExportContacts) (types.HexBytes, error) {
+	return api.service.messenger.ExportContacts(request)
+}
+
+// ImportContacts creates the contacts of a file produced by ExportContacts, skipping blocked contacts
+func (api *PublicAPI) ImportContacts(ctx context.Context, request *requests.ImportContacts) (*protocol.ImportedContacts, error) {
+	return api.service.messenger.ImportContacts(ctx, request)
+}
+
 func (api *PublicAPI) RequestContactInfoFromMailserver(pubkey string) (*protocol.Contact, error) {
 	return api.service.messenger.FetchContact(pubkey, true)
 }
@@ -382,6 +430,12 @@ func (api *PublicAPI) DisableInstallation(installationID string) error {
 	return api.service.messenger.DisableInstallation(installationID)
 }
 
+// RevokeInstallation permanently revokes one of our installations, e.g. a lost device,
+// for our contacts and our other devices.
+func (api *PublicAPI) RevokeInstallation(ctx context.Context, installationID string) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.RevokeInstallation(ctx, installationID)
+}
+
 // GetOurInstallations returns all the installations available given an identity
 func (api *PublicAPI) GetOurInstallations() []*multidevice.Installation {
 	return api.service.messenger.Installations()
@@ -494,6 +548,16 @@ func (api *PublicAPI) ImportCommunity(ctx context.Context, hexPrivateKey string)
 	return api.service.messenger.ImportCommunity(ctx, privateKey)
 }
 
+// ExportCommunityControlNodeBundle exports the control node state of the community, encrypted with the given password
+func (api *PublicAPI) ExportCommunityControlNodeBundle(request *requests.ExportCommunityControlNodeBundle) (types.HexBytes, error) {
+	return api.service.messenger.ExportCommunityControlNodeBundle(request)
+}
+
+// ImportCommunityControlNodeBundle makes this device the control node of the community exported in the bundle
+func (api *PublicAPI) ImportCommunityControlNodeBundle(ctx context.Context, request *requests.ImportCommunityControlNodeBundle) (*protocol.MessengerResponse, error) {
+	return api.service.messenger.ImportCommunityControlNodeBundle(ctx, request)
+}
+
 // GetCommunityPublicKeyFromPrivateKey gets the community's public key from its private key
 func (api *PublicAPI) GetCommunityPublicKeyFromPrivateKey(ctx context.Context, hexPrivateKey string) string {
 	publicKey := protocol.GetCommunityIDFromKey(hexPrivateKey)
@@ -604,6 +668,11 @@ func (api *PublicAPI) PendingRequestsToJoinForCommunity(id types.HexBytes) ([]*c
 	return api.service.messenger.PendingRequestsToJoinForCommunity(id)
 }
 
+// CommunityMembersDirectory returns a paginated, filtered list of the members of a community
+func (api *PublicAPI) CommunityMembersDirectory(request *requests.CommunityMembersDirectory) (*communities.MembersDirectoryPage, error) {
+	return api.service.messenger.CommunityMembersDirectory(request)
+}
+
 // DeclinedRequestsToJoinForCommunity returns the declined requests to join for a given community
 func (api *PublicAPI) DeclinedRequestsToJoinForCommunity(id types.HexBytes) ([]*communities.RequestToJoin, error) {
 	return api.service.messenger.DeclinedRequestsToJoinForCommunity(id)
@@ -825,6 +894,39 @@ func (api *PublicAPI) SetUserStatus(ctx context.Context, status int, customText
 	return api.service.messenger.SetUserStatus(ctx, status, customText)
 }
 
+// SetCustomUserStatus sets a status with an emoji, which optionally reverts to the previous status at a given time
+func (api *PublicAPI) SetCustomUserStatus(ctx context.Context, request *requests.SetCustomUserStatus) error {
+	return api.service.messenger.SetCustomUserStatus(ctx, request)
+}
+
+// SetStatusSchedule sets the recurring windows during which the status is set to do not disturb
+func (api *PublicAPI) SetStatusSchedule(windows []protocol.DoNotDisturbWindow) error {
+	return api.service.messenger.SetStatusSchedule(windows)
+}
+
+func (api *PublicAPI) GetStatusSchedule() ([]protocol.DoNotDisturbWindow, error) {
+	return api.service.messenger.GetStatusSchedule()
+}
+
+// SetContactRequestsPolicy sets who can send us a contact request
+func (api *PublicAPI) SetContactRequestsPolicy(policy protocol.ContactRequestsPolicy) error {
+	return api.service.messenger.SetContactRequestsPolicy(policy)
+}
+
+func (api *PublicAPI) GetContactRequestsPolicy() (protocol.ContactRequestsPolicy, error) {
+	return api.service.messenger.GetContactRequestsPolicy()
+}
+
+// GetAutoDeclinedContactRequestSenders returns the senders whose contact requests were declined for sending too many of them
+func (api *PublicAPI) GetAutoDeclinedContactRequestSenders() ([]string, error) {
+	return api.service.messenger.GetAutoDeclinedContactRequestSenders()
+}
+
+// ResetContactRequestSender accepts the contact requests of an auto-declined sender again
+func (api *PublicAPI) ResetContactRequestSender(contactID string) error {
+	return api.service.messenger.ResetContactRequestSender(contactID)
+}
+
 func (api *PublicAPI) DeleteMessage(id string) error {
 	return api.service.messenger.DeleteMessage(id)
 }
@@ -904,6 +1006,11 @@ func (api *PublicAPI) RemoveContact(ctx context.Context, pubKey string) (*protoc
 	return api.service.messenger.RemoveContact(ctx, pubKey)
 }
 
+// ResetContactSession resets the encryption session with the contact, used when their messages can't be decrypted anymore
+func (api *PublicAPI) ResetContactSession(ctx context.Context, pubKey string) error {
+	return api.service.messenger.ResetContactSession(pubKey)
+}
+
 func (api *PublicAPI) SetContactLocalNickname(ctx context.Context, request *requests.SetContactLocalNickname) (*protocol.MessengerResponse, error) {
 	return api.service.messenger.SetContactLocalNickname(request)
 }
@@ -1020,6 +1127,23 @@ func (api *PublicAPI) GetTrustStatus(ctx context.Context, contactID string) (ver
 	return api.service.messenger.GetTrustStatus(contactID)
 }
 
+// GetSafetyNumber returns the safety number shared with the contact, to be compared with the one on their device
+func (api *PublicAPI) GetSafetyNumber(ctx context.Context, contactID string) (*verification.SafetyNumber, error) {
+	return api.service.messenger.GetSafetyNumber(contactID)
+}
+
+func (api *PublicAPI) VerifySafetyNumber(ctx context.Context, contactID string, numeric string) (*verification.SafetyNumber, error) {
+	return api.service.messenger.VerifySafetyNumber(ctx, contactID, numeric)
+}
+
+func (api *PublicAPI) VerifySafetyNumberQRCode(ctx context.Context, contactID string, qrCode string) (*verification.SafetyNumber, error) {
+	return api.service.messenger.VerifySafetyNumberQRCode(ctx, contactID, qrCode)
+}
+
+func (api *PublicAPI) UnverifySafetyNumber(ctx context.Context, contactID string) (*verification.SafetyNumber, error) {
+	return api.service.messenger.UnverifySafetyNumber(ctx, contactID)
+}
+
 func (api *PublicAPI) GetLatestVerificationRequestFrom(ctx context.Context, contactID string) (*verification.Request, error) {
 	return api.service.messenger.GetLatestVerificationRequestFrom(contactID)
 }
@@ -1240,6 +1364,43 @@ func (api *PublicAPI) GetPushNotificationsServers() ([]*pushnotificationclient.P
 	return api.service.messenger.GetPushNotificationsServers()
 }
 
+// GetPushNotificationsServerStats returns the counters of the push notification server, for operators
+func (api *PublicAPI) GetPushNotificationsServerStats() (map[string]int64, error) {
+	return api.service.messenger.GetPushNotificationsServerStats()
+}
+
+// BlockPushNotificationsServerSender makes the push notification server ignore an abusive public key
+func (api *PublicAPI) BlockPushNotificationsServerSender(publicKeyBytes types.HexBytes) error {
+	publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	return api.service.messenger.BlockPushNotificationsServerSender(publicKey)
+}
+
+func (api *PublicAPI) UnblockPushNotificationsServerSender(publicKeyBytes types.HexBytes) error {
+	publicKey, err := crypto.UnmarshalPubkey(publicKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	return api.service.messenger.UnblockPushNotificationsServerSender(publicKey)
+}
+
+func (api *PublicAPI) GetBlockedPushNotificationsServerSenders() ([]types.HexBytes, error) {
+	publicKeys, err := api.service.messenger.GetBlockedPushNotificationsServerSenders()
+	if err != nil {
+		return nil, err
+	}
+
+	var result []types.HexBytes
+	for _, publicKey := range publicKeys {
+		result = append(result, crypto.FromECDSAPub(publicKey))
+	}
+	return result, nil
+}
+
 func (api *PublicAPI) RegisteredForPushNotifications() (bool, error) {
 	return api.service.messenger.RegisteredForPushNotifications()
 }
@@ -1277,6 +1438,12 @@ func (api *PublicAPI) GetTextURLsToUnfurl(text string) *protocol.URLsUnfurlPlan
 	return api.service.messenger.GetTextURLsToUnfurl(text)
 }
 
+// GetChatTextURLsToUnfurl is like GetTextURLsToUnfurl, taking into account
+// whether unfurling is disabled for the type of the chat.
+func (api *PublicAPI) GetChatTextURLsToUnfurl(chatID string, text string) (*protocol.URLsUnfurlPlan, error) {
+	return api.service.messenger.GetChatTextURLsToUnfurl(chatID, text)
+}
+
 // Deprecated: GetTextURLs is deprecated in favor of more generic GetTextURLsToUnfurl.
 //
 // GetTextURLs parses text and returns a deduplicated and (somewhat) normalized
@@ -1293,6 +1460,25 @@ func (api *PublicAPI) UnfurlURLs(urls []string) (protocol.UnfurlURLsResponse, er
 	return api.service.messenger.UnfurlURLs(nil, urls)
 }
 
+// UnfurlChatURLs is like UnfurlURLs, taking into account whether unfurling
+// is disabled for the type of the chat.
+func (api *PublicAPI) UnfurlChatURLs(chatID string, urls []string) (protocol.UnfurlURLsResponse, error) {
+	return api.service.messenger.UnfurlChatURLs(nil, chatID, urls)
+}
+
+// SetURLUnfurlingPrivacy sets the domains, proxy, chat types and cache used to unfurl URLs
+func (api *PublicAPI) SetURLUnfurlingPrivacy(privacy protocol.URLUnfurlingPrivacy) error {
+	return api.service.messenger.SetURLUnfurlingPrivacy(privacy)
+}
+
+func (api *PublicAPI) GetURLUnfurlingPrivacy() (protocol.URLUnfurlingPrivacy, error) {
+	return api.service.messenger.GetURLUnfurlingPrivacy()
+}
+
+func (api *PublicAPI) ClearLinkPreviewCache() error {
+	return api.service.messenger.ClearLinkPreviewCache()
+}
+
 func (api *PublicAPI) EnsVerified(pk, ensName string) error {
 	return api.service.messenger.ENSVerified(pk, ensName)
 }
@@ -1415,6 +1601,17 @@ func (api *PublicAPI) DisconnectActiveMailserver() {
 	api.service.messenger.DisconnectActiveMailserver()
 }
 
+// GetStorenodesHealth returns the score of each storenode, along with the latency and query history it's based on
+func (api *PublicAPI) GetStorenodesHealth() ([]protocol.StorenodeScore, error) {
+	return api.service.messenger.GetStorenodesHealth()
+}
+
+// GetMessageDeliveryDiagnostics returns the delivery timeline of a message we sent: envelopes published,
+// acknowledgements, store confirmations, retries and datasync acks from the recipients
+func (api *PublicAPI) GetMessageDeliveryDiagnostics(messageID string) (*protocol.MessageDeliveryDiagnostics, error) {
+	return api.service.messenger.GetMessageDeliveryDiagnostics(messageID)
+}
+
 // Echo is a method for testing purposes.
 func (api *PublicAPI) Echo(ctx context.Context, message string) (string, error) {
 	return message, nil
@@ -1833,6 +2030,30 @@ func (api *PublicAPI) SetStoreConfirmationForMessagesSent(request *requests.SetS
 	return api.service.messenger.SetStoreConfirmationForMessagesSent(request)
 }
 
+// SetLocalDiscovery enables the discovery of the peers on the local network with mDNS, applied on the next start
+func (api *PublicAPI) SetLocalDiscovery(request *requests.SetLocalDiscovery) error {
+	return api.service.messenger.SetLocalDiscovery(request)
+}
+
+// SetIncomingMessagesRateLimit changes the limit of messages handled per sender, applied right away
+func (api *PublicAPI) SetIncomingMessagesRateLimit(request *requests.SetIncomingMessagesRateLimit) error {
+	return api.service.messenger.SetIncomingMessagesRateLimit(request)
+}
+
+// GetQuarantinedSenders returns the senders whose messages are dropped for going over the rate limit too often
+func (api *PublicAPI) GetQuarantinedSenders() ([]*protocol.QuarantinedSender, error) {
+	return api.service.messenger.GetQuarantinedSenders()
+}
+
+// ReleaseQuarantinedSender handles the messages of a quarantined sender again
+func (api *PublicAPI) ReleaseQuarantinedSender(senderID string) error {
+	return api.service.messenger.ReleaseQuarantinedSender(senderID)
+}
+
+func (api *PublicAPI) GetSpamProtectionStats() *protocol.SpamProtectionStats {
+	return api.service.messenger.GetSpamProtectionStats()
+}
+
 func (api *PublicAPI) SetLogLevel(request *requests.SetLogLevel) error {
 	return api.service.messenger.SetLogLevel(request)
 }
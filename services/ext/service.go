@@ -414,6 +414,8 @@ func buildMessengerOptions(
 		protocol.WithENSVerificationConfig(config.ShhextConfig.VerifyENSURL, config.ShhextConfig.VerifyENSContractAddress),
 		protocol.WithClusterConfig(config.ClusterConfig),
 		protocol.WithTorrentConfig(&config.TorrentConfig),
+		protocol.WithArchiveTransports(communities.ArchiveTransportsFromConfig(&config.ArchiveTransportsConfig)...),
+		protocol.WithArchiveDataDir(config.ArchiveTransportsConfig.DataDir),
 		protocol.WithHTTPServer(httpServer),
 		protocol.WithRPCClient(rpcClient),
 		protocol.WithMessageCSV(config.OutputMessageCSVEnabled),
@@ -423,6 +425,8 @@ func buildMessengerOptions(
 		protocol.WithWakuService(wakuService),
 		protocol.WithAccountManager(accountManager),
 		protocol.WithAccountsFeed(accountsFeed),
+		protocol.WithParallelHistoryFetching(config.ShhextConfig.ParallelHistoryStorenodes, config.ShhextConfig.MaxConcurrentHistoryQueries),
+		protocol.WithIncomingMessagesRateLimit(config.ShhextConfig.MaxIncomingMessagesPerSenderPerMinute, config.ShhextConfig.SpamQuarantineScore),
 	}
 
 	if config.ShhextConfig.DataSyncEnabled {
@@ -125,6 +125,30 @@ func (m *MessengerSignalsHandler) DownloadingHistoryArchivesFinished(communityID
 	signal.SendDownloadingHistoryArchivesFinished(communityID)
 }
 
+func (m *MessengerSignalsHandler) HistoryArchiveVerificationFailed(communityID string, archiveID string, err string) {
+	signal.SendHistoryArchiveVerificationFailed(communityID, archiveID, err)
+}
+
+func (m *MessengerSignalsHandler) UnsignedHistoryArchiveImported(communityID string, archiveID string) {
+	signal.SendUnsignedHistoryArchiveImported(communityID, archiveID)
+}
+
+func (m *MessengerSignalsHandler) SessionOutOfSync(publicKey string, failures uint) {
+	signal.SendSessionOutOfSync(publicKey, failures)
+}
+
+func (m *MessengerSignalsHandler) SessionReset(publicKey string, state string, resentMessages int) {
+	signal.SendSessionReset(publicKey, state, resentMessages)
+}
+
+func (m *MessengerSignalsHandler) SafetyNumberChanged(contactID string) {
+	signal.SendSafetyNumberChanged(contactID)
+}
+
+func (m *MessengerSignalsHandler) SenderQuarantined(senderID string) {
+	signal.SendSenderQuarantined(senderID)
+}
+
 func (m *MessengerSignalsHandler) StatusUpdatesTimedOut(statusUpdates *[]protocol.UserStatus) {
 	signal.SendStatusUpdatesTimedOut(statusUpdates)
 }
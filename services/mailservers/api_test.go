@@ -3,6 +3,7 @@ package mailservers
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -216,3 +217,71 @@ func TestAddGetDeleteChatRequestRanges(t *testing.T) {
 	err = api.DeleteChatRequestRange(context.Background(), "non-existing-chat-id")
 	require.NoError(t, err)
 }
+
+func TestMailserverHealth(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	// A mailserver without history is neither trusted nor distrusted
+	health, err := db.MailserverHealth("mailserver001")
+	require.NoError(t, err)
+	require.Equal(t, 0.5, health.SuccessRate())
+	require.Equal(t, 1.0, health.Completeness())
+	require.Equal(t, int64(0), health.ResponseTimePercentile(0.5))
+
+	now := time.Unix(1700000000, 0)
+	for i := 1; i <= 10; i++ {
+		require.NoError(t, db.RecordMailserverQuery("mailserver001", true, time.Duration(i*100)*time.Millisecond, now))
+	}
+	require.NoError(t, db.RecordMailserverQuery("mailserver001", false, 0, now.Add(time.Minute)))
+	require.NoError(t, db.AddMailserverGap("mailserver001"))
+
+	health, err = db.MailserverHealth("mailserver001")
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), health.Queries)
+	require.Equal(t, uint64(1), health.Failures)
+	require.Equal(t, uint64(1), health.Gaps)
+	require.Equal(t, now.Unix(), health.LastSuccess)
+	require.Equal(t, now.Add(time.Minute).Unix(), health.LastFailure)
+	require.Equal(t, int64(500), health.ResponseTimePercentile(0.5))
+	require.Equal(t, int64(900), health.ResponseTimePercentile(0.9))
+	require.InDelta(t, 11.0/13.0, health.SuccessRate(), 0.0001)
+	require.InDelta(t, 11.0/12.0, health.Completeness(), 0.0001)
+
+	// Only the most recent response times are kept
+	for i := 0; i < maxResponseTimeSamples; i++ {
+		require.NoError(t, db.RecordMailserverQuery("mailserver001", true, time.Second, now))
+	}
+	all, err := db.AllMailserverHealth()
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	require.Len(t, all["mailserver001"].ResponseTimes, maxResponseTimeSamples)
+	require.Equal(t, int64(1000), all["mailserver001"].ResponseTimePercentile(0.1))
+}
+
+func TestMailserverTopicCoverage(t *testing.T) {
+	db, close := setupTestDB(t)
+	defer close()
+
+	topics := []string{"0x01020304", "0x05060708"}
+	require.NoError(t, db.UpdateMailserverTopicCoverage("mailserver001", "pubsub", topics, 100, 200))
+	// Overlapping ranges are merged
+	require.NoError(t, db.UpdateMailserverTopicCoverage("mailserver001", "pubsub", topics[:1], 150, 300))
+	require.NoError(t, db.UpdateMailserverTopicCoverage("mailserver002", "pubsub", topics[1:], 500, 600))
+
+	ids, err := db.MailserversCoveringRange("pubsub", topics[:1], 250, 260)
+	require.NoError(t, err)
+	require.Equal(t, []string{"mailserver001"}, ids)
+
+	ids, err = db.MailserversCoveringRange("pubsub", topics[1:], 250, 260)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+
+	ids, err = db.MailserversCoveringRange("pubsub", topics, 180, 550)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"mailserver001", "mailserver002"}, ids)
+
+	ids, err = db.MailserversCoveringRange("other-pubsub", topics, 0, 1000)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
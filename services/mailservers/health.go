@@ -0,0 +1,261 @@
+package mailservers
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxResponseTimeSamples is the number of recent response times kept to compute the percentiles
+const maxResponseTimeSamples = 100
+
+// MailserverHealth is the query history of a mailserver
+type MailserverHealth struct {
+	ID       string `json:"id"`
+	Queries  uint64 `json:"queries"`
+	Failures uint64 `json:"failures"`
+	// Gaps is the number of times messages missing from the mailserver responses were found later
+	Gaps uint64 `json:"gaps"`
+	// LastSuccess and LastFailure are unix timestamps in seconds
+	LastSuccess int64 `json:"lastSuccess"`
+	LastFailure int64 `json:"lastFailure"`
+	// ResponseTimes are the most recent response times in milliseconds, oldest first
+	ResponseTimes []int64 `json:"-"`
+}
+
+// SuccessRate returns the ratio of successful queries, a mailserver without history is given the benefit of the doubt
+func (h *MailserverHealth) SuccessRate() float64 {
+	return float64(h.Queries-h.Failures+1) / float64(h.Queries+2)
+}
+
+// Completeness returns the ratio of successful queries which didn't miss any message
+func (h *MailserverHealth) Completeness() float64 {
+	successes := h.Queries - h.Failures
+	return float64(successes+1) / float64(successes+h.Gaps+1)
+}
+
+// ResponseTimePercentile returns the p-th percentile of the recent response times in milliseconds, 0 if unknown
+func (h *MailserverHealth) ResponseTimePercentile(p float64) int64 {
+	if len(h.ResponseTimes) == 0 {
+		return 0
+	}
+	sorted := make([]int64, len(h.ResponseTimes))
+	copy(sorted, h.ResponseTimes)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	index := int(p*float64(len(sorted))+0.5) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(sorted) {
+		index = len(sorted) - 1
+	}
+	return sorted[index]
+}
+
+func (d *Database) mailserverHealth(tx *sql.Tx, id string) (*MailserverHealth, error) {
+	health := &MailserverHealth{ID: id}
+	var responseTimes []byte
+	err := tx.QueryRow(`SELECT queries, failures, gaps, last_success, last_failure, response_times FROM mailserver_health WHERE mailserver_id = ?`, id).Scan(
+		&health.Queries,
+		&health.Failures,
+		&health.Gaps,
+		&health.LastSuccess,
+		&health.LastFailure,
+		&responseTimes,
+	)
+	if err == sql.ErrNoRows {
+		return health, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(responseTimes) > 0 {
+		if err := json.Unmarshal(responseTimes, &health.ResponseTimes); err != nil {
+			return nil, err
+		}
+	}
+	return health, nil
+}
+
+func (d *Database) saveMailserverHealth(tx *sql.Tx, health *MailserverHealth) error {
+	responseTimes, err := json.Marshal(health.ResponseTimes)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT OR REPLACE INTO mailserver_health (mailserver_id, queries, failures, gaps, last_success, last_failure, response_times) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		health.ID,
+		health.Queries,
+		health.Failures,
+		health.Gaps,
+		health.LastSuccess,
+		health.LastFailure,
+		responseTimes,
+	)
+	return err
+}
+
+func (d *Database) updateMailserverHealth(id string, update func(*MailserverHealth)) (err error) {
+	var tx *sql.Tx
+	tx, err = d.db.Begin()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	var health *MailserverHealth
+	health, err = d.mailserverHealth(tx, id)
+	if err != nil {
+		return
+	}
+	update(health)
+	err = d.saveMailserverHealth(tx, health)
+	return
+}
+
+// RecordMailserverQuery adds the outcome of a query to the mailserver history
+func (d *Database) RecordMailserverQuery(id string, success bool, responseTime time.Duration, at time.Time) error {
+	return d.updateMailserverHealth(id, func(health *MailserverHealth) {
+		health.Queries++
+		if !success {
+			health.Failures++
+			health.LastFailure = at.Unix()
+			return
+		}
+		health.LastSuccess = at.Unix()
+		health.ResponseTimes = append(health.ResponseTimes, responseTime.Milliseconds())
+		if len(health.ResponseTimes) > maxResponseTimeSamples {
+			health.ResponseTimes = health.ResponseTimes[len(health.ResponseTimes)-maxResponseTimeSamples:]
+		}
+	})
+}
+
+// AddMailserverGap records that messages were missing from the responses of the mailserver
+func (d *Database) AddMailserverGap(id string) error {
+	return d.updateMailserverHealth(id, func(health *MailserverHealth) {
+		health.Gaps++
+	})
+}
+
+func (d *Database) MailserverHealth(id string) (*MailserverHealth, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	return d.mailserverHealth(tx, id)
+}
+
+// AllMailserverHealth returns the history of all the mailservers which have been queried, by id
+func (d *Database) AllMailserverHealth() (map[string]*MailserverHealth, error) {
+	rows, err := d.db.Query(`SELECT mailserver_id, queries, failures, gaps, last_success, last_failure, response_times FROM mailserver_health`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[string]*MailserverHealth)
+	for rows.Next() {
+		health := &MailserverHealth{}
+		var responseTimes []byte
+		err := rows.Scan(
+			&health.ID,
+			&health.Queries,
+			&health.Failures,
+			&health.Gaps,
+			&health.LastSuccess,
+			&health.LastFailure,
+			&responseTimes,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if len(responseTimes) > 0 {
+			if err := json.Unmarshal(responseTimes, &health.ResponseTimes); err != nil {
+				return nil, err
+			}
+		}
+		result[health.ID] = health
+	}
+	return result, rows.Err()
+}
+
+// UpdateMailserverTopicCoverage records that the mailserver returned all the messages of the topics
+// between from and to, extending the previous range when they overlap
+func (d *Database) UpdateMailserverTopicCoverage(id string, pubsubTopic string, topics []string, from, to uint32) (err error) {
+	var tx *sql.Tx
+	tx, err = d.db.Begin()
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			return
+		}
+		_ = tx.Rollback()
+	}()
+
+	for _, topic := range topics {
+		newFrom, newTo := from, to
+		var currentFrom, currentTo uint32
+		err = tx.QueryRow(`SELECT from_ts, to_ts FROM mailserver_topic_coverage WHERE mailserver_id = ? AND pubsub_topic = ? AND topic = ?`, id, pubsubTopic, topic).Scan(&currentFrom, &currentTo)
+		if err != nil && err != sql.ErrNoRows {
+			return
+		}
+		if err == nil && currentFrom <= to && currentTo >= from {
+			if currentFrom < newFrom {
+				newFrom = currentFrom
+			}
+			if currentTo > newTo {
+				newTo = currentTo
+			}
+		}
+
+		_, err = tx.Exec(`INSERT OR REPLACE INTO mailserver_topic_coverage (mailserver_id, pubsub_topic, topic, from_ts, to_ts) VALUES (?, ?, ?, ?, ?)`, id, pubsubTopic, topic, newFrom, newTo)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MailserversCoveringRange returns the mailservers which returned the messages of any of the topics
+// in a range overlapping from and to
+func (d *Database) MailserversCoveringRange(pubsubTopic string, topics []string, from, to uint32) ([]string, error) {
+	if len(topics) == 0 {
+		return nil, nil
+	}
+
+	args := []interface{}{pubsubTopic, to, from}
+	for _, topic := range topics {
+		args = append(args, topic)
+	}
+	inVector := strings.Repeat("?, ", len(topics)-1) + "?"
+
+	rows, err := d.db.Query(`SELECT DISTINCT mailserver_id FROM mailserver_topic_coverage WHERE pubsub_topic = ? AND from_ts < ? AND to_ts > ? AND topic IN (`+inVector+`)`, args...) // nolint: gosec
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
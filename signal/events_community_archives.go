@@ -36,6 +36,12 @@ const (
 	// EventDownloadingHistoryArchivesFinished is triggered when the community member node
 	// has downloaded all archives
 	EventDownloadingHistoryArchivesFinished = "community.downloadingHistoryArchivesFinished"
+	// EventHistoryArchiveVerificationFailed is triggered when the community member node
+	// has downloaded an archive index or archive which isn't signed by the community
+	EventHistoryArchiveVerificationFailed = "community.historyArchiveVerificationFailed"
+	// EventUnsignedHistoryArchiveImported is triggered when the community member node
+	// imports an archive of a legacy index which isn't signed by the community
+	EventUnsignedHistoryArchiveImported = "community.unsignedHistoryArchiveImported"
 )
 
 type CreatingHistoryArchivesSignal struct {
@@ -80,6 +86,18 @@ type DownloadingHistoryArchivesFinishedSignal struct {
 	CommunityID string `json:"communityId"`
 }
 
+type HistoryArchiveVerificationFailedSignal struct {
+	CommunityID string `json:"communityId"`
+	// ArchiveID is empty when the archive index fails verification
+	ArchiveID string `json:"archiveId,omitempty"`
+	Error     string `json:"error"`
+}
+
+type UnsignedHistoryArchiveImportedSignal struct {
+	CommunityID string `json:"communityId"`
+	ArchiveID   string `json:"archiveId"`
+}
+
 func SendHistoryArchivesProtocolEnabled() {
 	send(EventHistoryArchivesProtocolEnabled, nil)
 }
@@ -141,3 +159,18 @@ func SendDownloadingHistoryArchivesFinished(communityID string) {
 		CommunityID: communityID,
 	})
 }
+
+func SendHistoryArchiveVerificationFailed(communityID string, archiveID string, err string) {
+	send(EventHistoryArchiveVerificationFailed, HistoryArchiveVerificationFailedSignal{
+		CommunityID: communityID,
+		ArchiveID:   archiveID,
+		Error:       err,
+	})
+}
+
+func SendUnsignedHistoryArchiveImported(communityID string, archiveID string) {
+	send(EventUnsignedHistoryArchiveImported, UnsignedHistoryArchiveImportedSignal{
+		CommunityID: communityID,
+		ArchiveID:   archiveID,
+	})
+}
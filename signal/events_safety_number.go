@@ -0,0 +1,15 @@
+package signal
+
+const (
+	// EventSafetyNumberChanged is triggered when the installations of a contact
+	// whose safety number was verified have changed
+	EventSafetyNumberChanged = "safetyNumber.changed"
+)
+
+type SafetyNumberChangedSignal struct {
+	ContactID string `json:"contactId"`
+}
+
+func SendSafetyNumberChanged(contactID string) {
+	send(EventSafetyNumberChanged, SafetyNumberChangedSignal{ContactID: contactID})
+}
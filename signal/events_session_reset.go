@@ -0,0 +1,41 @@
+package signal
+
+const (
+	// EventSessionOutOfSync is triggered when several messages from a contact
+	// could not be decrypted, the encryption session with them should be reset
+	EventSessionOutOfSync = "session.outOfSync"
+	// EventSessionReset is triggered as the reset of the encryption session
+	// with a contact progresses
+	EventSessionReset = "session.reset"
+)
+
+const (
+	// SessionResetRequested means that we have reset the session and asked
+	// the contact to do the same
+	SessionResetRequested = "requested"
+	// SessionResetByContact means that the contact asked us to reset the session,
+	// which we did, and that we have resent our messages
+	SessionResetByContact = "resetByContact"
+	// SessionResetCompleted means that the contact has reset the session
+	// and resent their messages
+	SessionResetCompleted = "completed"
+)
+
+type SessionOutOfSyncSignal struct {
+	PublicKey string `json:"publicKey"`
+	Failures  uint   `json:"failures"`
+}
+
+type SessionResetSignal struct {
+	PublicKey      string `json:"publicKey"`
+	State          string `json:"state"`
+	ResentMessages int    `json:"resentMessages,omitempty"`
+}
+
+func SendSessionOutOfSync(publicKey string, failures uint) {
+	send(EventSessionOutOfSync, SessionOutOfSyncSignal{PublicKey: publicKey, Failures: failures})
+}
+
+func SendSessionReset(publicKey string, state string, resentMessages int) {
+	send(EventSessionReset, SessionResetSignal{PublicKey: publicKey, State: state, ResentMessages: resentMessages})
+}
@@ -0,0 +1,15 @@
+package signal
+
+const (
+	// EventSenderQuarantined is triggered when the messages of a sender are dropped
+	// until released, after going over the incoming messages rate limit too often
+	EventSenderQuarantined = "messages.senderQuarantined"
+)
+
+type SenderQuarantinedSignal struct {
+	SenderID string `json:"senderId"`
+}
+
+func SendSenderQuarantined(senderID string) {
+	send(EventSenderQuarantined, SenderQuarantinedSignal{SenderID: senderID})
+}
@@ -33,6 +33,12 @@ const (
 	// EventEnvelopeExpired fires when envelop expired
 	EventEnvelopeExpired EventType = "envelope.expired"
 
+	// EventEnvelopePublished fires when envelope was published to the network, Data is the publish method.
+	EventEnvelopePublished EventType = "envelope.published"
+
+	// EventEnvelopeStored fires when a store node confirmed it has the envelope.
+	EventEnvelopeStored EventType = "envelope.stored"
+
 	// EventEnvelopeReceived is sent once envelope was received from a peer.
 	// EventEnvelopeReceived must be sent to the feed even if envelope was previously in the cache.
 	// And event, ideally, should contain information about peer that sent envelope to us.
@@ -50,6 +50,7 @@ type Config struct {
 	Nameserver                             string           `toml:",omitempty"` // Optional nameserver to use for dns discovery
 	Resolver                               ethdisc.Resolver `toml:",omitempty"` // Optional resolver to use for dns discovery
 	EnableDiscV5                           bool             `toml:",omitempty"` // Indicates whether discv5 is enabled or not
+	EnableLocalDiscovery                   bool             `toml:",omitempty"` // Indicates whether the peers on the local network are discovered with mDNS
 	DiscoveryLimit                         int              `toml:",omitempty"` // Indicates the number of nodes to discover with peer exchange client
 	AutoUpdate                             bool             `toml:",omitempty"`
 	UDPPort                                int              `toml:",omitempty"`
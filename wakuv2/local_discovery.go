@@ -0,0 +1,314 @@
+package wakuv2
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+	"golang.org/x/net/dns/dnsmessage"
+	"golang.org/x/net/ipv4"
+)
+
+// localDiscoveryServiceName is the DNS-SD service advertised by libp2p nodes using mDNS
+const localDiscoveryServiceName = "_p2p._udp.local."
+
+// dnsaddrPrefix prefixes the multiaddresses of a peer in its TXT record
+const dnsaddrPrefix = "dnsaddr="
+
+const localDiscoveryTTL = 120
+
+// localDiscoveryQueryInterval is how often the local network is queried for peers
+var localDiscoveryQueryInterval = 30 * time.Second
+
+var mdnsGroupAddr = &net.UDPAddr{IP: net.IPv4(224, 0, 0, 251), Port: 5353}
+
+// localDiscovery finds the peers on the local network with mDNS, following the libp2p mDNS
+// discovery spec, so that nodes can exchange messages without any bootstrap node
+type localDiscovery struct {
+	self  peer.ID
+	name  string
+	addrs func() []multiaddr.Multiaddr
+	found func(peer.AddrInfo)
+
+	conn       *net.UDPConn
+	packetConn *ipv4.PacketConn
+	interfaces []net.Interface
+	// sendMu guards the multicast interface of packetConn while sending
+	sendMu sync.Mutex
+
+	wg     sync.WaitGroup
+	logger *zap.Logger
+}
+
+func newLocalDiscovery(self peer.ID, addrs func() []multiaddr.Multiaddr, found func(peer.AddrInfo), logger *zap.Logger) (*localDiscovery, error) {
+	conn, err := net.ListenMulticastUDP("udp4", nil, mdnsGroupAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	packetConn := ipv4.NewPacketConn(conn)
+	if err := packetConn.SetMulticastLoopback(true); err != nil {
+		logger.Debug("failed to enable multicast loopback", zap.Error(err))
+	}
+
+	// Listen on every multicast interface, as the default one may not be the local network
+	var interfaces []net.Interface
+	all, err := net.Interfaces()
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	for _, iface := range all {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagMulticast == 0 {
+			continue
+		}
+		// Joining fails for the default interface, which was already joined when listening
+		_ = packetConn.JoinGroup(&iface, &net.UDPAddr{IP: mdnsGroupAddr.IP})
+		interfaces = append(interfaces, iface)
+	}
+
+	name := make([]byte, 16)
+	if _, err := rand.Read(name); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	return &localDiscovery{
+		self:       self,
+		name:       hex.EncodeToString(name),
+		addrs:      addrs,
+		found:      found,
+		conn:       conn,
+		packetConn: packetConn,
+		interfaces: interfaces,
+		logger:     logger.Named("local-discovery"),
+	}, nil
+}
+
+func (d *localDiscovery) Start(ctx context.Context) {
+	d.wg.Add(2)
+	go func() {
+		defer d.wg.Done()
+		d.readLoop()
+	}()
+	go func() {
+		defer d.wg.Done()
+		d.queryLoop(ctx)
+	}()
+}
+
+func (d *localDiscovery) Stop() {
+	_ = d.conn.Close()
+	d.wg.Wait()
+}
+
+func (d *localDiscovery) queryLoop(ctx context.Context) {
+	// Announce ourselves, so that the peers already running find us right away
+	d.announce()
+
+	ticker := time.NewTicker(localDiscoveryQueryInterval)
+	defer ticker.Stop()
+	for {
+		d.query()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *localDiscovery) readLoop() {
+	buf := make([]byte, 9000)
+	for {
+		n, _, err := d.conn.ReadFromUDP(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			d.logger.Debug("failed to read mdns packet", zap.Error(err))
+			continue
+		}
+		d.handlePacket(buf[:n])
+	}
+}
+
+func (d *localDiscovery) handlePacket(packet []byte) {
+	var parser dnsmessage.Parser
+	header, err := parser.Start(packet)
+	if err != nil {
+		return
+	}
+
+	if !header.Response {
+		questions, err := parser.AllQuestions()
+		if err != nil {
+			return
+		}
+		for _, question := range questions {
+			if question.Name.String() == localDiscoveryServiceName && (question.Type == dnsmessage.TypePTR || question.Type == dnsmessage.TypeALL) {
+				d.announce()
+				return
+			}
+		}
+		return
+	}
+
+	if err := parser.SkipAllQuestions(); err != nil {
+		return
+	}
+
+	var resources []dnsmessage.Resource
+	answers, err := parser.AllAnswers()
+	if err != nil {
+		return
+	}
+	resources = append(resources, answers...)
+	if err := parser.SkipAllAuthorities(); err != nil {
+		return
+	}
+	additionals, err := parser.AllAdditionals()
+	if err != nil {
+		return
+	}
+	resources = append(resources, additionals...)
+
+	for _, info := range peersFromResources(resources) {
+		if info.ID == d.self {
+			continue
+		}
+		d.found(info)
+	}
+}
+
+// peersFromResources returns the peers advertised in the TXT records of the service instances
+func peersFromResources(resources []dnsmessage.Resource) []peer.AddrInfo {
+	var addrs []multiaddr.Multiaddr
+	for _, resource := range resources {
+		txt, ok := resource.Body.(*dnsmessage.TXTResource)
+		if !ok || !strings.HasSuffix(resource.Header.Name.String(), localDiscoveryServiceName) {
+			continue
+		}
+		for _, entry := range txt.TXT {
+			if !strings.HasPrefix(entry, dnsaddrPrefix) {
+				continue
+			}
+			addr, err := multiaddr.NewMultiaddr(strings.TrimPrefix(entry, dnsaddrPrefix))
+			if err != nil {
+				continue
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	infos, err := peer.AddrInfosFromP2pAddrs(addrs...)
+	if err != nil {
+		return nil
+	}
+	return infos
+}
+
+func (d *localDiscovery) query() {
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{})
+	builder.EnableCompression()
+	if err := builder.StartQuestions(); err != nil {
+		return
+	}
+	err := builder.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName(localDiscoveryServiceName),
+		Type:  dnsmessage.TypePTR,
+		Class: dnsmessage.ClassINET,
+	})
+	if err != nil {
+		return
+	}
+	packet, err := builder.Finish()
+	if err != nil {
+		return
+	}
+	d.send(packet)
+}
+
+func (d *localDiscovery) announce() {
+	packet, err := d.response()
+	if err != nil {
+		d.logger.Warn("failed to build mdns response", zap.Error(err))
+		return
+	}
+	d.send(packet)
+}
+
+func (d *localDiscovery) response() ([]byte, error) {
+	service := dnsmessage.MustNewName(localDiscoveryServiceName)
+	instance, err := dnsmessage.NewName(d.name + "." + localDiscoveryServiceName)
+	if err != nil {
+		return nil, err
+	}
+
+	var txt []string
+	for _, addr := range d.addrs() {
+		entry := dnsaddrPrefix + addr.String()
+		// A TXT string can't be longer than 255 bytes
+		if len(entry) > 255 {
+			continue
+		}
+		txt = append(txt, entry)
+	}
+	if len(txt) == 0 {
+		return nil, errors.New("no address to announce")
+	}
+
+	builder := dnsmessage.NewBuilder(nil, dnsmessage.Header{Response: true, Authoritative: true})
+	builder.EnableCompression()
+	if err := builder.StartAnswers(); err != nil {
+		return nil, err
+	}
+	err = builder.PTRResource(
+		dnsmessage.ResourceHeader{Name: service, Type: dnsmessage.TypePTR, Class: dnsmessage.ClassINET, TTL: localDiscoveryTTL},
+		dnsmessage.PTRResource{PTR: instance},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := builder.StartAdditionals(); err != nil {
+		return nil, err
+	}
+	err = builder.TXTResource(
+		dnsmessage.ResourceHeader{Name: instance, Type: dnsmessage.TypeTXT, Class: dnsmessage.ClassINET, TTL: localDiscoveryTTL},
+		dnsmessage.TXTResource{TXT: txt},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return builder.Finish()
+}
+
+func (d *localDiscovery) send(packet []byte) {
+	d.sendMu.Lock()
+	defer d.sendMu.Unlock()
+
+	if len(d.interfaces) == 0 {
+		if _, err := d.conn.WriteToUDP(packet, mdnsGroupAddr); err != nil {
+			d.logger.Debug("failed to send mdns packet", zap.Error(err))
+		}
+		return
+	}
+
+	for i := range d.interfaces {
+		if err := d.packetConn.SetMulticastInterface(&d.interfaces[i]); err != nil {
+			continue
+		}
+		if _, err := d.packetConn.WriteTo(packet, nil, mdnsGroupAddr); err != nil {
+			d.logger.Debug("failed to send mdns packet", zap.String("interface", d.interfaces[i].Name), zap.Error(err))
+		}
+	}
+}
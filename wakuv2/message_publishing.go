@@ -105,6 +105,12 @@ func (w *Waku) publishEnvelope(envelope *protocol.Envelope) {
 		return
 	}
 
+	w.SendEnvelopeEvent(common.EnvelopeEvent{
+		Hash:  gethcommon.BytesToHash(envelope.Hash().Bytes()),
+		Event: common.EventEnvelopePublished,
+		Data:  w.messageSender.PublishMethod().String(),
+	})
+
 	if !w.cfg.EnableStoreConfirmationForMessagesSent {
 		w.SendEnvelopeEvent(common.EnvelopeEvent{
 			Hash:  gethcommon.BytesToHash(envelope.Hash().Bytes()),
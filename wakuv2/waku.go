@@ -34,6 +34,7 @@ import (
 	"time"
 
 	"github.com/jellydator/ttlcache/v3"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/peerstore"
 	"github.com/multiformats/go-multiaddr"
@@ -130,6 +131,8 @@ type Waku struct {
 	envelopeCache *ttlcache.Cache[gethcommon.Hash, *common.ReceivedMessage] // Pool of envelopes currently tracked by this node
 	poolMu        sync.RWMutex                                              // Mutex to sync the message and expiration pools
 
+	pendingEnvelopes map[gethcommon.Hash]bool // Envelopes waiting to be processed, guarded by poolMu
+
 	bandwidthCounter *metrics.BandwidthCounter
 
 	protectedTopicStore *persistence.ProtectedTopicsStore
@@ -138,6 +141,8 @@ type Waku struct {
 
 	missingMsgVerifier *missing.MissingMessageVerifier
 
+	localDiscovery *localDiscovery // Discovery of the peers on the local network, when enabled
+
 	msgQueue chan *common.ReceivedMessage // Message queue for waku messages that havent been decoded
 
 	ctx    context.Context
@@ -223,6 +228,7 @@ func New(nodeKey *ecdsa.PrivateKey, fleet string, cfg *Config, logger *zap.Logge
 		privateKeys:                     make(map[string]*ecdsa.PrivateKey),
 		symKeys:                         make(map[string][]byte),
 		envelopeCache:                   newTTLCache(),
+		pendingEnvelopes:                make(map[gethcommon.Hash]bool),
 		msgQueue:                        make(chan *common.ReceivedMessage, messageQueueLimit),
 		topicHealthStatusChan:           make(chan peermanager.TopicHealthStatus, 100),
 		connectionNotifChan:             make(chan node.PeerConnection, 20),
@@ -1072,6 +1078,10 @@ func (w *Waku) Start() error {
 		}
 	}
 
+	if w.cfg.EnableLocalDiscovery {
+		w.startLocalDiscovery()
+	}
+
 	w.wg.Add(1)
 	go func() {
 		defer w.wg.Done()
@@ -1222,6 +1232,10 @@ func (w *Waku) startMessageSender() error {
 				case <-w.ctx.Done():
 					return
 				case hash := <-msgStoredChan:
+					w.SendEnvelopeEvent(common.EnvelopeEvent{
+						Hash:  hash,
+						Event: common.EventEnvelopeStored,
+					})
 					w.SendEnvelopeEvent(common.EnvelopeEvent{
 						Hash:  hash,
 						Event: common.EventEnvelopeSent,
@@ -1296,6 +1310,11 @@ func (w *Waku) setupRelaySubscriptions() error {
 func (w *Waku) Stop() error {
 	w.cancel()
 
+	if w.localDiscovery != nil {
+		w.localDiscovery.Stop()
+		w.localDiscovery = nil
+	}
+
 	w.envelopeCache.Stop()
 
 	w.node.Stop()
@@ -1369,12 +1388,18 @@ func (w *Waku) add(recvMessage *common.ReceivedMessage, processImmediately bool)
 	w.poolMu.Lock()
 	envelope := w.envelopeCache.Get(recvMessage.Hash())
 	alreadyCached := envelope != nil
-	w.poolMu.Unlock()
-
 	if !alreadyCached {
 		recvMessage.Processed.Store(false)
-		w.addEnvelope(recvMessage)
+		w.envelopeCache.Set(recvMessage.Hash(), recvMessage, ttlcache.DefaultTTL)
 	}
+	// Copies received while the envelope waits to be processed, e.g. when querying
+	// several storenodes at once, are dropped
+	duplicate := w.pendingEnvelopes[recvMessage.Hash()]
+	shouldProcess := !duplicate && (!alreadyCached || !envelope.Value().Processed.Load())
+	if shouldProcess {
+		w.pendingEnvelopes[recvMessage.Hash()] = true
+	}
+	w.poolMu.Unlock()
 
 	logger := w.logger.With(zap.String("envelopeHash", recvMessage.Hash().Hex()))
 
@@ -1387,7 +1412,11 @@ func (w *Waku) add(recvMessage *common.ReceivedMessage, processImmediately bool)
 		common.EnvelopesSizeMeter.Observe(float64(len(recvMessage.Envelope.Message().Payload)))
 	}
 
-	if !alreadyCached || !envelope.Value().Processed.Load() {
+	if duplicate {
+		logger.Debug("w envelope already pending")
+	}
+
+	if shouldProcess {
 		if processImmediately {
 			logger.Debug("immediately processing envelope")
 			w.processMessage(recvMessage)
@@ -1449,6 +1478,10 @@ func (w *Waku) processMessage(e *common.ReceivedMessage) {
 		e.Processed.Store(true)
 	}
 
+	w.poolMu.Lock()
+	delete(w.pendingEnvelopes, e.Hash())
+	w.poolMu.Unlock()
+
 	w.envelopeFeed.Send(common.EnvelopeEvent{
 		Topic: e.ContentTopic,
 		Hash:  e.Hash(),
@@ -1577,6 +1610,26 @@ func (w *Waku) StartDiscV5() error {
 	return w.node.DiscV5().Start(w.ctx)
 }
 
+// startLocalDiscovery announces the node and connects to the peers found on the local network with mDNS.
+// Failing to do so is not fatal, as the node may still find peers with the other discovery methods
+func (w *Waku) startLocalDiscovery() {
+	discovery, err := newLocalDiscovery(w.node.Host().ID(), w.node.ListenAddresses, w.onLocalPeerFound, w.logger)
+	if err != nil {
+		w.logger.Error("failed to start local discovery", zap.Error(err))
+		return
+	}
+	w.localDiscovery = discovery
+	w.localDiscovery.Start(w.ctx)
+}
+
+func (w *Waku) onLocalPeerFound(info peer.AddrInfo) {
+	if w.node.Host().Network().Connectedness(info.ID) == network.Connected {
+		return
+	}
+	w.logger.Info("peer found on the local network", zap.Stringer("peerID", info.ID), zap.Any("addrs", info.Addrs))
+	w.node.AddDiscoveredPeer(info.ID, info.Addrs, wps.Static, w.cfg.DefaultShardedPubsubTopics, nil, true)
+}
+
 func (w *Waku) StopDiscV5() error {
 	if w.node.DiscV5() == nil {
 		return errors.New("discv5 is not setup")
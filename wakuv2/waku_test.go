@@ -807,3 +807,88 @@ func TestTelemetryFormat(t *testing.T) {
 	_, err = json.Marshal(requestBody)
 	require.NoError(t, err)
 }
+
+func TestDuplicateStoreEnvelopesProcessedOnce(t *testing.T) {
+	w, err := New(nil, "", nil, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+
+	msg := &pb.WakuMessage{
+		Payload:      []byte{1, 2, 3},
+		ContentTopic: common.BytesToTopic([]byte{1, 2, 3, 4}).ContentTopic(),
+		Timestamp:    proto.Int64(time.Now().UnixNano()),
+	}
+	envelope := protocol.NewEnvelope(msg, msg.GetTimestamp(), relay.DefaultWakuTopic)
+
+	// The same envelope returned by two storenodes is posted only once
+	require.NoError(t, w.OnNewEnvelopes(envelope, common.StoreMessageType, false))
+	require.NoError(t, w.OnNewEnvelopes(envelope, common.StoreMessageType, false))
+	require.Len(t, w.msgQueue, 1)
+
+	// Once processed, an envelope which didn't match any filter can be posted again
+	w.processMessage(<-w.msgQueue)
+	require.NoError(t, w.OnNewEnvelopes(envelope, common.StoreMessageType, false))
+	require.Len(t, w.msgQueue, 1)
+}
+
+func TestLocalDiscovery(t *testing.T) {
+	newNode := func(peersCh chan peer.IDSlice) *Waku {
+		config := &Config{
+			Port:                 0,
+			ClusterID:            16,
+			EnableDiscV5:         false,
+			EnableLocalDiscovery: true,
+			DiscoveryLimit:       20,
+		}
+		w, err := New(nil, "", config, nil, nil, nil, nil, func(cs types.ConnStatus) {
+			peersCh <- maps.Keys(cs.Peers)
+		})
+		require.NoError(t, err)
+		require.NoError(t, w.Start())
+		if w.localDiscovery == nil {
+			require.NoError(t, w.Stop())
+			t.Skip("multicast is not available")
+		}
+		return w
+	}
+
+	w1PeersCh := make(chan peer.IDSlice, 100)
+	w1 := newNode(w1PeersCh)
+	defer func() {
+		require.NoError(t, w1.Stop())
+		close(w1PeersCh)
+	}()
+
+	w2PeersCh := make(chan peer.IDSlice, 100)
+	w2 := newNode(w2PeersCh)
+	defer func() {
+		require.NoError(t, w2.Stop())
+		close(w2PeersCh)
+	}()
+
+	// The nodes find each other without any bootstrap node
+	waitForPeerConnectionWithTimeout(t, w2.node.Host().ID(), w1PeersCh, 15*time.Second)
+
+	filter := &common.Filter{
+		Messages:      common.NewMemoryMessageStore(),
+		PubsubTopic:   w2.cfg.DefaultShardPubsubTopic,
+		ContentTopics: common.NewTopicSetFromBytes([][]byte{{1, 2, 3, 4}}),
+	}
+	_, err := w2.Subscribe(filter)
+	require.NoError(t, err)
+
+	// Wait for the relay mesh to be formed
+	time.Sleep(2 * time.Second)
+
+	msgTimestamp := w1.timestamp()
+	_, err = w1.Send(w1.cfg.DefaultShardPubsubTopic, &pb.WakuMessage{
+		Payload:      []byte{1, 2, 3, 4, 5},
+		ContentTopic: maps.Keys(filter.ContentTopics)[0].ContentTopic(),
+		Version:      proto.Uint32(0),
+		Timestamp:    &msgTimestamp,
+	}, nil)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(filter.Retrieve()) == 1
+	}, 5*time.Second, 100*time.Millisecond)
+}